@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// API configures the api-service.
+type API struct {
+	Common
+
+	HTTPHost          string        `key:"http_host" env:"HTTP_HOST" flag:"http-host" usage:"interface the HTTP server listens on, all when empty"`
+	HTTPPort          int           `key:"http_port" env:"HTTP_PORT" flag:"http-port" default:"8080" usage:"port the HTTP server listens on"`
+	ReadHeaderTimeout time.Duration `key:"read_header_timeout" env:"READ_HEADER_TIMEOUT" flag:"read-header-timeout" default:"10s" usage:"how long a client may take to send request headers"`
+	IdleTimeout       time.Duration `key:"idle_timeout" env:"IDLE_TIMEOUT" flag:"idle-timeout" default:"2m" usage:"how long an idle keep-alive connection is kept"`
+
+	GRPCHost       string        `key:"grpc_host" env:"GRPC_HOST" flag:"grpc-host" default:"db-service" usage:"host of the db-service"`
+	GRPCPort       int           `key:"grpc_port" env:"GRPC_PORT" flag:"grpc-port" default:"50051" usage:"gRPC port of the db-service"`
+	RequestTimeout time.Duration `key:"request_timeout" env:"REQUEST_TIMEOUT" flag:"request-timeout" default:"5s" usage:"timeout of each call to the db-service"`
+
+	ShutdownDrainDelay time.Duration `key:"shutdown_drain_delay" env:"SHUTDOWN_DRAIN_DELAY" flag:"shutdown-drain-delay" default:"3s" usage:"how long /readyz fails before the HTTP server stops"`
+
+	BlobStore         string `key:"blob_store" env:"BLOB_STORE" flag:"blob-store" default:"local" usage:"where attachment content is kept: local or s3"`
+	BlobDir           string `key:"blob_dir" env:"BLOB_DIR" flag:"blob-dir" default:"data/attachments" usage:"directory of the local blob store"`
+	S3Endpoint        string `key:"s3_endpoint" env:"S3_ENDPOINT" flag:"s3-endpoint" usage:"endpoint of the S3-compatible blob store"`
+	S3Bucket          string `key:"s3_bucket" env:"S3_BUCKET" flag:"s3-bucket" usage:"bucket of the S3 blob store"`
+	S3Region          string `key:"s3_region" env:"S3_REGION" flag:"s3-region" usage:"region of the S3 blob store"`
+	S3AccessKeyID     string `key:"s3_access_key_id" env:"S3_ACCESS_KEY_ID" flag:"s3-access-key-id" usage:"access key of the S3 blob store"`
+	S3SecretAccessKey string `key:"s3_secret_access_key" env:"S3_SECRET_ACCESS_KEY" flag:"s3-secret-access-key" usage:"secret key of the S3 blob store"`
+	S3UseSSL          bool   `key:"s3_use_ssl" env:"S3_USE_SSL" flag:"s3-use-ssl" default:"true" usage:"connect to the S3 blob store over TLS"`
+	MaxAttachmentSize int64  `key:"max_attachment_size" env:"MAX_ATTACHMENT_SIZE" flag:"max-attachment-size" default:"26214400" usage:"upload limit in bytes"`
+}
+
+// LoadAPI loads the api-service configuration; args are os.Args[1:].
+func LoadAPI(args []string) (*API, error) {
+	cfg := &API{}
+	if err := Load(cfg, os.Args[0], args); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// HTTPAddr is the address the HTTP server listens on.
+func (c *API) HTTPAddr() string {
+	return net.JoinHostPort(c.HTTPHost, strconv.Itoa(c.HTTPPort))
+}
+
+// DBServiceAddr is the gRPC target of the db-service.
+func (c *API) DBServiceAddr() string {
+	return net.JoinHostPort(c.GRPCHost, strconv.Itoa(c.GRPCPort))
+}
+
+func (c *API) Validate() error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	if err := validatePort("http_port", c.HTTPPort); err != nil {
+		return err
+	}
+	if err := validatePort("grpc_port", c.GRPCPort); err != nil {
+		return err
+	}
+	if c.GRPCHost == "" {
+		return errors.New("grpc_host is required")
+	}
+	for name, d := range map[string]time.Duration{
+		"read_header_timeout": c.ReadHeaderTimeout,
+		"idle_timeout":        c.IdleTimeout,
+		"request_timeout":     c.RequestTimeout,
+	} {
+		if err := validatePositive(name, d); err != nil {
+			return err
+		}
+	}
+	if c.ShutdownDrainDelay < 0 {
+		return errors.New("shutdown_drain_delay must not be negative")
+	}
+	if c.ShutdownDrainDelay >= c.ShutdownTimeout {
+		return errors.New("shutdown_drain_delay must be shorter than shutdown_timeout")
+	}
+	if c.MaxAttachmentSize <= 0 {
+		return errors.New("max_attachment_size must be positive")
+	}
+	switch c.BlobStore {
+	case "local":
+		if c.BlobDir == "" {
+			return errors.New("blob_dir is required for the local blob store")
+		}
+	case "s3":
+		if c.S3Endpoint == "" || c.S3Bucket == "" {
+			return errors.New("s3_endpoint and s3_bucket are required for the s3 blob store")
+		}
+	default:
+		return fmt.Errorf("blob_store must be local or s3, got %q", c.BlobStore)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Package config loads the typed configuration of the services. Every setting
+// is a struct field whose tags name it in each source:
+//
+//	key     the key in the config file
+//	env     the environment variable
+//	flag    the command-line flag
+//	default the value used when no source sets it
+//	usage   the flag help text
+//
+// Sources override each other in this order: defaults, the YAML or TOML file
+// named by -config or CONFIG_FILE, environment variables, flags. A variable
+// or flag that is set overrides even when empty, so WEB_ADDR= turns off a
+// web_addr from the file.
+package config
+
+import (
+	"encoding"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Validator is implemented by configurations that check their values once
+// they are loaded.
+type Validator interface {
+	Validate() error
+}
+
+type field struct {
+	value    reflect.Value
+	name     string
+	key      string
+	env      string
+	flag     string
+	def      string
+	usage    string
+	required bool
+}
+
+// Load fills cfg, a pointer to a struct, from all sources and validates it.
+// name is the program name used in flag errors; args are the command-line
+// arguments without the program name. -h returns flag.ErrHelp.
+func Load(cfg any, name string, args []string) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: Load needs a pointer to a struct, got %T", cfg)
+	}
+	fields := collect(v.Elem())
+
+	for _, f := range fields {
+		if f.def != "" {
+			if err := set(f.value, f.def); err != nil {
+				return fmt.Errorf("invalid default for %s: %w", f.name, err)
+			}
+		}
+	}
+
+	// Flags are parsed first to find the config file, but applied last.
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	configPath := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML config file (env CONFIG_FILE)")
+	type flagValue struct {
+		field *field
+		raw   string
+	}
+	var flagValues []flagValue
+	for i := range fields {
+		f := &fields[i]
+		if f.flag == "" {
+			continue
+		}
+		usage := f.usage
+		if f.env != "" {
+			usage += fmt.Sprintf(" (env %s)", f.env)
+		}
+		if f.def != "" {
+			usage += fmt.Sprintf(" (default %s)", f.def)
+		}
+		fs.Func(f.flag, usage, func(raw string) error {
+			// Checked now for a clear error, set after the other sources.
+			if err := set(reflect.New(f.value.Type()).Elem(), raw); err != nil {
+				return err
+			}
+			flagValues = append(flagValues, flagValue{field: f, raw: raw})
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	if *configPath != "" {
+		if err := loadFile(*configPath, fields); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range fields {
+		if f.env == "" {
+			continue
+		}
+		if raw, ok := os.LookupEnv(f.env); ok {
+			if err := set(f.value, raw); err != nil {
+				return fmt.Errorf("invalid %s %q: %w", f.env, raw, err)
+			}
+		}
+	}
+
+	for _, fv := range flagValues {
+		if err := set(fv.field.value, fv.raw); err != nil {
+			return fmt.Errorf("invalid -%s %q: %w", fv.field.flag, fv.raw, err)
+		}
+	}
+
+	for _, f := range fields {
+		if f.required && f.value.IsZero() {
+			return fmt.Errorf("%s is required", describe(f))
+		}
+	}
+
+	if validator, ok := cfg.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+	}
+	return nil
+}
+
+// collect returns the settings of a struct, descending into embedded structs.
+func collect(v reflect.Value) []field {
+	var fields []field
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, collect(v.Field(i))...)
+			continue
+		}
+		fields = append(fields, field{
+			value:    v.Field(i),
+			name:     sf.Name,
+			key:      sf.Tag.Get("key"),
+			env:      sf.Tag.Get("env"),
+			flag:     sf.Tag.Get("flag"),
+			def:      sf.Tag.Get("default"),
+			usage:    sf.Tag.Get("usage"),
+			required: sf.Tag.Get("required") == "true",
+		})
+	}
+	return fields
+}
+
+// loadFile sets the fields found in a flat YAML or TOML file. Unknown keys are
+// an error, so that a typo does not silently leave a default in place.
+func loadFile(path string, fields []field) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	values := map[string]any{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	default:
+		return fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	byKey := make(map[string]field, len(fields))
+	for _, f := range fields {
+		if f.key != "" {
+			byKey[f.key] = f
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		f, ok := byKey[key]
+		if !ok {
+			return fmt.Errorf("unknown key %q in config file %s", key, path)
+		}
+		var raw string
+		switch value := values[key].(type) {
+		case string:
+			raw = value
+		case bool, int, int64, uint64, float64:
+			raw = fmt.Sprint(value)
+		default:
+			return fmt.Errorf("key %q in config file %s must be a scalar", key, path)
+		}
+		if err := set(f.value, raw); err != nil {
+			return fmt.Errorf("invalid %s in config file %s: %w", key, path, err)
+		}
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses raw into v according to its type.
+func set(v reflect.Value, raw string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	default:
+		return errors.New("unsupported setting type " + v.Type().String())
+	}
+	return nil
+}
+
+func describe(f field) string {
+	switch {
+	case f.env != "":
+		return f.env
+	case f.flag != "":
+		return "-" + f.flag
+	default:
+		return f.name
+	}
+}
@@ -0,0 +1,209 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"checklist-go/internal/config"
+)
+
+type testConfig struct {
+	Name    string        `key:"name" env:"TEST_NAME" flag:"name" default:"default"`
+	Port    int           `key:"port" env:"TEST_PORT" flag:"port" default:"80"`
+	Timeout time.Duration `key:"timeout" env:"TEST_TIMEOUT" flag:"timeout" default:"1s"`
+}
+
+func TestLoad(t *testing.T) {
+	defaults := testConfig{Name: "default", Port: 80, Timeout: time.Second}
+
+	tests := []struct {
+		name string
+		// file is written to config.<ext> and passed with -config.
+		file, ext string
+		env       map[string]string
+		args      []string
+		want      testConfig
+		wantErr   string
+	}{
+		{name: "defaults", want: defaults},
+		{
+			name: "yaml file over defaults",
+			file: "name: file\nport: 81\ntimeout: 2s\n", ext: "yaml",
+			want: testConfig{Name: "file", Port: 81, Timeout: 2 * time.Second},
+		},
+		{
+			name: "toml file over defaults",
+			file: "name = \"file\"\nport = 81\n", ext: "toml",
+			want: testConfig{Name: "file", Port: 81, Timeout: time.Second},
+		},
+		{
+			name: "env over file",
+			file: "name: file\nport: 81\n", ext: "yaml",
+			env:  map[string]string{"TEST_NAME": "env", "TEST_TIMEOUT": "3s"},
+			want: testConfig{Name: "env", Port: 81, Timeout: 3 * time.Second},
+		},
+		{
+			name: "flags over env",
+			file: "name: file\n", ext: "yaml",
+			env:  map[string]string{"TEST_NAME": "env", "TEST_PORT": "82"},
+			args: []string{"-name", "flag"},
+			want: testConfig{Name: "flag", Port: 82, Timeout: time.Second},
+		},
+		{
+			name: "empty env overrides the file value",
+			file: "name: file\n", ext: "yaml",
+			env:  map[string]string{"TEST_NAME": ""},
+			want: testConfig{Name: "", Port: 80, Timeout: time.Second},
+		},
+		{
+			name: "empty env overrides the default",
+			env:  map[string]string{"TEST_NAME": ""},
+			want: testConfig{Name: "", Port: 80, Timeout: time.Second},
+		},
+		{
+			name: "empty file value overrides the default",
+			file: "name: \"\"\n", ext: "yaml",
+			want: testConfig{Name: "", Port: 80, Timeout: time.Second},
+		},
+		{
+			name: "empty flag overrides env",
+			env:  map[string]string{"TEST_NAME": "env"},
+			args: []string{"-name="},
+			want: testConfig{Name: "", Port: 80, Timeout: time.Second},
+		},
+		{
+			name: "unknown file key",
+			file: "name: file\nnmae: typo\n", ext: "yaml",
+			wantErr: `unknown key "nmae"`,
+		},
+		{
+			name: "unsupported file extension",
+			file: "name=file\n", ext: "ini",
+			wantErr: "must be .yaml, .yml or .toml",
+		},
+		{
+			name: "bad duration in file",
+			file: "timeout: soon\n", ext: "yaml",
+			wantErr: "invalid timeout in config file",
+		},
+		{
+			name:    "bad duration in env",
+			env:     map[string]string{"TEST_TIMEOUT": "10"},
+			wantErr: `invalid TEST_TIMEOUT "10"`,
+		},
+		{
+			name:    "bad int in env",
+			env:     map[string]string{"TEST_PORT": "eighty"},
+			wantErr: `invalid TEST_PORT "eighty"`,
+		},
+		{
+			name: "empty int in env",
+			file: "port: 81\n", ext: "yaml",
+			env:     map[string]string{"TEST_PORT": ""},
+			wantErr: `invalid TEST_PORT ""`,
+		},
+		{
+			name:    "bad int in flag",
+			args:    []string{"-port", "eighty"},
+			wantErr: `invalid value "eighty" for flag -port`,
+		},
+		{
+			name:    "bad duration in flag",
+			args:    []string{"-timeout", "1 minute"},
+			wantErr: `invalid value "1 minute" for flag -timeout`,
+		},
+		{
+			name:    "unexpected argument",
+			args:    []string{"serve"},
+			wantErr: "unexpected arguments: serve",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CONFIG_FILE", "")
+			for _, name := range []string{"TEST_NAME", "TEST_PORT", "TEST_TIMEOUT"} {
+				// Set, even to "", the variable would override; t.Setenv
+				// restores it afterwards.
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			args := tt.args
+			if tt.file != "" {
+				path := filepath.Join(t.TempDir(), "config."+tt.ext)
+				if err := os.WriteFile(path, []byte(tt.file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				args = append([]string{"-config", path}, args...)
+			}
+
+			var got testConfig
+			err := config.Load(&got, "test", args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRequired(t *testing.T) {
+	t.Setenv("CONFIG_FILE", "")
+	var cfg struct {
+		DSN string `env:"TEST_DSN" flag:"dsn" required:"true"`
+	}
+	if err := config.Load(&cfg, "test", nil); err == nil || err.Error() != "TEST_DSN is required" {
+		t.Errorf("Load() error = %v, want TEST_DSN is required", err)
+	}
+	if err := config.Load(&cfg, "test", []string{"-dsn", "memory://"}); err != nil || cfg.DSN != "memory://" {
+		t.Errorf("Load(-dsn) = %q, %v", cfg.DSN, err)
+	}
+}
+
+func TestLoadDBDefaults(t *testing.T) {
+	t.Setenv("CONFIG_FILE", "")
+	var cfg config.DB
+	if err := config.Load(&cfg, "db-service", []string{"-db-dsn", "memory://"}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.GRPCAddr() != ":50051" || cfg.MaxConns != 10 || cfg.SchedulerInterval != time.Minute {
+		t.Errorf("defaults: grpc %q, max conns %d, scheduler interval %s", cfg.GRPCAddr(), cfg.MaxConns, cfg.SchedulerInterval)
+	}
+	if cfg.WebAddr != "" {
+		t.Errorf("web endpoint is on by default at %q", cfg.WebAddr)
+	}
+}
+
+func TestLoadDBClearWebAddr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("db_dsn: memory://\nweb_addr: \":8081\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+
+	t.Setenv("WEB_ADDR", ":8082")
+	os.Unsetenv("WEB_ADDR")
+	var cfg config.DB
+	if err := config.Load(&cfg, "db-service", nil); err != nil || cfg.WebAddr != ":8081" {
+		t.Fatalf("Load() web addr = %q, %v, want :8081 from the file", cfg.WebAddr, err)
+	}
+
+	t.Setenv("WEB_ADDR", "")
+	cfg = config.DB{}
+	if err := config.Load(&cfg, "db-service", nil); err != nil || cfg.WebAddr != "" {
+		t.Errorf("Load() web addr = %q, %v, want it turned off by WEB_ADDR=", cfg.WebAddr, err)
+	}
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DB configures the db-service.
+type DB struct {
+	Common
+
+	GRPCHost    string `key:"grpc_host" env:"GRPC_HOST" flag:"grpc-host" usage:"interface the gRPC server listens on, all when empty"`
+	GRPCPort    int    `key:"grpc_port" env:"GRPC_PORT" flag:"grpc-port" default:"50051" usage:"port the gRPC server listens on"`
+	MetricsAddr string `key:"metrics_addr" env:"METRICS_ADDR" flag:"metrics-addr" default:":9090" usage:"address of the Prometheus /metrics endpoint"`
+
+	WebAddr            string `key:"web_addr" env:"WEB_ADDR" flag:"web-addr" usage:"address of the Connect and gRPC-Web endpoint for browser clients, e.g. :8081; calls need X-User-ID like those of the api-service; off when empty"`
+	CORSAllowedOrigins string `key:"cors_allowed_origins" env:"CORS_ALLOWED_ORIGINS" flag:"cors-allowed-origins" usage:"comma-separated origins browsers may call the web endpoint from, * for any"`
+
+	DrainTimeout time.Duration `key:"drain_timeout" env:"DRAIN_TIMEOUT" flag:"drain-timeout" default:"5s" usage:"how long in-flight RPCs may run on shutdown before they are cancelled"`
+
+	DSN             string        `key:"db_dsn" env:"DB_DSN" flag:"db-dsn" required:"true" usage:"Postgres connection string, sqlite://path for a single SQLite file, or memory:// for an in-process store that is lost on exit"`
+	MaxConns        int32         `key:"db_max_conns" env:"DB_MAX_CONNS" flag:"db-max-conns" default:"10" usage:"maximum size of the connection pool"`
+	MinConns        int32         `key:"db_min_conns" env:"DB_MIN_CONNS" flag:"db-min-conns" default:"0" usage:"connections kept open when idle"`
+	MaxConnLifetime time.Duration `key:"db_max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME" flag:"db-max-conn-lifetime" default:"1h" usage:"age after which a connection is replaced"`
+	MaxConnIdleTime time.Duration `key:"db_max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME" flag:"db-max-conn-idle-time" default:"30m" usage:"idle time after which a connection is closed"`
+	MigrateOnStart  bool          `key:"migrate_on_start" env:"MIGRATE_ON_START" flag:"migrate-on-start" default:"true" usage:"apply pending schema migrations before serving"`
+
+	SchedulerInterval   time.Duration `key:"scheduler_interval" env:"SCHEDULER_INTERVAL" flag:"scheduler-interval" default:"1m" usage:"how often due recurrences are materialized"`
+	EnforceDependencies bool          `key:"enforce_task_dependencies" env:"ENFORCE_TASK_DEPENDENCIES" flag:"enforce-task-dependencies" default:"true" usage:"refuse to complete tasks with open prerequisites"`
+	HealthCheckInterval time.Duration `key:"health_check_interval" env:"HEALTH_CHECK_INTERVAL" flag:"health-check-interval" default:"5s" usage:"how often the database is pinged for the gRPC health status"`
+}
+
+// LoadDB loads the db-service configuration; args are os.Args[1:].
+func LoadDB(args []string) (*DB, error) {
+	cfg := &DB{}
+	if err := Load(cfg, os.Args[0], args); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// GRPCAddr is the address the gRPC server listens on.
+func (c *DB) GRPCAddr() string {
+	return net.JoinHostPort(c.GRPCHost, strconv.Itoa(c.GRPCPort))
+}
+
+// CORSOrigins splits CORSAllowedOrigins into its origins.
+func (c *DB) CORSOrigins() []string {
+	var origins []string
+	for _, origin := range strings.Split(c.CORSAllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+func (c *DB) Validate() error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	if err := validatePort("grpc_port", c.GRPCPort); err != nil {
+		return err
+	}
+	if c.MaxConns < 1 {
+		return errors.New("db_max_conns must be at least 1")
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		return errors.New("db_min_conns must be between 0 and db_max_conns")
+	}
+	for name, d := range map[string]time.Duration{
+		"db_max_conn_lifetime":  c.MaxConnLifetime,
+		"db_max_conn_idle_time": c.MaxConnIdleTime,
+		"scheduler_interval":    c.SchedulerInterval,
+		"health_check_interval": c.HealthCheckInterval,
+		"drain_timeout":         c.DrainTimeout,
+	} {
+		if err := validatePositive(name, d); err != nil {
+			return err
+		}
+	}
+	if c.DrainTimeout >= c.ShutdownTimeout {
+		return errors.New("drain_timeout must be shorter than shutdown_timeout")
+	}
+	return nil
+}
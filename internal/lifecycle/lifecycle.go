@@ -0,0 +1,155 @@
+// Package lifecycle runs the long-lived parts of a service and tears them down
+// in a fixed order when the process is asked to stop.
+//
+// A service registers its servers with Serve, its background workers with
+// Background and its teardown steps with OnShutdown, then calls Run. Run
+// returns after SIGINT or SIGTERM, or after a server fails, once every
+// shutdown step has run. Like deferred calls, the steps run last registered
+// first, so a step registered next to what it stops runs before the steps of
+// what that depends on.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Runner starts registered components and stops them in reverse registration
+// order.
+type Runner struct {
+	shutdownTimeout time.Duration
+	servers         []server
+	hooks           []hook
+}
+
+type server struct {
+	name  string
+	serve func() error
+}
+
+type hook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// New returns a Runner whose whole shutdown sequence must finish within
+// shutdownTimeout; steps still running after that see a cancelled context.
+func New(shutdownTimeout time.Duration) *Runner {
+	return &Runner{shutdownTimeout: shutdownTimeout}
+}
+
+// Serve registers a blocking serve function such as grpc.Server.Serve or
+// http.Server.ListenAndServe. It must return once the matching shutdown step
+// stops the server; an error before that triggers the shutdown.
+// http.ErrServerClosed is not treated as an error.
+func (r *Runner) Serve(name string, serve func() error) {
+	r.servers = append(r.servers, server{name: name, serve: serve})
+}
+
+// OnShutdown registers a teardown step. Steps run one at a time, in the
+// reverse of the order they were registered, and all of them run even if an
+// earlier one fails.
+func (r *Runner) OnShutdown(name string, stop func(ctx context.Context) error) {
+	r.hooks = append(r.hooks, hook{name: name, stop: stop})
+}
+
+// Background starts run in its own goroutine when Run is called and returns
+// the step that stops it: the step cancels the context passed to run and waits
+// for run to return. Register it with OnShutdown after the steps of what the
+// worker uses.
+func (r *Runner) Background(name string, run func(ctx context.Context)) func(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	var once sync.Once
+
+	r.Serve(name, func() error {
+		defer close(done)
+		run(ctx)
+		return nil
+	})
+
+	return func(stopCtx context.Context) error {
+		once.Do(cancel)
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return fmt.Errorf("%s did not stop: %w", name, stopCtx.Err())
+		}
+	}
+}
+
+// Run starts every registered server and worker and blocks until ctx is
+// cancelled, the process receives SIGINT or SIGTERM, or a server fails. It
+// then runs the shutdown steps and returns the server failure, if any, joined
+// with the errors of the steps.
+func (r *Runner) Run(ctx context.Context) error {
+	ctx, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	failed := make(chan error, len(r.servers))
+	var wg sync.WaitGroup
+	for _, s := range r.servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				failed <- fmt.Errorf("%s: %w", s.name, err)
+			}
+		}()
+	}
+
+	var errs []error
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down", "reason", context.Cause(ctx).Error())
+	case err := <-failed:
+		slog.Error("Shutting down after failure", "error", err)
+		errs = append(errs, err)
+	}
+	// A second signal falls back to the default behaviour and kills the
+	// process, in case the shutdown hangs.
+	stopSignals()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), r.shutdownTimeout)
+	defer cancel()
+
+	for _, h := range slices.Backward(r.hooks) {
+		start := time.Now()
+		if err := h.stop(shutdownCtx); err != nil {
+			slog.Error("Shutdown step failed", "step", h.name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		slog.Info("Shutdown step finished", "step", h.name, "duration", time.Since(start).String())
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		errs = append(errs, fmt.Errorf("components still running after shutdown: %w", shutdownCtx.Err()))
+	}
+
+	// Failures that raced with the shutdown are still worth reporting.
+	for {
+		select {
+		case err := <-failed:
+			errs = append(errs, err)
+		default:
+			return errors.Join(errs...)
+		}
+	}
+}
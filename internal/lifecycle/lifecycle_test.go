@@ -0,0 +1,128 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"checklist-go/internal/lifecycle"
+)
+
+// cancelled returns a context that is already done, so Run shuts down at once.
+func cancelled() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func TestShutdownOrder(t *testing.T) {
+	r := lifecycle.New(time.Second)
+	var steps []string
+	for _, name := range []string{"storage", "worker", "server"} {
+		r.OnShutdown(name, func(context.Context) error {
+			steps = append(steps, name)
+			if name == "worker" {
+				return errors.New("stuck")
+			}
+			return nil
+		})
+	}
+
+	err := r.Run(cancelled())
+	if want := []string{"server", "worker", "storage"}; !slices.Equal(steps, want) {
+		t.Errorf("steps ran as %v, want %v", steps, want)
+	}
+	if err == nil || err.Error() != "worker: stuck" {
+		t.Errorf("Run() = %v, want the failed step", err)
+	}
+}
+
+func TestServerFailureStopsOthers(t *testing.T) {
+	r := lifecycle.New(time.Second)
+	stop := make(chan struct{})
+	r.Serve("healthy", func() error {
+		<-stop
+		return nil
+	})
+	r.Serve("broken", func() error { return errors.New("address in use") })
+	r.OnShutdown("healthy", func(context.Context) error {
+		close(stop)
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- r.Run(context.Background()) }()
+	select {
+	case err := <-done:
+		if err == nil || err.Error() != "broken: address in use" {
+			t.Errorf("Run() = %v, want the failure of broken", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after a server failed")
+	}
+	select {
+	case <-stop:
+	default:
+		t.Error("the healthy server was not stopped")
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	r := lifecycle.New(100 * time.Millisecond)
+	r.Serve("stubborn", func() error { select {} })
+	var late atomic.Bool
+	// Registered first, so it runs after the slow step.
+	r.OnShutdown("after", func(ctx context.Context) error {
+		late.Store(ctx.Err() != nil)
+		return nil
+	})
+	r.OnShutdown("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	err := r.Run(cancelled())
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Run took %s with a 100ms shutdown timeout", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "slow:") ||
+		!strings.Contains(err.Error(), "components still running") {
+		t.Errorf("Run() = %v, want the slow step and the stubborn server timed out", err)
+	}
+	if !late.Load() {
+		t.Error("a step after the timeout did not see a cancelled context")
+	}
+}
+
+func TestBackground(t *testing.T) {
+	r := lifecycle.New(time.Second)
+	var finished atomic.Bool
+	r.OnShutdown("worker", r.Background("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		// Cleanup the step has to wait for.
+		time.Sleep(50 * time.Millisecond)
+		finished.Store(true)
+	}))
+
+	if err := r.Run(cancelled()); err != nil {
+		t.Errorf("Run() = %v", err)
+	}
+	if !finished.Load() {
+		t.Error("Run returned before the worker did")
+	}
+}
+
+func TestBackgroundDoesNotStop(t *testing.T) {
+	r := lifecycle.New(100 * time.Millisecond)
+	r.OnShutdown("worker", r.Background("worker", func(context.Context) { select {} }))
+
+	err := r.Run(cancelled())
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "worker did not stop") {
+		t.Errorf("Run() = %v, want the worker reported as not stopping", err)
+	}
+}
@@ -0,0 +1,72 @@
+// Package logging sets up the JSON logging of the services. Every log line
+// written with a request's context carries the fields the service keeps for
+// the request, and the trace ID of the current span.
+package logging
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Fields returns the attributes of the request ctx belongs to, if any.
+type Fields func(ctx context.Context) []slog.Attr
+
+// Setup makes a JSON handler writing to stdout at the given level the default
+// logger. Output of the standard log package goes through the same handler.
+func Setup(service string, level slog.Level, fields Fields) {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(contextHandler{handler, fields}).With("service", service))
+}
+
+// contextHandler adds the fields of the request, and the trace ID of the
+// current span, to every record. A field the record already has is kept.
+type contextHandler struct {
+	slog.Handler
+	fields Fields
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	for _, attr := range h.fields(ctx) {
+		if !hasAttr(r, attr.Key) {
+			r.AddAttrs(attr)
+		}
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs), h.fields}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name), h.fields}
+}
+
+func hasAttr(r slog.Record, key string) bool {
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		found = a.Key == key
+		return !found
+	})
+	return found
+}
+
+// RequestTaskID returns the ID of the task a db-service request is about.
+func RequestTaskID(req any) string {
+	switch r := req.(type) {
+	case *pb.TaskActionRequest:
+		return r.GetId()
+	case *pb.TransitionTaskRequest:
+		return r.GetId()
+	case interface{ GetTaskId() string }:
+		return r.GetTaskId()
+	}
+	return ""
+}
@@ -7,8 +7,10 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,9 +26,14 @@ const (
 
 // Соответствует запросу для POST /create
 type CreateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Необязательный идентификатор чек-листа, к которому относится задача
+	ChecklistId string `protobuf:"bytes,3,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Необязательный срок выполнения
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,15 +82,50 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // Основной объект Задачи, который будет возвращаться в большинстве ответов
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Done        bool                   `protobuf:"varint,4,opt,name=done,json=completed,proto3" json:"done,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	ChecklistId string                 `protobuf:"bytes,7,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	DueAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,proto3" json:"due_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,proto3" json:"completed_at,omitempty"`
+	// Правило повторения, по которому создана задача (если она — очередное повторение)
+	RecurrenceId string   `protobuf:"bytes,10,opt,name=recurrence_id,proto3" json:"recurrence_id,omitempty"`
+	Tags         []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Вычисляемый флаг: у задачи есть незавершенные предварительные задачи
+	Blocked bool `protobuf:"varint,12,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// Задачи, которые должны быть выполнены раньше этой
+	DependsOnIds []string `protobuf:"bytes,13,rep,name=depends_on_ids,json=depends_on,proto3" json:"depends_on_ids,omitempty"`
+	// Состояние задачи в рабочем процессе чек-листа; done вычисляется из него
+	Status string `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	// Участники чек-листа, назначенные на задачу
+	AssigneeIds   []string `protobuf:"bytes,15,rep,name=assignee_ids,proto3" json:"assignee_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -160,6 +202,69 @@ func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Task) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *Task) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetRecurrenceId() string {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return ""
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Task) GetDependsOnIds() []string {
+	if x != nil {
+		return x.DependsOnIds
+	}
+	return nil
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetAssigneeIds() []string {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
 // Общий запрос для операций, где нужен только ID (DELETE /delete и PUT /done)
 type TaskActionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -250,9 +355,17 @@ func (x *DeleteTaskResponse) GetSuccess() bool {
 	return false
 }
 
-// Запрос для GET /list (пока без параметров)
+// Запрос для GET /list
 type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Если задан, возвращаются только задачи этого чек-листа
+	ChecklistId string `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Если задан, возвращаются только задачи, назначенные на этого пользователя
+	AssigneeId string `protobuf:"bytes,2,opt,name=assignee_id,proto3" json:"assignee_id,omitempty"`
+	// Если true, возвращаются только невыполненные задачи
+	OpenOnly bool `protobuf:"varint,3,opt,name=open_only,proto3" json:"open_only,omitempty"`
+	// Выражение на языке фильтров, например: tag:backend AND NOT done AND due<7d
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -287,6 +400,34 @@ func (*ListTasksRequest) Descriptor() ([]byte, []int) {
 	return file_proto_checklist_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *ListTasksRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetOpenOnly() bool {
+	if x != nil {
+		return x.OpenOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 // Ответ для GET /list
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -332,37 +473,4397 @@ func (x *ListTasksResponse) GetTasks() []*Task {
 	return nil
 }
 
-var File_proto_checklist_proto protoreflect.FileDescriptor
+// Чек-лист — именованный набор задач
+type Checklist struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Правило повторения, по которому создан чек-лист (если он — очередное повторение)
+	RecurrenceId  string                 `protobuf:"bytes,6,opt,name=recurrence_id,proto3" json:"recurrence_id,omitempty"`
+	OccurrenceAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurrence_at,proto3" json:"occurrence_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_checklist_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/checklist.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
-	"\x11CreateTaskRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xd8\x01\n" +
-	"\x04Task\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04done\x18\x04 \x01(\bR\x04done\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"#\n" +
-	"\x11TaskActionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
-	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x12\n" +
-	"\x10ListTasksRequest\"6\n" +
-	"\x11ListTasksResponse\x12!\n" +
-	"\x05tasks\x18\x01 \x03(\v2\v.proto.TaskR\x05tasks2\x81\x02\n" +
-	"\x10ChecklistService\x123\n" +
+func (x *Checklist) Reset() {
+	*x = Checklist{}
+	mi := &file_proto_checklist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checklist) ProtoMessage() {}
+
+func (x *Checklist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checklist.ProtoReflect.Descriptor instead.
+func (*Checklist) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Checklist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Checklist) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Checklist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Checklist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Checklist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Checklist) GetRecurrenceId() string {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return ""
+}
+
+func (x *Checklist) GetOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceAt
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/checklists
+type CreateChecklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistRequest) Reset() {
+	*x = CreateChecklistRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistRequest) ProtoMessage() {}
+
+func (x *CreateChecklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateChecklistRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateChecklistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Общий запрос для операций над чек-листом по ID
+type ChecklistActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistActionRequest) Reset() {
+	*x = ChecklistActionRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistActionRequest) ProtoMessage() {}
+
+func (x *ChecklistActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistActionRequest.ProtoReflect.Descriptor instead.
+func (*ChecklistActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChecklistActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Запрос для GET /v1/checklists (пока без параметров)
+type ListChecklistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistsRequest) Reset() {
+	*x = ListChecklistsRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistsRequest) ProtoMessage() {}
+
+func (x *ListChecklistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{9}
+}
+
+// Ответ для GET /v1/checklists
+type ListChecklistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checklists    []*Checklist           `protobuf:"bytes,1,rep,name=checklists,proto3" json:"checklists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistsResponse) Reset() {
+	*x = ListChecklistsResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistsResponse) ProtoMessage() {}
+
+func (x *ListChecklistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistsResponse.ProtoReflect.Descriptor instead.
+func (*ListChecklistsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListChecklistsResponse) GetChecklists() []*Checklist {
+	if x != nil {
+		return x.Checklists
+	}
+	return nil
+}
+
+// Публичная ссылка только для чтения на чек-лист
+type ShareLink struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChecklistId string                 `protobuf:"bytes,2,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Сам токен хранится только в виде хеша и возвращается один раз, в ответе CreateShareLink
+	Token             string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	PasswordProtected bool                   `protobuf:"varint,4,opt,name=password_protected,proto3" json:"password_protected,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	RevokedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,proto3" json:"revoked_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_proto_checklist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ShareLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareLink) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *ShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLink) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/checklists/{id}/shares
+type CreateShareLinkRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Необязательный срок действия ссылки
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	// Необязательный пароль для доступа по ссылке
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateShareLinkRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateShareLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Запрос для GET /v1/checklists/{id}/shares
+type ListShareLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksRequest) ProtoMessage() {}
+
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListShareLinksRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+// Ответ для GET /v1/checklists/{id}/shares
+type ListShareLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinks    []*ShareLink           `protobuf:"bytes,1,rep,name=share_links,proto3" json:"share_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListShareLinksResponse) GetShareLinks() []*ShareLink {
+	if x != nil {
+		return x.ShareLinks
+	}
+	return nil
+}
+
+// Запрос для DELETE /v1/shares/{id}
+type RevokeShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkRequest) Reset() {
+	*x = RevokeShareLinkRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkRequest) ProtoMessage() {}
+
+func (x *RevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeShareLinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Запрос для GET /share/{token}
+type ResolveShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareLinkRequest) Reset() {
+	*x = ResolveShareLinkRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareLinkRequest) ProtoMessage() {}
+
+func (x *ResolveShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResolveShareLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResolveShareLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Ответ для GET /share/{token}
+type SharedChecklist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checklist     *Checklist             `protobuf:"bytes,1,opt,name=checklist,proto3" json:"checklist,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedChecklist) Reset() {
+	*x = SharedChecklist{}
+	mi := &file_proto_checklist_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedChecklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedChecklist) ProtoMessage() {}
+
+func (x *SharedChecklist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedChecklist.ProtoReflect.Descriptor instead.
+func (*SharedChecklist) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SharedChecklist) GetChecklist() *Checklist {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *SharedChecklist) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Правило повторения (iCalendar RRULE) для задачи или чек-листа
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Задано ровно одно из task_id и checklist_id
+	TaskId      string `protobuf:"bytes,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	ChecklistId string `protobuf:"bytes,3,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Например, "FREQ=WEEKLY;BYDAY=MO;BYHOUR=10"
+	Rrule   string                 `protobuf:"bytes,4,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	Dtstart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dtstart,proto3" json:"dtstart,omitempty"`
+	// Часовой пояс IANA, в котором вычисляются повторения, по умолчанию UTC
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Не задано, если повторения закончились (COUNT/UNTIL)
+	NextOccurrenceAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_occurrence_at,proto3" json:"next_occurrence_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Почему планировщик отключил правило (next_occurrence_at тогда не задано);
+	// пусто, пока правило работает. Сбрасывается, когда правило задают заново.
+	LastError     string `protobuf:"bytes,10,opt,name=last_error,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_proto_checklist_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Recurrence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Recurrence) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *Recurrence) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetDtstart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Dtstart
+	}
+	return nil
+}
+
+func (x *Recurrence) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Recurrence) GetNextOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrenceAt
+	}
+	return nil
+}
+
+func (x *Recurrence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Recurrence) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Recurrence) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// Соответствует запросам PUT /v1/tasks/{id}/recurrence и PUT /v1/checklists/{id}/recurrence
+type SetRecurrenceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	ChecklistId string                 `protobuf:"bytes,2,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	Rrule       string                 `protobuf:"bytes,3,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Необязательное начало расписания, по умолчанию — текущий момент
+	Dtstart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dtstart,proto3" json:"dtstart,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecurrenceRequest) Reset() {
+	*x = SetRecurrenceRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecurrenceRequest) ProtoMessage() {}
+
+func (x *SetRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SetRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetRecurrenceRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SetRecurrenceRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *SetRecurrenceRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *SetRecurrenceRequest) GetDtstart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Dtstart
+	}
+	return nil
+}
+
+func (x *SetRecurrenceRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Запрос для GET /v1/recurrences (пока без параметров)
+type ListRecurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurrencesRequest) Reset() {
+	*x = ListRecurrencesRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurrencesRequest) ProtoMessage() {}
+
+func (x *ListRecurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{20}
+}
+
+// Ответ для GET /v1/recurrences
+type ListRecurrencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurrences   []*Recurrence          `protobuf:"bytes,1,rep,name=recurrences,proto3" json:"recurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurrencesResponse) Reset() {
+	*x = ListRecurrencesResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurrencesResponse) ProtoMessage() {}
+
+func (x *ListRecurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurrencesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRecurrencesResponse) GetRecurrences() []*Recurrence {
+	if x != nil {
+		return x.Recurrences
+	}
+	return nil
+}
+
+// Запрос для DELETE /v1/recurrences/{id}
+type DeleteRecurrenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurrenceRequest) Reset() {
+	*x = DeleteRecurrenceRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurrenceRequest) ProtoMessage() {}
+
+func (x *DeleteRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteRecurrenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Ответ для DELETE /v1/recurrences/{id}
+type DeleteRecurrenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurrenceResponse) Reset() {
+	*x = DeleteRecurrenceResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurrenceResponse) ProtoMessage() {}
+
+func (x *DeleteRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteRecurrenceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Заготовка задачи внутри шаблона
+type TaskBlueprint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Может содержать переменные вида {{version}}
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Срок выполнения относительно момента создания чек-листа из шаблона
+	DueOffset     *durationpb.Duration `protobuf:"bytes,3,opt,name=due_offset,proto3" json:"due_offset,omitempty"`
+	Tags          []string             `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskBlueprint) Reset() {
+	*x = TaskBlueprint{}
+	mi := &file_proto_checklist_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskBlueprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskBlueprint) ProtoMessage() {}
+
+func (x *TaskBlueprint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskBlueprint.ProtoReflect.Descriptor instead.
+func (*TaskBlueprint) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaskBlueprint) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDueOffset() *durationpb.Duration {
+	if x != nil {
+		return x.DueOffset
+	}
+	return nil
+}
+
+func (x *TaskBlueprint) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Шаблон чек-листа с упорядоченными заготовками задач
+type Template struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Название создаваемого чек-листа, может содержать переменные
+	ChecklistTitle string                 `protobuf:"bytes,4,opt,name=checklist_title,proto3" json:"checklist_title,omitempty"`
+	Tasks          []*TaskBlueprint       `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_proto_checklist_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetChecklistTitle() string {
+	if x != nil {
+		return x.ChecklistTitle
+	}
+	return ""
+}
+
+func (x *Template) GetTasks() []*TaskBlueprint {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/templates
+type CreateTemplateFromChecklistRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Необязательно, по умолчанию — название исходного чек-листа
+	ChecklistTitle string `protobuf:"bytes,4,opt,name=checklist_title,proto3" json:"checklist_title,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTemplateFromChecklistRequest) Reset() {
+	*x = CreateTemplateFromChecklistRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateFromChecklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateFromChecklistRequest) ProtoMessage() {}
+
+func (x *CreateTemplateFromChecklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateFromChecklistRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateFromChecklistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateTemplateFromChecklistRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *CreateTemplateFromChecklistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateFromChecklistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateFromChecklistRequest) GetChecklistTitle() string {
+	if x != nil {
+		return x.ChecklistTitle
+	}
+	return ""
+}
+
+// Общий запрос для операций над шаблоном по ID
+type TemplateActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateActionRequest) Reset() {
+	*x = TemplateActionRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateActionRequest) ProtoMessage() {}
+
+func (x *TemplateActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateActionRequest.ProtoReflect.Descriptor instead.
+func (*TemplateActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TemplateActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Запрос для GET /v1/templates (пока без параметров)
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{28}
+}
+
+// Ответ для GET /v1/templates
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/templates/{id}/instantiate
+type InstantiateTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	// Значения переменных, подставляемых в названия: {"version": "1.2.0"}
+	Variables map[string]string `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Точка отсчета для сроков задач, по умолчанию — текущий момент
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,proto3" json:"start_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+// Соответствует запросам POST и DELETE /v1/tasks/{id}/dependencies
+type TaskDependencyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	// Задача, которая должна быть выполнена раньше task_id
+	DependsOnId   string `protobuf:"bytes,2,opt,name=depends_on_id,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDependencyRequest) Reset() {
+	*x = TaskDependencyRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDependencyRequest) ProtoMessage() {}
+
+func (x *TaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*TaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TaskDependencyRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+// Состояние рабочего процесса, например todo, in_progress, review, done
+type WorkflowState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Задачи в этом состоянии считаются выполненными (done = true)
+	IsDone        bool `protobuf:"varint,3,opt,name=is_done,proto3" json:"is_done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowState) Reset() {
+	*x = WorkflowState{}
+	mi := &file_proto_checklist_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowState) ProtoMessage() {}
+
+func (x *WorkflowState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowState.ProtoReflect.Descriptor instead.
+func (*WorkflowState) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WorkflowState) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorkflowState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowState) GetIsDone() bool {
+	if x != nil {
+		return x.IsDone
+	}
+	return false
+}
+
+// Разрешенный переход между состояниями
+type WorkflowTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromState     string                 `protobuf:"bytes,1,opt,name=from_state,json=from,proto3" json:"from_state,omitempty"`
+	ToState       string                 `protobuf:"bytes,2,opt,name=to_state,json=to,proto3" json:"to_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowTransition) Reset() {
+	*x = WorkflowTransition{}
+	mi := &file_proto_checklist_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTransition) ProtoMessage() {}
+
+func (x *WorkflowTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTransition.ProtoReflect.Descriptor instead.
+func (*WorkflowTransition) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WorkflowTransition) GetFromState() string {
+	if x != nil {
+		return x.FromState
+	}
+	return ""
+}
+
+func (x *WorkflowTransition) GetToState() string {
+	if x != nil {
+		return x.ToState
+	}
+	return ""
+}
+
+// Рабочий процесс чек-листа. Порядок состояний задает порядок колонок доски,
+// первое состояние, не являющееся завершающим, — начальное для новых задач
+type Workflow struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	States      []*WorkflowState       `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	Transitions []*WorkflowTransition  `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	// true, если у чек-листа нет собственного процесса и используется стандартный
+	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
+	mi := &file_proto_checklist_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workflow) ProtoMessage() {}
+
+func (x *Workflow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Workflow) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *Workflow) GetStates() []*WorkflowState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *Workflow) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *Workflow) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// Соответствует запросу для PUT /v1/checklists/{id}/workflow
+type SetWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	States        []*WorkflowState       `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	Transitions   []*WorkflowTransition  `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkflowRequest) Reset() {
+	*x = SetWorkflowRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkflowRequest) ProtoMessage() {}
+
+func (x *SetWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetWorkflowRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *SetWorkflowRequest) GetStates() []*WorkflowState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *SetWorkflowRequest) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// Запрос для GET /v1/checklists/{id}/workflow
+type GetWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowRequest) Reset() {
+	*x = GetWorkflowRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowRequest) ProtoMessage() {}
+
+func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetWorkflowRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+// Соответствует запросу для POST /v1/tasks/{id}/transition
+type TransitionTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionTaskRequest) Reset() {
+	*x = TransitionTaskRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTaskRequest) ProtoMessage() {}
+
+func (x *TransitionTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTaskRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TransitionTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionTaskRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Запрос для GET /v1/checklists/{id}/board
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetBoardRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+// Колонка доски: состояние и задачи в нем
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *WorkflowState         `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_proto_checklist_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BoardColumn) GetState() *WorkflowState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *BoardColumn) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Ответ для GET /v1/checklists/{id}/board
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checklist     *Checklist             `protobuf:"bytes,1,opt,name=checklist,proto3" json:"checklist,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_proto_checklist_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Board) GetChecklist() *Checklist {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *Board) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Участник чек-листа; назначать задачи можно только на участников
+type ChecklistMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistMember) Reset() {
+	*x = ChecklistMember{}
+	mi := &file_proto_checklist_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistMember) ProtoMessage() {}
+
+func (x *ChecklistMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistMember.ProtoReflect.Descriptor instead.
+func (*ChecklistMember) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChecklistMember) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *ChecklistMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChecklistMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Соответствует запросам для POST и DELETE /v1/checklists/{id}/members
+type ChecklistMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistMemberRequest) Reset() {
+	*x = ChecklistMemberRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistMemberRequest) ProtoMessage() {}
+
+func (x *ChecklistMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistMemberRequest.ProtoReflect.Descriptor instead.
+func (*ChecklistMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChecklistMemberRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *ChecklistMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveChecklistMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveChecklistMemberResponse) Reset() {
+	*x = RemoveChecklistMemberResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveChecklistMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChecklistMemberResponse) ProtoMessage() {}
+
+func (x *RemoveChecklistMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChecklistMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveChecklistMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RemoveChecklistMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос для GET /v1/checklists/{id}/members
+type ListChecklistMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistId   string                 `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistMembersRequest) Reset() {
+	*x = ListChecklistMembersRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistMembersRequest) ProtoMessage() {}
+
+func (x *ListChecklistMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListChecklistMembersRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+type ListChecklistMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ChecklistMember     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistMembersResponse) Reset() {
+	*x = ListChecklistMembersResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistMembersResponse) ProtoMessage() {}
+
+func (x *ListChecklistMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListChecklistMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListChecklistMembersResponse) GetMembers() []*ChecklistMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// Соответствует запросам для POST и DELETE /v1/tasks/{id}/assignees
+type TaskAssigneeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskAssigneeRequest) Reset() {
+	*x = TaskAssigneeRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskAssigneeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskAssigneeRequest) ProtoMessage() {}
+
+func (x *TaskAssigneeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskAssigneeRequest.ProtoReflect.Descriptor instead.
+func (*TaskAssigneeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TaskAssigneeRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskAssigneeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Комментарий к задаче; body хранится в формате Markdown
+type Comment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId    string                 `protobuf:"bytes,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,3,opt,name=author_id,proto3" json:"author_id,omitempty"`
+	Body      string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Время последнего редактирования; не задано, если комментарий не редактировался
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_checklist_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/tasks/{id}/comments
+type AddCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddCommentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Запрос для GET /v1/tasks/{id}/comments; комментарии возвращаются от старых к новым
+type ListCommentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TaskId   string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// next_page_token из предыдущего ответа
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCommentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Comments []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Соответствует запросу для PATCH /v1/tasks/{task_id}/comments/{id}
+type EditCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId string                 `protobuf:"bytes,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	// Редактировать комментарий может только его автор
+	AuthorId      string `protobuf:"bytes,3,opt,name=author_id,proto3" json:"author_id,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EditCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Соответствует запросу для DELETE /v1/tasks/{task_id}/comments/{id}
+type DeleteCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId string                 `protobuf:"bytes,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	// Удалить комментарий может только его автор
+	AuthorId      string `protobuf:"bytes,3,opt,name=author_id,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Метаданные вложения; само содержимое хранится в blob-хранилище api-service
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId      string                 `protobuf:"bytes,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	Filename    string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,5,opt,name=size_bytes,proto3" json:"size_bytes,omitempty"`
+	// SHA-256 содержимого в hex
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Ключ объекта в blob-хранилище
+	StorageKey    string                 `protobuf:"bytes,7,opt,name=storage_key,proto3" json:"storage_key,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,8,opt,name=uploaded_by,proto3" json:"uploaded_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_checklist_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Создается api-service после того, как содержимое записано в blob-хранилище
+type CreateAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,proto3" json:"size_bytes,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	StorageKey    string                 `protobuf:"bytes,6,opt,name=storage_key,proto3" json:"storage_key,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,7,opt,name=uploaded_by,proto3" json:"uploaded_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentRequest) Reset() {
+	*x = CreateAttachmentRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAttachmentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CreateAttachmentRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+type AttachmentActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentActionRequest) Reset() {
+	*x = AttachmentActionRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentActionRequest) ProtoMessage() {}
+
+func (x *AttachmentActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentActionRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AttachmentActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Запрос для GET /v1/tasks/{id}/attachments
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAttachmentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Запрос для GET /v1/search
+type SearchTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Слова, "фразы", префиксы (слово*), исключения (-слово) и OR
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Если задан, поиск только в задачах этого чек-листа
+	ChecklistId   string `protobuf:"bytes,2,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Найденная задача; фрагменты — HTML, совпадения выделены тегом <mark>
+type SearchResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Task           *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Rank           float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	TitleHighlight string                 `protobuf:"bytes,3,opt,name=title_highlight,proto3" json:"title_highlight,omitempty"`
+	// Пустой, если совпадений в описании нет
+	DescriptionSnippet string `protobuf:"bytes,4,opt,name=description_snippet,proto3" json:"description_snippet,omitempty"`
+	// Лучший совпавший комментарий к задаче, если есть
+	CommentId      string `protobuf:"bytes,5,opt,name=comment_id,proto3" json:"comment_id,omitempty"`
+	CommentSnippet string `protobuf:"bytes,6,opt,name=comment_snippet,proto3" json:"comment_snippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_checklist_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SearchResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchResult) GetDescriptionSnippet() string {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCommentSnippet() string {
+	if x != nil {
+		return x.CommentSnippet
+	}
+	return ""
+}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SearchTasksResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Сохраненное представление: именованный фильтр задач
+type SavedView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedView) Reset() {
+	*x = SavedView{}
+	mi := &file_proto_checklist_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedView) ProtoMessage() {}
+
+func (x *SavedView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedView.ProtoReflect.Descriptor instead.
+func (*SavedView) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SavedView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedView) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SavedView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Соответствует запросу для POST /v1/views
+type CreateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedViewRequest) Reset() {
+	*x = CreateSavedViewRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedViewRequest) ProtoMessage() {}
+
+func (x *CreateSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateSavedViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedViewRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// Соответствует запросу для PUT /v1/views/{id}
+type UpdateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedViewRequest) Reset() {
+	*x = UpdateSavedViewRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedViewRequest) ProtoMessage() {}
+
+func (x *UpdateSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateSavedViewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type SavedViewActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedViewActionRequest) Reset() {
+	*x = SavedViewActionRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedViewActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedViewActionRequest) ProtoMessage() {}
+
+func (x *SavedViewActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedViewActionRequest.ProtoReflect.Descriptor instead.
+func (*SavedViewActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SavedViewActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSavedViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsRequest) Reset() {
+	*x = ListSavedViewsRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsRequest) ProtoMessage() {}
+
+func (x *ListSavedViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{66}
+}
+
+type ListSavedViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*SavedView           `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsResponse) Reset() {
+	*x = ListSavedViewsResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsResponse) ProtoMessage() {}
+
+func (x *ListSavedViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSavedViewsResponse) GetViews() []*SavedView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type DeleteSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedViewResponse) Reset() {
+	*x = DeleteSavedViewResponse{}
+	mi := &file_proto_checklist_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedViewResponse) ProtoMessage() {}
+
+func (x *DeleteSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteSavedViewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос для GET /v1/stats
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Учитываются задачи, созданные в полуинтервале [from, to)
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Если задан, статистика только по этому чек-листу
+	ChecklistId string `protobuf:"bytes,3,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	// Шаг временного ряда: day, week или month
+	GroupBy string `protobuf:"bytes,4,opt,name=group_by,proto3" json:"group_by,omitempty"`
+	// Часовой пояс IANA для границ шагов, по умолчанию UTC
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_proto_checklist_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *GetStatsRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Показатели чек-листа или всех задач вместе
+type ChecklistStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустой для задач без чек-листа и для итоговой строки
+	ChecklistId    string `protobuf:"bytes,1,opt,name=checklist_id,proto3" json:"checklist_id,omitempty"`
+	ChecklistTitle string `protobuf:"bytes,2,opt,name=checklist_title,proto3" json:"checklist_title,omitempty"`
+	Total          int64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Completed      int64  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	// completed / total; 0, если задач нет
+	CompletionRatio float64 `protobuf:"fixed64,5,opt,name=completion_ratio,proto3" json:"completion_ratio,omitempty"`
+	// Медиана времени от created_at до completed_at; не задана, если выполненных задач нет
+	MedianTimeToComplete *durationpb.Duration `protobuf:"bytes,6,opt,name=median_time_to_complete,proto3" json:"median_time_to_complete,omitempty"`
+	// Незавершенные задачи с истекшим сроком
+	Overdue       int64 `protobuf:"varint,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistStats) Reset() {
+	*x = ChecklistStats{}
+	mi := &file_proto_checklist_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistStats) ProtoMessage() {}
+
+func (x *ChecklistStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistStats.ProtoReflect.Descriptor instead.
+func (*ChecklistStats) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ChecklistStats) GetChecklistId() string {
+	if x != nil {
+		return x.ChecklistId
+	}
+	return ""
+}
+
+func (x *ChecklistStats) GetChecklistTitle() string {
+	if x != nil {
+		return x.ChecklistTitle
+	}
+	return ""
+}
+
+func (x *ChecklistStats) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChecklistStats) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ChecklistStats) GetCompletionRatio() float64 {
+	if x != nil {
+		return x.CompletionRatio
+	}
+	return 0
+}
+
+func (x *ChecklistStats) GetMedianTimeToComplete() *durationpb.Duration {
+	if x != nil {
+		return x.MedianTimeToComplete
+	}
+	return nil
+}
+
+func (x *ChecklistStats) GetOverdue() int64 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+// Шаг временного ряда
+type StatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Completed     int64                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_proto_checklist_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StatsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StatsBucket) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *StatsBucket) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy       string                 `protobuf:"bytes,3,opt,name=group_by,proto3" json:"group_by,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Totals        *ChecklistStats        `protobuf:"bytes,5,opt,name=totals,proto3" json:"totals,omitempty"`
+	Checklists    []*ChecklistStats      `protobuf:"bytes,6,rep,name=checklists,proto3" json:"checklists,omitempty"`
+	Series        []*StatsBucket         `protobuf:"bytes,7,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_proto_checklist_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_checklist_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_proto_checklist_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Stats) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Stats) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Stats) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *Stats) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Stats) GetTotals() *ChecklistStats {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *Stats) GetChecklists() []*ChecklistStats {
+	if x != nil {
+		return x.Checklists
+	}
+	return nil
+}
+
+func (x *Stats) GetSeries() []*StatsBucket {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+var File_proto_checklist_proto protoreflect.FileDescriptor
+
+const file_proto_checklist_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/checklist.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x01\n" +
+	"\x11CreateTaskRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\"\n" +
+	"\fchecklist_id\x18\x03 \x01(\tR\fchecklist_id\x122\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06due_at\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xab\x04\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\x04done\x18\x04 \x01(\bR\tcompleted\x12:\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12\"\n" +
+	"\fchecklist_id\x18\a \x01(\tR\fchecklist_id\x122\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06due_at\x12>\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fcompleted_at\x12$\n" +
+	"\rrecurrence_id\x18\n" +
+	" \x01(\tR\rrecurrence_id\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x18\n" +
+	"\ablocked\x18\f \x01(\bR\ablocked\x12\"\n" +
+	"\x0edepends_on_ids\x18\r \x03(\tR\n" +
+	"depends_on\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12\"\n" +
+	"\fassignee_ids\x18\x0f \x03(\tR\fassignee_ids\"#\n" +
+	"\x11TaskActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x12DeleteTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8e\x01\n" +
+	"\x10ListTasksRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12 \n" +
+	"\vassignee_id\x18\x02 \x01(\tR\vassignee_id\x12\x1c\n" +
+	"\topen_only\x18\x03 \x01(\bR\topen_only\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\"6\n" +
+	"\x11ListTasksResponse\x12!\n" +
+	"\x05tasks\x18\x01 \x03(\v2\v.proto.TaskR\x05tasks\"\xb3\x02\n" +
+	"\tChecklist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12$\n" +
+	"\rrecurrence_id\x18\x06 \x01(\tR\rrecurrence_id\x12@\n" +
+	"\roccurrence_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\roccurrence_at\"P\n" +
+	"\x16CreateChecklistRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"(\n" +
+	"\x16ChecklistActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15ListChecklistsRequest\"J\n" +
+	"\x16ListChecklistsResponse\x120\n" +
+	"\n" +
+	"checklists\x18\x01 \x03(\v2\x10.proto.ChecklistR\n" +
+	"checklists\"\xb9\x02\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\fchecklist_id\x18\x02 \x01(\tR\fchecklist_id\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12.\n" +
+	"\x12password_protected\x18\x04 \x01(\bR\x12password_protected\x12:\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12:\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revoked_at\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\x94\x01\n" +
+	"\x16CreateShareLinkRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12:\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\";\n" +
+	"\x15ListShareLinksRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\"L\n" +
+	"\x16ListShareLinksResponse\x122\n" +
+	"\vshare_links\x18\x01 \x03(\v2\x10.proto.ShareLinkR\vshare_links\"(\n" +
+	"\x16RevokeShareLinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x17ResolveShareLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"d\n" +
+	"\x0fSharedChecklist\x12.\n" +
+	"\tchecklist\x18\x01 \x01(\v2\x10.proto.ChecklistR\tchecklist\x12!\n" +
+	"\x05tasks\x18\x02 \x03(\v2\v.proto.TaskR\x05tasks\"\xa6\x03\n" +
+	"\n" +
+	"Recurrence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atask_id\x18\x02 \x01(\tR\atask_id\x12\"\n" +
+	"\fchecklist_id\x18\x03 \x01(\tR\fchecklist_id\x12\x14\n" +
+	"\x05rrule\x18\x04 \x01(\tR\x05rrule\x124\n" +
+	"\adtstart\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adtstart\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12J\n" +
+	"\x12next_occurrence_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x12next_occurrence_at\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12\x1e\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\n" +
+	"last_error\"\xbc\x01\n" +
+	"\x14SetRecurrenceRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12\"\n" +
+	"\fchecklist_id\x18\x02 \x01(\tR\fchecklist_id\x12\x14\n" +
+	"\x05rrule\x18\x03 \x01(\tR\x05rrule\x124\n" +
+	"\adtstart\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adtstart\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\x18\n" +
+	"\x16ListRecurrencesRequest\"N\n" +
+	"\x17ListRecurrencesResponse\x123\n" +
+	"\vrecurrences\x18\x01 \x03(\v2\x11.proto.RecurrenceR\vrecurrences\")\n" +
+	"\x17DeleteRecurrenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteRecurrenceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x96\x01\n" +
+	"\rTaskBlueprint\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"due_offset\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"due_offset\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"\x9e\x02\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x0fchecklist_title\x18\x04 \x01(\tR\x0fchecklist_title\x12*\n" +
+	"\x05tasks\x18\x05 \x03(\v2\x14.proto.TaskBlueprintR\x05tasks\x12:\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xa8\x01\n" +
+	"\"CreateTemplateFromChecklistRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x0fchecklist_title\x18\x04 \x01(\tR\x0fchecklist_title\"'\n" +
+	"\x15TemplateActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14ListTemplatesRequest\"F\n" +
+	"\x15ListTemplatesResponse\x12-\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x0f.proto.TemplateR\ttemplates\"\x84\x02\n" +
+	"\x1aInstantiateTemplateRequest\x12 \n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\vtemplate_id\x12N\n" +
+	"\tvariables\x18\x02 \x03(\v20.proto.InstantiateTemplateRequest.VariablesEntryR\tvariables\x126\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstart_at\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x15TaskDependencyRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12$\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\rdepends_on_id\"O\n" +
+	"\rWorkflowState\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\ais_done\x18\x03 \x01(\bR\ais_done\"D\n" +
+	"\x12WorkflowTransition\x12\x18\n" +
+	"\n" +
+	"from_state\x18\x01 \x01(\tR\x04from\x12\x14\n" +
+	"\bto_state\x18\x02 \x01(\tR\x02to\"\xb9\x01\n" +
+	"\bWorkflow\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12,\n" +
+	"\x06states\x18\x02 \x03(\v2\x14.proto.WorkflowStateR\x06states\x12;\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x19.proto.WorkflowTransitionR\vtransitions\x12\x1e\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\n" +
+	"is_default\"\xa3\x01\n" +
+	"\x12SetWorkflowRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12,\n" +
+	"\x06states\x18\x02 \x03(\v2\x14.proto.WorkflowStateR\x06states\x12;\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x19.proto.WorkflowTransitionR\vtransitions\"8\n" +
+	"\x12GetWorkflowRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\"?\n" +
+	"\x15TransitionTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"5\n" +
+	"\x0fGetBoardRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\"\\\n" +
+	"\vBoardColumn\x12*\n" +
+	"\x05state\x18\x01 \x01(\v2\x14.proto.WorkflowStateR\x05state\x12!\n" +
+	"\x05tasks\x18\x02 \x03(\v2\v.proto.TaskR\x05tasks\"e\n" +
+	"\x05Board\x12.\n" +
+	"\tchecklist\x18\x01 \x01(\v2\x10.proto.ChecklistR\tchecklist\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.proto.BoardColumnR\acolumns\"\x8b\x01\n" +
+	"\x0fChecklistMember\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12:\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"V\n" +
+	"\x16ChecklistMemberRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\"9\n" +
+	"\x1dRemoveChecklistMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x1bListChecklistMembersRequest\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\"P\n" +
+	"\x1cListChecklistMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.proto.ChecklistMemberR\amembers\"I\n" +
+	"\x13TaskAssigneeRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\"\x97\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atask_id\x18\x02 \x01(\tR\atask_id\x12\x1c\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\tauthor_id\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12:\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x128\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tedited_at\"_\n" +
+	"\x11AddCommentRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12\x1c\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\tauthor_id\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"m\n" +
+	"\x13ListCommentsRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"l\n" +
+	"\x14ListCommentsResponse\x12*\n" +
+	"\bcomments\x18\x01 \x03(\v2\x0e.proto.CommentR\bcomments\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"p\n" +
+	"\x12EditCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atask_id\x18\x02 \x01(\tR\atask_id\x12\x1c\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\tauthor_id\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"^\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atask_id\x18\x02 \x01(\tR\atask_id\x12\x1c\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\tauthor_id\"1\n" +
+	"\x15DeleteCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atask_id\x18\x02 \x01(\tR\atask_id\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\"\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\n" +
+	"size_bytes\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12 \n" +
+	"\vstorage_key\x18\a \x01(\tR\vstorage_key\x12 \n" +
+	"\vuploaded_by\x18\b \x01(\tR\vuploaded_by\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xef\x01\n" +
+	"\x17CreateAttachmentRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\"\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\n" +
+	"size_bytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x12 \n" +
+	"\vstorage_key\x18\x06 \x01(\tR\vstorage_key\x12 \n" +
+	"\vuploaded_by\x18\a \x01(\tR\vuploaded_by\")\n" +
+	"\x17AttachmentActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16ListAttachmentsRequest\x12\x18\n" +
+	"\atask_id\x18\x01 \x01(\tR\atask_id\"N\n" +
+	"\x17ListAttachmentsResponse\x123\n" +
+	"\vattachments\x18\x01 \x03(\v2\x11.proto.AttachmentR\vattachments\"d\n" +
+	"\x12SearchTasksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\"\n" +
+	"\fchecklist_id\x18\x02 \x01(\tR\fchecklist_id\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xe9\x01\n" +
+	"\fSearchResult\x12\x1f\n" +
+	"\x04task\x18\x01 \x01(\v2\v.proto.TaskR\x04task\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12(\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0ftitle_highlight\x120\n" +
+	"\x13description_snippet\x18\x04 \x01(\tR\x13description_snippet\x12\x1e\n" +
+	"\n" +
+	"comment_id\x18\x05 \x01(\tR\n" +
+	"comment_id\x12(\n" +
+	"\x0fcomment_snippet\x18\x06 \x01(\tR\x0fcomment_snippet\"D\n" +
+	"\x13SearchTasksResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.proto.SearchResultR\aresults\"\xbf\x01\n" +
+	"\tSavedView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12:\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"D\n" +
+	"\x16CreateSavedViewRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\"T\n" +
+	"\x16UpdateSavedViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"(\n" +
+	"\x16SavedViewActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15ListSavedViewsRequest\"@\n" +
+	"\x16ListSavedViewsResponse\x12&\n" +
+	"\x05views\x18\x01 \x03(\v2\x10.proto.SavedViewR\x05views\"3\n" +
+	"\x17DeleteSavedViewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc9\x01\n" +
+	"\x0fGetStatsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\"\n" +
+	"\fchecklist_id\x18\x03 \x01(\tR\fchecklist_id\x12\x1a\n" +
+	"\bgroup_by\x18\x04 \x01(\tR\bgroup_by\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\xad\x02\n" +
+	"\x0eChecklistStats\x12\"\n" +
+	"\fchecklist_id\x18\x01 \x01(\tR\fchecklist_id\x12(\n" +
+	"\x0fchecklist_title\x18\x02 \x01(\tR\x0fchecklist_title\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x03R\tcompleted\x12*\n" +
+	"\x10completion_ratio\x18\x05 \x01(\x01R\x10completion_ratio\x12S\n" +
+	"\x17median_time_to_complete\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x17median_time_to_complete\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\x03R\aoverdue\"w\n" +
+	"\vStatsBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x03R\tcompleted\"\xad\x02\n" +
+	"\x05Stats\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\bgroup_by\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12-\n" +
+	"\x06totals\x18\x05 \x01(\v2\x15.proto.ChecklistStatsR\x06totals\x125\n" +
+	"\n" +
+	"checklists\x18\x06 \x03(\v2\x15.proto.ChecklistStatsR\n" +
+	"checklists\x12*\n" +
+	"\x06series\x18\a \x03(\v2\x12.proto.StatsBucketR\x06series2\xc0\x1e\n" +
+	"\x10ChecklistService\x12G\n" +
+	"\n" +
+	"CreateTask\x12\x18.proto.CreateTaskRequest\x1a\v.proto.Task\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/create\x12M\n" +
+	"\tListTasks\x12\x17.proto.ListTasksRequest\x1a\x18.proto.ListTasksResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/list\x12U\n" +
+	"\n" +
+	"DeleteTask\x12\x18.proto.TaskActionRequest\x1a\x19.proto.DeleteTaskResponse\"\x12\x82\xd3\xe4\x93\x02\f:\x01**\a/delete\x12G\n" +
+	"\fMarkTaskDone\x12\x18.proto.TaskActionRequest\x1a\v.proto.Task\"\x10\x82\xd3\xe4\x93\x02\n" +
+	":\x01*\x1a\x05/done\x12]\n" +
+	"\x0fCreateChecklist\x12\x1d.proto.CreateChecklistRequest\x1a\x10.proto.Checklist\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/checklists\x12e\n" +
+	"\x0eListChecklists\x12\x1c.proto.ListChecklistsRequest\x1a\x1d.proto.ListChecklistsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/checklists\x12\\\n" +
+	"\fGetChecklist\x12\x1d.proto.ChecklistActionRequest\x1a\x10.proto.Checklist\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/checklists/{id}\x12B\n" +
+	"\x0fCreateShareLink\x12\x1d.proto.CreateShareLinkRequest\x1a\x10.proto.ShareLink\x12M\n" +
+	"\x0eListShareLinks\x12\x1c.proto.ListShareLinksRequest\x1a\x1d.proto.ListShareLinksResponse\x12[\n" +
+	"\x0fRevokeShareLink\x12\x1d.proto.RevokeShareLinkRequest\x1a\x10.proto.ShareLink\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/shares/{id}\x12J\n" +
+	"\x10ResolveShareLink\x12\x1e.proto.ResolveShareLinkRequest\x1a\x16.proto.SharedChecklist\x12\x99\x01\n" +
+	"\rSetRecurrence\x12\x1b.proto.SetRecurrenceRequest\x1a\x11.proto.Recurrence\"X\x82\xd3\xe4\x93\x02R:\x01*Z-:\x01*\x1a(/v1/checklists/{checklist_id}/recurrence\x1a\x1e/v1/tasks/{task_id}/recurrence\x12i\n" +
+	"\x0fListRecurrences\x12\x1d.proto.ListRecurrencesRequest\x1a\x1e.proto.ListRecurrencesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/recurrences\x12q\n" +
+	"\x10DeleteRecurrence\x12\x1e.proto.DeleteRecurrenceRequest\x1a\x1f.proto.DeleteRecurrenceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/recurrences/{id}\x12s\n" +
+	"\x1bCreateTemplateFromChecklist\x12).proto.CreateTemplateFromChecklistRequest\x1a\x0f.proto.Template\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/templates\x12a\n" +
+	"\rListTemplates\x12\x1b.proto.ListTemplatesRequest\x1a\x1c.proto.ListTemplatesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/templates\x12X\n" +
+	"\vGetTemplate\x12\x1c.proto.TemplateActionRequest\x1a\x0f.proto.Template\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/templates/{id}\x12~\n" +
+	"\x13InstantiateTemplate\x12!.proto.InstantiateTemplateRequest\x1a\x10.proto.Checklist\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/templates/{template_id}/instantiate\x12g\n" +
+	"\rAddDependency\x12\x1c.proto.TaskDependencyRequest\x1a\v.proto.Task\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/tasks/{task_id}/dependencies\x12w\n" +
+	"\x10RemoveDependency\x12\x1c.proto.TaskDependencyRequest\x1a\v.proto.Task\"8\x82\xd3\xe4\x93\x022*0/v1/tasks/{task_id}/dependencies/{depends_on_id}\x12l\n" +
+	"\vSetWorkflow\x12\x19.proto.SetWorkflowRequest\x1a\x0f.proto.Workflow\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/checklists/{checklist_id}/workflow\x12i\n" +
+	"\vGetWorkflow\x12\x19.proto.GetWorkflowRequest\x1a\x0f.proto.Workflow\".\x82\xd3\xe4\x93\x02(\x12&/v1/checklists/{checklist_id}/workflow\x12a\n" +
+	"\x0eTransitionTask\x12\x1c.proto.TransitionTaskRequest\x1a\v.proto.Task\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/tasks/{id}/transition\x12]\n" +
+	"\bGetBoard\x12\x16.proto.GetBoardRequest\x1a\f.proto.Board\"+\x82\xd3\xe4\x93\x02%\x12#/v1/checklists/{checklist_id}/board\x12}\n" +
+	"\x12AddChecklistMember\x12\x1d.proto.ChecklistMemberRequest\x1a\x16.proto.ChecklistMember\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/checklists/{checklist_id}/members\x12\x95\x01\n" +
+	"\x15RemoveChecklistMember\x12\x1d.proto.ChecklistMemberRequest\x1a$.proto.RemoveChecklistMemberResponse\"7\x82\xd3\xe4\x93\x021*//v1/checklists/{checklist_id}/members/{user_id}\x12\x8e\x01\n" +
+	"\x14ListChecklistMembers\x12\".proto.ListChecklistMembersRequest\x1a#.proto.ListChecklistMembersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/checklists/{checklist_id}/members\x12_\n" +
 	"\n" +
-	"CreateTask\x12\x18.proto.CreateTaskRequest\x1a\v.proto.Task\x12>\n" +
-	"\tListTasks\x12\x17.proto.ListTasksRequest\x1a\x18.proto.ListTasksResponse\x12A\n" +
+	"AssignTask\x12\x1a.proto.TaskAssigneeRequest\x1a\v.proto.Task\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tasks/{task_id}/assignees\x12h\n" +
+	"\fUnassignTask\x12\x1a.proto.TaskAssigneeRequest\x1a\v.proto.Task\"/\x82\xd3\xe4\x93\x02)*'/v1/tasks/{task_id}/assignees/{user_id}\x12E\n" +
+	"\x10CreateAttachment\x12\x1e.proto.CreateAttachmentRequest\x1a\x11.proto.Attachment\x12P\n" +
+	"\x0fListAttachments\x12\x1d.proto.ListAttachmentsRequest\x1a\x1e.proto.ListAttachmentsResponse\x12B\n" +
+	"\rGetAttachment\x12\x1e.proto.AttachmentActionRequest\x1a\x11.proto.Attachment\x12E\n" +
+	"\x10DeleteAttachment\x12\x1e.proto.AttachmentActionRequest\x1a\x11.proto.Attachment\x12D\n" +
+	"\vSearchTasks\x12\x19.proto.SearchTasksRequest\x1a\x1a.proto.SearchTasksResponse\x12X\n" +
+	"\x0fCreateSavedView\x12\x1d.proto.CreateSavedViewRequest\x1a\x10.proto.SavedView\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/views\x12`\n" +
+	"\x0eListSavedViews\x12\x1c.proto.ListSavedViewsRequest\x1a\x1d.proto.ListSavedViewsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/views\x12W\n" +
+	"\fGetSavedView\x12\x1d.proto.SavedViewActionRequest\x1a\x10.proto.SavedView\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/views/{id}\x12]\n" +
+	"\x0fUpdateSavedView\x12\x1d.proto.UpdateSavedViewRequest\x1a\x10.proto.SavedView\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/views/{id}\x12h\n" +
+	"\x0fDeleteSavedView\x12\x1d.proto.SavedViewActionRequest\x1a\x1e.proto.DeleteSavedViewResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/views/{id}\x120\n" +
+	"\bGetStats\x12\x16.proto.GetStatsRequest\x1a\f.proto.Stats2\x97\x02\n" +
+	"\x0eCommentService\x126\n" +
 	"\n" +
-	"DeleteTask\x12\x18.proto.TaskActionRequest\x1a\x19.proto.DeleteTaskResponse\x125\n" +
-	"\fMarkTaskDone\x12\x18.proto.TaskActionRequest\x1a\v.proto.TaskB\x14Z\x12checklist-go/protob\x06proto3"
+	"AddComment\x12\x18.proto.AddCommentRequest\x1a\x0e.proto.Comment\x12G\n" +
+	"\fListComments\x12\x1a.proto.ListCommentsRequest\x1a\x1b.proto.ListCommentsResponse\x128\n" +
+	"\vEditComment\x12\x19.proto.EditCommentRequest\x1a\x0e.proto.Comment\x12J\n" +
+	"\rDeleteComment\x12\x1b.proto.DeleteCommentRequest\x1a\x1c.proto.DeleteCommentResponseB\x14Z\x12checklist-go/protob\x06proto3"
 
 var (
 	file_proto_checklist_proto_rawDescOnce sync.Once
@@ -376,33 +4877,239 @@ func file_proto_checklist_proto_rawDescGZIP() []byte {
 	return file_proto_checklist_proto_rawDescData
 }
 
-var file_proto_checklist_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_checklist_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_proto_checklist_proto_goTypes = []any{
-	(*CreateTaskRequest)(nil),     // 0: proto.CreateTaskRequest
-	(*Task)(nil),                  // 1: proto.Task
-	(*TaskActionRequest)(nil),     // 2: proto.TaskActionRequest
-	(*DeleteTaskResponse)(nil),    // 3: proto.DeleteTaskResponse
-	(*ListTasksRequest)(nil),      // 4: proto.ListTasksRequest
-	(*ListTasksResponse)(nil),     // 5: proto.ListTasksResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*CreateTaskRequest)(nil),                  // 0: proto.CreateTaskRequest
+	(*Task)(nil),                               // 1: proto.Task
+	(*TaskActionRequest)(nil),                  // 2: proto.TaskActionRequest
+	(*DeleteTaskResponse)(nil),                 // 3: proto.DeleteTaskResponse
+	(*ListTasksRequest)(nil),                   // 4: proto.ListTasksRequest
+	(*ListTasksResponse)(nil),                  // 5: proto.ListTasksResponse
+	(*Checklist)(nil),                          // 6: proto.Checklist
+	(*CreateChecklistRequest)(nil),             // 7: proto.CreateChecklistRequest
+	(*ChecklistActionRequest)(nil),             // 8: proto.ChecklistActionRequest
+	(*ListChecklistsRequest)(nil),              // 9: proto.ListChecklistsRequest
+	(*ListChecklistsResponse)(nil),             // 10: proto.ListChecklistsResponse
+	(*ShareLink)(nil),                          // 11: proto.ShareLink
+	(*CreateShareLinkRequest)(nil),             // 12: proto.CreateShareLinkRequest
+	(*ListShareLinksRequest)(nil),              // 13: proto.ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),             // 14: proto.ListShareLinksResponse
+	(*RevokeShareLinkRequest)(nil),             // 15: proto.RevokeShareLinkRequest
+	(*ResolveShareLinkRequest)(nil),            // 16: proto.ResolveShareLinkRequest
+	(*SharedChecklist)(nil),                    // 17: proto.SharedChecklist
+	(*Recurrence)(nil),                         // 18: proto.Recurrence
+	(*SetRecurrenceRequest)(nil),               // 19: proto.SetRecurrenceRequest
+	(*ListRecurrencesRequest)(nil),             // 20: proto.ListRecurrencesRequest
+	(*ListRecurrencesResponse)(nil),            // 21: proto.ListRecurrencesResponse
+	(*DeleteRecurrenceRequest)(nil),            // 22: proto.DeleteRecurrenceRequest
+	(*DeleteRecurrenceResponse)(nil),           // 23: proto.DeleteRecurrenceResponse
+	(*TaskBlueprint)(nil),                      // 24: proto.TaskBlueprint
+	(*Template)(nil),                           // 25: proto.Template
+	(*CreateTemplateFromChecklistRequest)(nil), // 26: proto.CreateTemplateFromChecklistRequest
+	(*TemplateActionRequest)(nil),              // 27: proto.TemplateActionRequest
+	(*ListTemplatesRequest)(nil),               // 28: proto.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),              // 29: proto.ListTemplatesResponse
+	(*InstantiateTemplateRequest)(nil),         // 30: proto.InstantiateTemplateRequest
+	(*TaskDependencyRequest)(nil),              // 31: proto.TaskDependencyRequest
+	(*WorkflowState)(nil),                      // 32: proto.WorkflowState
+	(*WorkflowTransition)(nil),                 // 33: proto.WorkflowTransition
+	(*Workflow)(nil),                           // 34: proto.Workflow
+	(*SetWorkflowRequest)(nil),                 // 35: proto.SetWorkflowRequest
+	(*GetWorkflowRequest)(nil),                 // 36: proto.GetWorkflowRequest
+	(*TransitionTaskRequest)(nil),              // 37: proto.TransitionTaskRequest
+	(*GetBoardRequest)(nil),                    // 38: proto.GetBoardRequest
+	(*BoardColumn)(nil),                        // 39: proto.BoardColumn
+	(*Board)(nil),                              // 40: proto.Board
+	(*ChecklistMember)(nil),                    // 41: proto.ChecklistMember
+	(*ChecklistMemberRequest)(nil),             // 42: proto.ChecklistMemberRequest
+	(*RemoveChecklistMemberResponse)(nil),      // 43: proto.RemoveChecklistMemberResponse
+	(*ListChecklistMembersRequest)(nil),        // 44: proto.ListChecklistMembersRequest
+	(*ListChecklistMembersResponse)(nil),       // 45: proto.ListChecklistMembersResponse
+	(*TaskAssigneeRequest)(nil),                // 46: proto.TaskAssigneeRequest
+	(*Comment)(nil),                            // 47: proto.Comment
+	(*AddCommentRequest)(nil),                  // 48: proto.AddCommentRequest
+	(*ListCommentsRequest)(nil),                // 49: proto.ListCommentsRequest
+	(*ListCommentsResponse)(nil),               // 50: proto.ListCommentsResponse
+	(*EditCommentRequest)(nil),                 // 51: proto.EditCommentRequest
+	(*DeleteCommentRequest)(nil),               // 52: proto.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),              // 53: proto.DeleteCommentResponse
+	(*Attachment)(nil),                         // 54: proto.Attachment
+	(*CreateAttachmentRequest)(nil),            // 55: proto.CreateAttachmentRequest
+	(*AttachmentActionRequest)(nil),            // 56: proto.AttachmentActionRequest
+	(*ListAttachmentsRequest)(nil),             // 57: proto.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),            // 58: proto.ListAttachmentsResponse
+	(*SearchTasksRequest)(nil),                 // 59: proto.SearchTasksRequest
+	(*SearchResult)(nil),                       // 60: proto.SearchResult
+	(*SearchTasksResponse)(nil),                // 61: proto.SearchTasksResponse
+	(*SavedView)(nil),                          // 62: proto.SavedView
+	(*CreateSavedViewRequest)(nil),             // 63: proto.CreateSavedViewRequest
+	(*UpdateSavedViewRequest)(nil),             // 64: proto.UpdateSavedViewRequest
+	(*SavedViewActionRequest)(nil),             // 65: proto.SavedViewActionRequest
+	(*ListSavedViewsRequest)(nil),              // 66: proto.ListSavedViewsRequest
+	(*ListSavedViewsResponse)(nil),             // 67: proto.ListSavedViewsResponse
+	(*DeleteSavedViewResponse)(nil),            // 68: proto.DeleteSavedViewResponse
+	(*GetStatsRequest)(nil),                    // 69: proto.GetStatsRequest
+	(*ChecklistStats)(nil),                     // 70: proto.ChecklistStats
+	(*StatsBucket)(nil),                        // 71: proto.StatsBucket
+	(*Stats)(nil),                              // 72: proto.Stats
+	nil,                                        // 73: proto.InstantiateTemplateRequest.VariablesEntry
+	(*timestamppb.Timestamp)(nil),              // 74: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 75: google.protobuf.Duration
 }
 var file_proto_checklist_proto_depIdxs = []int32{
-	6, // 0: proto.Task.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: proto.Task.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 2: proto.ListTasksResponse.tasks:type_name -> proto.Task
-	0, // 3: proto.ChecklistService.CreateTask:input_type -> proto.CreateTaskRequest
-	4, // 4: proto.ChecklistService.ListTasks:input_type -> proto.ListTasksRequest
-	2, // 5: proto.ChecklistService.DeleteTask:input_type -> proto.TaskActionRequest
-	2, // 6: proto.ChecklistService.MarkTaskDone:input_type -> proto.TaskActionRequest
-	1, // 7: proto.ChecklistService.CreateTask:output_type -> proto.Task
-	5, // 8: proto.ChecklistService.ListTasks:output_type -> proto.ListTasksResponse
-	3, // 9: proto.ChecklistService.DeleteTask:output_type -> proto.DeleteTaskResponse
-	1, // 10: proto.ChecklistService.MarkTaskDone:output_type -> proto.Task
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	74,  // 0: proto.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	74,  // 1: proto.Task.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 2: proto.Task.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 3: proto.Task.due_at:type_name -> google.protobuf.Timestamp
+	74,  // 4: proto.Task.completed_at:type_name -> google.protobuf.Timestamp
+	1,   // 5: proto.ListTasksResponse.tasks:type_name -> proto.Task
+	74,  // 6: proto.Checklist.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 7: proto.Checklist.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 8: proto.Checklist.occurrence_at:type_name -> google.protobuf.Timestamp
+	6,   // 9: proto.ListChecklistsResponse.checklists:type_name -> proto.Checklist
+	74,  // 10: proto.ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	74,  // 11: proto.ShareLink.revoked_at:type_name -> google.protobuf.Timestamp
+	74,  // 12: proto.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 13: proto.CreateShareLinkRequest.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 14: proto.ListShareLinksResponse.share_links:type_name -> proto.ShareLink
+	6,   // 15: proto.SharedChecklist.checklist:type_name -> proto.Checklist
+	1,   // 16: proto.SharedChecklist.tasks:type_name -> proto.Task
+	74,  // 17: proto.Recurrence.dtstart:type_name -> google.protobuf.Timestamp
+	74,  // 18: proto.Recurrence.next_occurrence_at:type_name -> google.protobuf.Timestamp
+	74,  // 19: proto.Recurrence.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 20: proto.Recurrence.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 21: proto.SetRecurrenceRequest.dtstart:type_name -> google.protobuf.Timestamp
+	18,  // 22: proto.ListRecurrencesResponse.recurrences:type_name -> proto.Recurrence
+	75,  // 23: proto.TaskBlueprint.due_offset:type_name -> google.protobuf.Duration
+	24,  // 24: proto.Template.tasks:type_name -> proto.TaskBlueprint
+	74,  // 25: proto.Template.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 26: proto.Template.updated_at:type_name -> google.protobuf.Timestamp
+	25,  // 27: proto.ListTemplatesResponse.templates:type_name -> proto.Template
+	73,  // 28: proto.InstantiateTemplateRequest.variables:type_name -> proto.InstantiateTemplateRequest.VariablesEntry
+	74,  // 29: proto.InstantiateTemplateRequest.start_at:type_name -> google.protobuf.Timestamp
+	32,  // 30: proto.Workflow.states:type_name -> proto.WorkflowState
+	33,  // 31: proto.Workflow.transitions:type_name -> proto.WorkflowTransition
+	32,  // 32: proto.SetWorkflowRequest.states:type_name -> proto.WorkflowState
+	33,  // 33: proto.SetWorkflowRequest.transitions:type_name -> proto.WorkflowTransition
+	32,  // 34: proto.BoardColumn.state:type_name -> proto.WorkflowState
+	1,   // 35: proto.BoardColumn.tasks:type_name -> proto.Task
+	6,   // 36: proto.Board.checklist:type_name -> proto.Checklist
+	39,  // 37: proto.Board.columns:type_name -> proto.BoardColumn
+	74,  // 38: proto.ChecklistMember.created_at:type_name -> google.protobuf.Timestamp
+	41,  // 39: proto.ListChecklistMembersResponse.members:type_name -> proto.ChecklistMember
+	74,  // 40: proto.Comment.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 41: proto.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 42: proto.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	47,  // 43: proto.ListCommentsResponse.comments:type_name -> proto.Comment
+	74,  // 44: proto.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	54,  // 45: proto.ListAttachmentsResponse.attachments:type_name -> proto.Attachment
+	1,   // 46: proto.SearchResult.task:type_name -> proto.Task
+	60,  // 47: proto.SearchTasksResponse.results:type_name -> proto.SearchResult
+	74,  // 48: proto.SavedView.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 49: proto.SavedView.updated_at:type_name -> google.protobuf.Timestamp
+	62,  // 50: proto.ListSavedViewsResponse.views:type_name -> proto.SavedView
+	74,  // 51: proto.GetStatsRequest.from:type_name -> google.protobuf.Timestamp
+	74,  // 52: proto.GetStatsRequest.to:type_name -> google.protobuf.Timestamp
+	75,  // 53: proto.ChecklistStats.median_time_to_complete:type_name -> google.protobuf.Duration
+	74,  // 54: proto.StatsBucket.start:type_name -> google.protobuf.Timestamp
+	74,  // 55: proto.Stats.from:type_name -> google.protobuf.Timestamp
+	74,  // 56: proto.Stats.to:type_name -> google.protobuf.Timestamp
+	70,  // 57: proto.Stats.totals:type_name -> proto.ChecklistStats
+	70,  // 58: proto.Stats.checklists:type_name -> proto.ChecklistStats
+	71,  // 59: proto.Stats.series:type_name -> proto.StatsBucket
+	0,   // 60: proto.ChecklistService.CreateTask:input_type -> proto.CreateTaskRequest
+	4,   // 61: proto.ChecklistService.ListTasks:input_type -> proto.ListTasksRequest
+	2,   // 62: proto.ChecklistService.DeleteTask:input_type -> proto.TaskActionRequest
+	2,   // 63: proto.ChecklistService.MarkTaskDone:input_type -> proto.TaskActionRequest
+	7,   // 64: proto.ChecklistService.CreateChecklist:input_type -> proto.CreateChecklistRequest
+	9,   // 65: proto.ChecklistService.ListChecklists:input_type -> proto.ListChecklistsRequest
+	8,   // 66: proto.ChecklistService.GetChecklist:input_type -> proto.ChecklistActionRequest
+	12,  // 67: proto.ChecklistService.CreateShareLink:input_type -> proto.CreateShareLinkRequest
+	13,  // 68: proto.ChecklistService.ListShareLinks:input_type -> proto.ListShareLinksRequest
+	15,  // 69: proto.ChecklistService.RevokeShareLink:input_type -> proto.RevokeShareLinkRequest
+	16,  // 70: proto.ChecklistService.ResolveShareLink:input_type -> proto.ResolveShareLinkRequest
+	19,  // 71: proto.ChecklistService.SetRecurrence:input_type -> proto.SetRecurrenceRequest
+	20,  // 72: proto.ChecklistService.ListRecurrences:input_type -> proto.ListRecurrencesRequest
+	22,  // 73: proto.ChecklistService.DeleteRecurrence:input_type -> proto.DeleteRecurrenceRequest
+	26,  // 74: proto.ChecklistService.CreateTemplateFromChecklist:input_type -> proto.CreateTemplateFromChecklistRequest
+	28,  // 75: proto.ChecklistService.ListTemplates:input_type -> proto.ListTemplatesRequest
+	27,  // 76: proto.ChecklistService.GetTemplate:input_type -> proto.TemplateActionRequest
+	30,  // 77: proto.ChecklistService.InstantiateTemplate:input_type -> proto.InstantiateTemplateRequest
+	31,  // 78: proto.ChecklistService.AddDependency:input_type -> proto.TaskDependencyRequest
+	31,  // 79: proto.ChecklistService.RemoveDependency:input_type -> proto.TaskDependencyRequest
+	35,  // 80: proto.ChecklistService.SetWorkflow:input_type -> proto.SetWorkflowRequest
+	36,  // 81: proto.ChecklistService.GetWorkflow:input_type -> proto.GetWorkflowRequest
+	37,  // 82: proto.ChecklistService.TransitionTask:input_type -> proto.TransitionTaskRequest
+	38,  // 83: proto.ChecklistService.GetBoard:input_type -> proto.GetBoardRequest
+	42,  // 84: proto.ChecklistService.AddChecklistMember:input_type -> proto.ChecklistMemberRequest
+	42,  // 85: proto.ChecklistService.RemoveChecklistMember:input_type -> proto.ChecklistMemberRequest
+	44,  // 86: proto.ChecklistService.ListChecklistMembers:input_type -> proto.ListChecklistMembersRequest
+	46,  // 87: proto.ChecklistService.AssignTask:input_type -> proto.TaskAssigneeRequest
+	46,  // 88: proto.ChecklistService.UnassignTask:input_type -> proto.TaskAssigneeRequest
+	55,  // 89: proto.ChecklistService.CreateAttachment:input_type -> proto.CreateAttachmentRequest
+	57,  // 90: proto.ChecklistService.ListAttachments:input_type -> proto.ListAttachmentsRequest
+	56,  // 91: proto.ChecklistService.GetAttachment:input_type -> proto.AttachmentActionRequest
+	56,  // 92: proto.ChecklistService.DeleteAttachment:input_type -> proto.AttachmentActionRequest
+	59,  // 93: proto.ChecklistService.SearchTasks:input_type -> proto.SearchTasksRequest
+	63,  // 94: proto.ChecklistService.CreateSavedView:input_type -> proto.CreateSavedViewRequest
+	66,  // 95: proto.ChecklistService.ListSavedViews:input_type -> proto.ListSavedViewsRequest
+	65,  // 96: proto.ChecklistService.GetSavedView:input_type -> proto.SavedViewActionRequest
+	64,  // 97: proto.ChecklistService.UpdateSavedView:input_type -> proto.UpdateSavedViewRequest
+	65,  // 98: proto.ChecklistService.DeleteSavedView:input_type -> proto.SavedViewActionRequest
+	69,  // 99: proto.ChecklistService.GetStats:input_type -> proto.GetStatsRequest
+	48,  // 100: proto.CommentService.AddComment:input_type -> proto.AddCommentRequest
+	49,  // 101: proto.CommentService.ListComments:input_type -> proto.ListCommentsRequest
+	51,  // 102: proto.CommentService.EditComment:input_type -> proto.EditCommentRequest
+	52,  // 103: proto.CommentService.DeleteComment:input_type -> proto.DeleteCommentRequest
+	1,   // 104: proto.ChecklistService.CreateTask:output_type -> proto.Task
+	5,   // 105: proto.ChecklistService.ListTasks:output_type -> proto.ListTasksResponse
+	3,   // 106: proto.ChecklistService.DeleteTask:output_type -> proto.DeleteTaskResponse
+	1,   // 107: proto.ChecklistService.MarkTaskDone:output_type -> proto.Task
+	6,   // 108: proto.ChecklistService.CreateChecklist:output_type -> proto.Checklist
+	10,  // 109: proto.ChecklistService.ListChecklists:output_type -> proto.ListChecklistsResponse
+	6,   // 110: proto.ChecklistService.GetChecklist:output_type -> proto.Checklist
+	11,  // 111: proto.ChecklistService.CreateShareLink:output_type -> proto.ShareLink
+	14,  // 112: proto.ChecklistService.ListShareLinks:output_type -> proto.ListShareLinksResponse
+	11,  // 113: proto.ChecklistService.RevokeShareLink:output_type -> proto.ShareLink
+	17,  // 114: proto.ChecklistService.ResolveShareLink:output_type -> proto.SharedChecklist
+	18,  // 115: proto.ChecklistService.SetRecurrence:output_type -> proto.Recurrence
+	21,  // 116: proto.ChecklistService.ListRecurrences:output_type -> proto.ListRecurrencesResponse
+	23,  // 117: proto.ChecklistService.DeleteRecurrence:output_type -> proto.DeleteRecurrenceResponse
+	25,  // 118: proto.ChecklistService.CreateTemplateFromChecklist:output_type -> proto.Template
+	29,  // 119: proto.ChecklistService.ListTemplates:output_type -> proto.ListTemplatesResponse
+	25,  // 120: proto.ChecklistService.GetTemplate:output_type -> proto.Template
+	6,   // 121: proto.ChecklistService.InstantiateTemplate:output_type -> proto.Checklist
+	1,   // 122: proto.ChecklistService.AddDependency:output_type -> proto.Task
+	1,   // 123: proto.ChecklistService.RemoveDependency:output_type -> proto.Task
+	34,  // 124: proto.ChecklistService.SetWorkflow:output_type -> proto.Workflow
+	34,  // 125: proto.ChecklistService.GetWorkflow:output_type -> proto.Workflow
+	1,   // 126: proto.ChecklistService.TransitionTask:output_type -> proto.Task
+	40,  // 127: proto.ChecklistService.GetBoard:output_type -> proto.Board
+	41,  // 128: proto.ChecklistService.AddChecklistMember:output_type -> proto.ChecklistMember
+	43,  // 129: proto.ChecklistService.RemoveChecklistMember:output_type -> proto.RemoveChecklistMemberResponse
+	45,  // 130: proto.ChecklistService.ListChecklistMembers:output_type -> proto.ListChecklistMembersResponse
+	1,   // 131: proto.ChecklistService.AssignTask:output_type -> proto.Task
+	1,   // 132: proto.ChecklistService.UnassignTask:output_type -> proto.Task
+	54,  // 133: proto.ChecklistService.CreateAttachment:output_type -> proto.Attachment
+	58,  // 134: proto.ChecklistService.ListAttachments:output_type -> proto.ListAttachmentsResponse
+	54,  // 135: proto.ChecklistService.GetAttachment:output_type -> proto.Attachment
+	54,  // 136: proto.ChecklistService.DeleteAttachment:output_type -> proto.Attachment
+	61,  // 137: proto.ChecklistService.SearchTasks:output_type -> proto.SearchTasksResponse
+	62,  // 138: proto.ChecklistService.CreateSavedView:output_type -> proto.SavedView
+	67,  // 139: proto.ChecklistService.ListSavedViews:output_type -> proto.ListSavedViewsResponse
+	62,  // 140: proto.ChecklistService.GetSavedView:output_type -> proto.SavedView
+	62,  // 141: proto.ChecklistService.UpdateSavedView:output_type -> proto.SavedView
+	68,  // 142: proto.ChecklistService.DeleteSavedView:output_type -> proto.DeleteSavedViewResponse
+	72,  // 143: proto.ChecklistService.GetStats:output_type -> proto.Stats
+	47,  // 144: proto.CommentService.AddComment:output_type -> proto.Comment
+	50,  // 145: proto.CommentService.ListComments:output_type -> proto.ListCommentsResponse
+	47,  // 146: proto.CommentService.EditComment:output_type -> proto.Comment
+	53,  // 147: proto.CommentService.DeleteComment:output_type -> proto.DeleteCommentResponse
+	104, // [104:148] is the sub-list for method output_type
+	60,  // [60:104] is the sub-list for method input_type
+	60,  // [60:60] is the sub-list for extension type_name
+	60,  // [60:60] is the sub-list for extension extendee
+	0,   // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_proto_checklist_proto_init() }
@@ -416,9 +5123,9 @@ func file_proto_checklist_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_checklist_proto_rawDesc), len(file_proto_checklist_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   74,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_checklist_proto_goTypes,
 		DependencyIndexes: file_proto_checklist_proto_depIdxs,
@@ -19,10 +19,46 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChecklistService_CreateTask_FullMethodName   = "/proto.ChecklistService/CreateTask"
-	ChecklistService_ListTasks_FullMethodName    = "/proto.ChecklistService/ListTasks"
-	ChecklistService_DeleteTask_FullMethodName   = "/proto.ChecklistService/DeleteTask"
-	ChecklistService_MarkTaskDone_FullMethodName = "/proto.ChecklistService/MarkTaskDone"
+	ChecklistService_CreateTask_FullMethodName                  = "/proto.ChecklistService/CreateTask"
+	ChecklistService_ListTasks_FullMethodName                   = "/proto.ChecklistService/ListTasks"
+	ChecklistService_DeleteTask_FullMethodName                  = "/proto.ChecklistService/DeleteTask"
+	ChecklistService_MarkTaskDone_FullMethodName                = "/proto.ChecklistService/MarkTaskDone"
+	ChecklistService_CreateChecklist_FullMethodName             = "/proto.ChecklistService/CreateChecklist"
+	ChecklistService_ListChecklists_FullMethodName              = "/proto.ChecklistService/ListChecklists"
+	ChecklistService_GetChecklist_FullMethodName                = "/proto.ChecklistService/GetChecklist"
+	ChecklistService_CreateShareLink_FullMethodName             = "/proto.ChecklistService/CreateShareLink"
+	ChecklistService_ListShareLinks_FullMethodName              = "/proto.ChecklistService/ListShareLinks"
+	ChecklistService_RevokeShareLink_FullMethodName             = "/proto.ChecklistService/RevokeShareLink"
+	ChecklistService_ResolveShareLink_FullMethodName            = "/proto.ChecklistService/ResolveShareLink"
+	ChecklistService_SetRecurrence_FullMethodName               = "/proto.ChecklistService/SetRecurrence"
+	ChecklistService_ListRecurrences_FullMethodName             = "/proto.ChecklistService/ListRecurrences"
+	ChecklistService_DeleteRecurrence_FullMethodName            = "/proto.ChecklistService/DeleteRecurrence"
+	ChecklistService_CreateTemplateFromChecklist_FullMethodName = "/proto.ChecklistService/CreateTemplateFromChecklist"
+	ChecklistService_ListTemplates_FullMethodName               = "/proto.ChecklistService/ListTemplates"
+	ChecklistService_GetTemplate_FullMethodName                 = "/proto.ChecklistService/GetTemplate"
+	ChecklistService_InstantiateTemplate_FullMethodName         = "/proto.ChecklistService/InstantiateTemplate"
+	ChecklistService_AddDependency_FullMethodName               = "/proto.ChecklistService/AddDependency"
+	ChecklistService_RemoveDependency_FullMethodName            = "/proto.ChecklistService/RemoveDependency"
+	ChecklistService_SetWorkflow_FullMethodName                 = "/proto.ChecklistService/SetWorkflow"
+	ChecklistService_GetWorkflow_FullMethodName                 = "/proto.ChecklistService/GetWorkflow"
+	ChecklistService_TransitionTask_FullMethodName              = "/proto.ChecklistService/TransitionTask"
+	ChecklistService_GetBoard_FullMethodName                    = "/proto.ChecklistService/GetBoard"
+	ChecklistService_AddChecklistMember_FullMethodName          = "/proto.ChecklistService/AddChecklistMember"
+	ChecklistService_RemoveChecklistMember_FullMethodName       = "/proto.ChecklistService/RemoveChecklistMember"
+	ChecklistService_ListChecklistMembers_FullMethodName        = "/proto.ChecklistService/ListChecklistMembers"
+	ChecklistService_AssignTask_FullMethodName                  = "/proto.ChecklistService/AssignTask"
+	ChecklistService_UnassignTask_FullMethodName                = "/proto.ChecklistService/UnassignTask"
+	ChecklistService_CreateAttachment_FullMethodName            = "/proto.ChecklistService/CreateAttachment"
+	ChecklistService_ListAttachments_FullMethodName             = "/proto.ChecklistService/ListAttachments"
+	ChecklistService_GetAttachment_FullMethodName               = "/proto.ChecklistService/GetAttachment"
+	ChecklistService_DeleteAttachment_FullMethodName            = "/proto.ChecklistService/DeleteAttachment"
+	ChecklistService_SearchTasks_FullMethodName                 = "/proto.ChecklistService/SearchTasks"
+	ChecklistService_CreateSavedView_FullMethodName             = "/proto.ChecklistService/CreateSavedView"
+	ChecklistService_ListSavedViews_FullMethodName              = "/proto.ChecklistService/ListSavedViews"
+	ChecklistService_GetSavedView_FullMethodName                = "/proto.ChecklistService/GetSavedView"
+	ChecklistService_UpdateSavedView_FullMethodName             = "/proto.ChecklistService/UpdateSavedView"
+	ChecklistService_DeleteSavedView_FullMethodName             = "/proto.ChecklistService/DeleteSavedView"
+	ChecklistService_GetStats_FullMethodName                    = "/proto.ChecklistService/GetStats"
 )
 
 // ChecklistServiceClient is the client API for ChecklistService service.
@@ -37,6 +73,78 @@ type ChecklistServiceClient interface {
 	DeleteTask(ctx context.Context, in *TaskActionRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
 	// Для PUT /done
 	MarkTaskDone(ctx context.Context, in *TaskActionRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для POST /v1/checklists
+	CreateChecklist(ctx context.Context, in *CreateChecklistRequest, opts ...grpc.CallOption) (*Checklist, error)
+	// Для GET /v1/checklists
+	ListChecklists(ctx context.Context, in *ListChecklistsRequest, opts ...grpc.CallOption) (*ListChecklistsResponse, error)
+	// Для GET /v1/checklists/{id}
+	GetChecklist(ctx context.Context, in *ChecklistActionRequest, opts ...grpc.CallOption) (*Checklist, error)
+	// Для POST /v1/checklists/{id}/shares
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	// Для GET /v1/checklists/{id}/shares
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	// Для DELETE /v1/shares/{id}
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	// Для GET /share/{token}
+	ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*SharedChecklist, error)
+	// Для PUT /v1/tasks/{id}/recurrence и PUT /v1/checklists/{id}/recurrence
+	SetRecurrence(ctx context.Context, in *SetRecurrenceRequest, opts ...grpc.CallOption) (*Recurrence, error)
+	// Для GET /v1/recurrences
+	ListRecurrences(ctx context.Context, in *ListRecurrencesRequest, opts ...grpc.CallOption) (*ListRecurrencesResponse, error)
+	// Для DELETE /v1/recurrences/{id}
+	DeleteRecurrence(ctx context.Context, in *DeleteRecurrenceRequest, opts ...grpc.CallOption) (*DeleteRecurrenceResponse, error)
+	// Для POST /v1/templates
+	CreateTemplateFromChecklist(ctx context.Context, in *CreateTemplateFromChecklistRequest, opts ...grpc.CallOption) (*Template, error)
+	// Для GET /v1/templates
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// Для GET /v1/templates/{id}
+	GetTemplate(ctx context.Context, in *TemplateActionRequest, opts ...grpc.CallOption) (*Template, error)
+	// Для POST /v1/templates/{id}/instantiate
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*Checklist, error)
+	// Для POST /v1/tasks/{id}/dependencies
+	AddDependency(ctx context.Context, in *TaskDependencyRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для DELETE /v1/tasks/{id}/dependencies/{depends_on_id}
+	RemoveDependency(ctx context.Context, in *TaskDependencyRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для PUT /v1/checklists/{id}/workflow
+	SetWorkflow(ctx context.Context, in *SetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	// Для GET /v1/checklists/{id}/workflow
+	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	// Для POST /v1/tasks/{id}/transition
+	TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для GET /v1/checklists/{id}/board
+	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*Board, error)
+	// Для POST /v1/checklists/{id}/members
+	AddChecklistMember(ctx context.Context, in *ChecklistMemberRequest, opts ...grpc.CallOption) (*ChecklistMember, error)
+	// Для DELETE /v1/checklists/{id}/members/{user_id}
+	RemoveChecklistMember(ctx context.Context, in *ChecklistMemberRequest, opts ...grpc.CallOption) (*RemoveChecklistMemberResponse, error)
+	// Для GET /v1/checklists/{id}/members
+	ListChecklistMembers(ctx context.Context, in *ListChecklistMembersRequest, opts ...grpc.CallOption) (*ListChecklistMembersResponse, error)
+	// Для POST /v1/tasks/{id}/assignees
+	AssignTask(ctx context.Context, in *TaskAssigneeRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для DELETE /v1/tasks/{id}/assignees/{user_id}
+	UnassignTask(ctx context.Context, in *TaskAssigneeRequest, opts ...grpc.CallOption) (*Task, error)
+	// Для POST /v1/tasks/{id}/attachments
+	CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// Для GET /v1/tasks/{id}/attachments
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Для GET /v1/attachments/{id} и GET /v1/attachments/{id}/content
+	GetAttachment(ctx context.Context, in *AttachmentActionRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// Для DELETE /v1/attachments/{id}; возвращает удаленные метаданные, чтобы удалить содержимое
+	DeleteAttachment(ctx context.Context, in *AttachmentActionRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// Для GET /v1/search
+	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
+	// Для POST /v1/views
+	CreateSavedView(ctx context.Context, in *CreateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error)
+	// Для GET /v1/views
+	ListSavedViews(ctx context.Context, in *ListSavedViewsRequest, opts ...grpc.CallOption) (*ListSavedViewsResponse, error)
+	// Для GET /v1/views/{id}
+	GetSavedView(ctx context.Context, in *SavedViewActionRequest, opts ...grpc.CallOption) (*SavedView, error)
+	// Для PUT /v1/views/{id}
+	UpdateSavedView(ctx context.Context, in *UpdateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error)
+	// Для DELETE /v1/views/{id}
+	DeleteSavedView(ctx context.Context, in *SavedViewActionRequest, opts ...grpc.CallOption) (*DeleteSavedViewResponse, error)
+	// Для GET /v1/stats
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error)
 }
 
 type checklistServiceClient struct {
@@ -87,155 +195,1711 @@ func (c *checklistServiceClient) MarkTaskDone(ctx context.Context, in *TaskActio
 	return out, nil
 }
 
+func (c *checklistServiceClient) CreateChecklist(ctx context.Context, in *CreateChecklistRequest, opts ...grpc.CallOption) (*Checklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Checklist)
+	err := c.cc.Invoke(ctx, ChecklistService_CreateChecklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListChecklists(ctx context.Context, in *ListChecklistsRequest, opts ...grpc.CallOption) (*ListChecklistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecklistsResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListChecklists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetChecklist(ctx context.Context, in *ChecklistActionRequest, opts ...grpc.CallOption) (*Checklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Checklist)
+	err := c.cc.Invoke(ctx, ChecklistService_GetChecklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, ChecklistService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, ChecklistService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*SharedChecklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedChecklist)
+	err := c.cc.Invoke(ctx, ChecklistService_ResolveShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) SetRecurrence(ctx context.Context, in *SetRecurrenceRequest, opts ...grpc.CallOption) (*Recurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recurrence)
+	err := c.cc.Invoke(ctx, ChecklistService_SetRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListRecurrences(ctx context.Context, in *ListRecurrencesRequest, opts ...grpc.CallOption) (*ListRecurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurrencesResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListRecurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) DeleteRecurrence(ctx context.Context, in *DeleteRecurrenceRequest, opts ...grpc.CallOption) (*DeleteRecurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurrenceResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_DeleteRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) CreateTemplateFromChecklist(ctx context.Context, in *CreateTemplateFromChecklistRequest, opts ...grpc.CallOption) (*Template, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Template)
+	err := c.cc.Invoke(ctx, ChecklistService_CreateTemplateFromChecklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetTemplate(ctx context.Context, in *TemplateActionRequest, opts ...grpc.CallOption) (*Template, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Template)
+	err := c.cc.Invoke(ctx, ChecklistService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*Checklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Checklist)
+	err := c.cc.Invoke(ctx, ChecklistService_InstantiateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) AddDependency(ctx context.Context, in *TaskDependencyRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, ChecklistService_AddDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) RemoveDependency(ctx context.Context, in *TaskDependencyRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, ChecklistService_RemoveDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) SetWorkflow(ctx context.Context, in *SetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, ChecklistService_SetWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, ChecklistService_GetWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, ChecklistService_TransitionTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*Board, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Board)
+	err := c.cc.Invoke(ctx, ChecklistService_GetBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) AddChecklistMember(ctx context.Context, in *ChecklistMemberRequest, opts ...grpc.CallOption) (*ChecklistMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistMember)
+	err := c.cc.Invoke(ctx, ChecklistService_AddChecklistMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) RemoveChecklistMember(ctx context.Context, in *ChecklistMemberRequest, opts ...grpc.CallOption) (*RemoveChecklistMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveChecklistMemberResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_RemoveChecklistMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListChecklistMembers(ctx context.Context, in *ListChecklistMembersRequest, opts ...grpc.CallOption) (*ListChecklistMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecklistMembersResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListChecklistMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) AssignTask(ctx context.Context, in *TaskAssigneeRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, ChecklistService_AssignTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) UnassignTask(ctx context.Context, in *TaskAssigneeRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, ChecklistService_UnassignTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, ChecklistService_CreateAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetAttachment(ctx context.Context, in *AttachmentActionRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, ChecklistService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) DeleteAttachment(ctx context.Context, in *AttachmentActionRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, ChecklistService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTasksResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_SearchTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) CreateSavedView(ctx context.Context, in *CreateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, ChecklistService_CreateSavedView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) ListSavedViews(ctx context.Context, in *ListSavedViewsRequest, opts ...grpc.CallOption) (*ListSavedViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedViewsResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_ListSavedViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetSavedView(ctx context.Context, in *SavedViewActionRequest, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, ChecklistService_GetSavedView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) UpdateSavedView(ctx context.Context, in *UpdateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, ChecklistService_UpdateSavedView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) DeleteSavedView(ctx context.Context, in *SavedViewActionRequest, opts ...grpc.CallOption) (*DeleteSavedViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedViewResponse)
+	err := c.cc.Invoke(ctx, ChecklistService_DeleteSavedView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, ChecklistService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChecklistServiceServer is the server API for ChecklistService service.
 // All implementations must embed UnimplementedChecklistServiceServer
 // for forward compatibility.
-type ChecklistServiceServer interface {
-	// Для POST /create
-	CreateTask(context.Context, *CreateTaskRequest) (*Task, error)
-	// Для GET /list
-	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
-	// Для DELETE /delete
-	DeleteTask(context.Context, *TaskActionRequest) (*DeleteTaskResponse, error)
-	// Для PUT /done
-	MarkTaskDone(context.Context, *TaskActionRequest) (*Task, error)
-	mustEmbedUnimplementedChecklistServiceServer()
+type ChecklistServiceServer interface {
+	// Для POST /create
+	CreateTask(context.Context, *CreateTaskRequest) (*Task, error)
+	// Для GET /list
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// Для DELETE /delete
+	DeleteTask(context.Context, *TaskActionRequest) (*DeleteTaskResponse, error)
+	// Для PUT /done
+	MarkTaskDone(context.Context, *TaskActionRequest) (*Task, error)
+	// Для POST /v1/checklists
+	CreateChecklist(context.Context, *CreateChecklistRequest) (*Checklist, error)
+	// Для GET /v1/checklists
+	ListChecklists(context.Context, *ListChecklistsRequest) (*ListChecklistsResponse, error)
+	// Для GET /v1/checklists/{id}
+	GetChecklist(context.Context, *ChecklistActionRequest) (*Checklist, error)
+	// Для POST /v1/checklists/{id}/shares
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error)
+	// Для GET /v1/checklists/{id}/shares
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	// Для DELETE /v1/shares/{id}
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*ShareLink, error)
+	// Для GET /share/{token}
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*SharedChecklist, error)
+	// Для PUT /v1/tasks/{id}/recurrence и PUT /v1/checklists/{id}/recurrence
+	SetRecurrence(context.Context, *SetRecurrenceRequest) (*Recurrence, error)
+	// Для GET /v1/recurrences
+	ListRecurrences(context.Context, *ListRecurrencesRequest) (*ListRecurrencesResponse, error)
+	// Для DELETE /v1/recurrences/{id}
+	DeleteRecurrence(context.Context, *DeleteRecurrenceRequest) (*DeleteRecurrenceResponse, error)
+	// Для POST /v1/templates
+	CreateTemplateFromChecklist(context.Context, *CreateTemplateFromChecklistRequest) (*Template, error)
+	// Для GET /v1/templates
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// Для GET /v1/templates/{id}
+	GetTemplate(context.Context, *TemplateActionRequest) (*Template, error)
+	// Для POST /v1/templates/{id}/instantiate
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*Checklist, error)
+	// Для POST /v1/tasks/{id}/dependencies
+	AddDependency(context.Context, *TaskDependencyRequest) (*Task, error)
+	// Для DELETE /v1/tasks/{id}/dependencies/{depends_on_id}
+	RemoveDependency(context.Context, *TaskDependencyRequest) (*Task, error)
+	// Для PUT /v1/checklists/{id}/workflow
+	SetWorkflow(context.Context, *SetWorkflowRequest) (*Workflow, error)
+	// Для GET /v1/checklists/{id}/workflow
+	GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error)
+	// Для POST /v1/tasks/{id}/transition
+	TransitionTask(context.Context, *TransitionTaskRequest) (*Task, error)
+	// Для GET /v1/checklists/{id}/board
+	GetBoard(context.Context, *GetBoardRequest) (*Board, error)
+	// Для POST /v1/checklists/{id}/members
+	AddChecklistMember(context.Context, *ChecklistMemberRequest) (*ChecklistMember, error)
+	// Для DELETE /v1/checklists/{id}/members/{user_id}
+	RemoveChecklistMember(context.Context, *ChecklistMemberRequest) (*RemoveChecklistMemberResponse, error)
+	// Для GET /v1/checklists/{id}/members
+	ListChecklistMembers(context.Context, *ListChecklistMembersRequest) (*ListChecklistMembersResponse, error)
+	// Для POST /v1/tasks/{id}/assignees
+	AssignTask(context.Context, *TaskAssigneeRequest) (*Task, error)
+	// Для DELETE /v1/tasks/{id}/assignees/{user_id}
+	UnassignTask(context.Context, *TaskAssigneeRequest) (*Task, error)
+	// Для POST /v1/tasks/{id}/attachments
+	CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error)
+	// Для GET /v1/tasks/{id}/attachments
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Для GET /v1/attachments/{id} и GET /v1/attachments/{id}/content
+	GetAttachment(context.Context, *AttachmentActionRequest) (*Attachment, error)
+	// Для DELETE /v1/attachments/{id}; возвращает удаленные метаданные, чтобы удалить содержимое
+	DeleteAttachment(context.Context, *AttachmentActionRequest) (*Attachment, error)
+	// Для GET /v1/search
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	// Для POST /v1/views
+	CreateSavedView(context.Context, *CreateSavedViewRequest) (*SavedView, error)
+	// Для GET /v1/views
+	ListSavedViews(context.Context, *ListSavedViewsRequest) (*ListSavedViewsResponse, error)
+	// Для GET /v1/views/{id}
+	GetSavedView(context.Context, *SavedViewActionRequest) (*SavedView, error)
+	// Для PUT /v1/views/{id}
+	UpdateSavedView(context.Context, *UpdateSavedViewRequest) (*SavedView, error)
+	// Для DELETE /v1/views/{id}
+	DeleteSavedView(context.Context, *SavedViewActionRequest) (*DeleteSavedViewResponse, error)
+	// Для GET /v1/stats
+	GetStats(context.Context, *GetStatsRequest) (*Stats, error)
+	mustEmbedUnimplementedChecklistServiceServer()
+}
+
+// UnimplementedChecklistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChecklistServiceServer struct{}
+
+func (UnimplementedChecklistServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedChecklistServiceServer) DeleteTask(context.Context, *TaskActionRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedChecklistServiceServer) MarkTaskDone(context.Context, *TaskActionRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkTaskDone not implemented")
+}
+func (UnimplementedChecklistServiceServer) CreateChecklist(context.Context, *CreateChecklistRequest) (*Checklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChecklist not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListChecklists(context.Context, *ListChecklistsRequest) (*ListChecklistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklists not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetChecklist(context.Context, *ChecklistActionRequest) (*Checklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChecklist not implemented")
+}
+func (UnimplementedChecklistServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedChecklistServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedChecklistServiceServer) ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*SharedChecklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShareLink not implemented")
+}
+func (UnimplementedChecklistServiceServer) SetRecurrence(context.Context, *SetRecurrenceRequest) (*Recurrence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecurrence not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListRecurrences(context.Context, *ListRecurrencesRequest) (*ListRecurrencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurrences not implemented")
+}
+func (UnimplementedChecklistServiceServer) DeleteRecurrence(context.Context, *DeleteRecurrenceRequest) (*DeleteRecurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurrence not implemented")
+}
+func (UnimplementedChecklistServiceServer) CreateTemplateFromChecklist(context.Context, *CreateTemplateFromChecklistRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateFromChecklist not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetTemplate(context.Context, *TemplateActionRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedChecklistServiceServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*Checklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedChecklistServiceServer) AddDependency(context.Context, *TaskDependencyRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDependency not implemented")
+}
+func (UnimplementedChecklistServiceServer) RemoveDependency(context.Context, *TaskDependencyRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDependency not implemented")
+}
+func (UnimplementedChecklistServiceServer) SetWorkflow(context.Context, *SetWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflow not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (UnimplementedChecklistServiceServer) TransitionTask(context.Context, *TransitionTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTask not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetBoard(context.Context, *GetBoardRequest) (*Board, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
+func (UnimplementedChecklistServiceServer) AddChecklistMember(context.Context, *ChecklistMemberRequest) (*ChecklistMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistMember not implemented")
+}
+func (UnimplementedChecklistServiceServer) RemoveChecklistMember(context.Context, *ChecklistMemberRequest) (*RemoveChecklistMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChecklistMember not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListChecklistMembers(context.Context, *ListChecklistMembersRequest) (*ListChecklistMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklistMembers not implemented")
+}
+func (UnimplementedChecklistServiceServer) AssignTask(context.Context, *TaskAssigneeRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTask not implemented")
+}
+func (UnimplementedChecklistServiceServer) UnassignTask(context.Context, *TaskAssigneeRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignTask not implemented")
+}
+func (UnimplementedChecklistServiceServer) CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttachment not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetAttachment(context.Context, *AttachmentActionRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedChecklistServiceServer) DeleteAttachment(context.Context, *AttachmentActionRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedChecklistServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTasks not implemented")
+}
+func (UnimplementedChecklistServiceServer) CreateSavedView(context.Context, *CreateSavedViewRequest) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedView not implemented")
+}
+func (UnimplementedChecklistServiceServer) ListSavedViews(context.Context, *ListSavedViewsRequest) (*ListSavedViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedViews not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetSavedView(context.Context, *SavedViewActionRequest) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedView not implemented")
+}
+func (UnimplementedChecklistServiceServer) UpdateSavedView(context.Context, *UpdateSavedViewRequest) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedView not implemented")
+}
+func (UnimplementedChecklistServiceServer) DeleteSavedView(context.Context, *SavedViewActionRequest) (*DeleteSavedViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedView not implemented")
+}
+func (UnimplementedChecklistServiceServer) GetStats(context.Context, *GetStatsRequest) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedChecklistServiceServer) mustEmbedUnimplementedChecklistServiceServer() {}
+func (UnimplementedChecklistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeChecklistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChecklistServiceServer will
+// result in compilation errors.
+type UnsafeChecklistServiceServer interface {
+	mustEmbedUnimplementedChecklistServiceServer()
+}
+
+func RegisterChecklistServiceServer(s grpc.ServiceRegistrar, srv ChecklistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChecklistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChecklistService_ServiceDesc, srv)
+}
+
+func _ChecklistService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).DeleteTask(ctx, req.(*TaskActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_MarkTaskDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).MarkTaskDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_MarkTaskDone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).MarkTaskDone(ctx, req.(*TaskActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_CreateChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateChecklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateChecklist(ctx, req.(*CreateChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListChecklists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListChecklists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListChecklists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListChecklists(ctx, req.(*ListChecklistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetChecklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetChecklist(ctx, req.(*ChecklistActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_RevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ResolveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ResolveShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ResolveShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_SetRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).SetRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_SetRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).SetRecurrence(ctx, req.(*SetRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListRecurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListRecurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListRecurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListRecurrences(ctx, req.(*ListRecurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_DeleteRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).DeleteRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_DeleteRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).DeleteRecurrence(ctx, req.(*DeleteRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_CreateTemplateFromChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateFromChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateTemplateFromChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateTemplateFromChecklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateTemplateFromChecklist(ctx, req.(*CreateTemplateFromChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetTemplate(ctx, req.(*TemplateActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_InstantiateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_AddDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).AddDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_AddDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).AddDependency(ctx, req.(*TaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_RemoveDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).RemoveDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_RemoveDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).RemoveDependency(ctx, req.(*TaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_SetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).SetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_SetWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).SetWorkflow(ctx, req.(*SetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetWorkflow(ctx, req.(*GetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_TransitionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).TransitionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_TransitionTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).TransitionTask(ctx, req.(*TransitionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetBoard(ctx, req.(*GetBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_AddChecklistMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).AddChecklistMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_AddChecklistMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).AddChecklistMember(ctx, req.(*ChecklistMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_RemoveChecklistMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).RemoveChecklistMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_RemoveChecklistMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).RemoveChecklistMember(ctx, req.(*ChecklistMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListChecklistMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListChecklistMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListChecklistMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListChecklistMembers(ctx, req.(*ListChecklistMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_AssignTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskAssigneeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).AssignTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_AssignTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).AssignTask(ctx, req.(*TaskAssigneeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_UnassignTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskAssigneeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).UnassignTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_UnassignTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).UnassignTask(ctx, req.(*TaskAssigneeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_CreateAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateAttachment(ctx, req.(*CreateAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetAttachment(ctx, req.(*AttachmentActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).DeleteAttachment(ctx, req.(*AttachmentActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).SearchTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_SearchTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_CreateSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).CreateSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_CreateSavedView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).CreateSavedView(ctx, req.(*CreateSavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_ListSavedViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).ListSavedViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_ListSavedViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).ListSavedViews(ctx, req.(*ListSavedViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedViewActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetSavedView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetSavedView(ctx, req.(*SavedViewActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_UpdateSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).UpdateSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_UpdateSavedView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).UpdateSavedView(ctx, req.(*UpdateSavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_DeleteSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedViewActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).DeleteSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_DeleteSavedView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).DeleteSavedView(ctx, req.(*SavedViewActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChecklistService_ServiceDesc is the grpc.ServiceDesc for ChecklistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChecklistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ChecklistService",
+	HandlerType: (*ChecklistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _ChecklistService_CreateTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _ChecklistService_ListTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _ChecklistService_DeleteTask_Handler,
+		},
+		{
+			MethodName: "MarkTaskDone",
+			Handler:    _ChecklistService_MarkTaskDone_Handler,
+		},
+		{
+			MethodName: "CreateChecklist",
+			Handler:    _ChecklistService_CreateChecklist_Handler,
+		},
+		{
+			MethodName: "ListChecklists",
+			Handler:    _ChecklistService_ListChecklists_Handler,
+		},
+		{
+			MethodName: "GetChecklist",
+			Handler:    _ChecklistService_GetChecklist_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _ChecklistService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _ChecklistService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _ChecklistService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "ResolveShareLink",
+			Handler:    _ChecklistService_ResolveShareLink_Handler,
+		},
+		{
+			MethodName: "SetRecurrence",
+			Handler:    _ChecklistService_SetRecurrence_Handler,
+		},
+		{
+			MethodName: "ListRecurrences",
+			Handler:    _ChecklistService_ListRecurrences_Handler,
+		},
+		{
+			MethodName: "DeleteRecurrence",
+			Handler:    _ChecklistService_DeleteRecurrence_Handler,
+		},
+		{
+			MethodName: "CreateTemplateFromChecklist",
+			Handler:    _ChecklistService_CreateTemplateFromChecklist_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ChecklistService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _ChecklistService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _ChecklistService_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "AddDependency",
+			Handler:    _ChecklistService_AddDependency_Handler,
+		},
+		{
+			MethodName: "RemoveDependency",
+			Handler:    _ChecklistService_RemoveDependency_Handler,
+		},
+		{
+			MethodName: "SetWorkflow",
+			Handler:    _ChecklistService_SetWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _ChecklistService_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "TransitionTask",
+			Handler:    _ChecklistService_TransitionTask_Handler,
+		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _ChecklistService_GetBoard_Handler,
+		},
+		{
+			MethodName: "AddChecklistMember",
+			Handler:    _ChecklistService_AddChecklistMember_Handler,
+		},
+		{
+			MethodName: "RemoveChecklistMember",
+			Handler:    _ChecklistService_RemoveChecklistMember_Handler,
+		},
+		{
+			MethodName: "ListChecklistMembers",
+			Handler:    _ChecklistService_ListChecklistMembers_Handler,
+		},
+		{
+			MethodName: "AssignTask",
+			Handler:    _ChecklistService_AssignTask_Handler,
+		},
+		{
+			MethodName: "UnassignTask",
+			Handler:    _ChecklistService_UnassignTask_Handler,
+		},
+		{
+			MethodName: "CreateAttachment",
+			Handler:    _ChecklistService_CreateAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _ChecklistService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _ChecklistService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _ChecklistService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _ChecklistService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "CreateSavedView",
+			Handler:    _ChecklistService_CreateSavedView_Handler,
+		},
+		{
+			MethodName: "ListSavedViews",
+			Handler:    _ChecklistService_ListSavedViews_Handler,
+		},
+		{
+			MethodName: "GetSavedView",
+			Handler:    _ChecklistService_GetSavedView_Handler,
+		},
+		{
+			MethodName: "UpdateSavedView",
+			Handler:    _ChecklistService_UpdateSavedView_Handler,
+		},
+		{
+			MethodName: "DeleteSavedView",
+			Handler:    _ChecklistService_DeleteSavedView_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _ChecklistService_GetStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/checklist.proto",
+}
+
+const (
+	CommentService_AddComment_FullMethodName    = "/proto.CommentService/AddComment"
+	CommentService_ListComments_FullMethodName  = "/proto.CommentService/ListComments"
+	CommentService_EditComment_FullMethodName   = "/proto.CommentService/EditComment"
+	CommentService_DeleteComment_FullMethodName = "/proto.CommentService/DeleteComment"
+)
+
+// CommentServiceClient is the client API for CommentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentServiceClient interface {
+	// Для POST /v1/tasks/{id}/comments
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// Для GET /v1/tasks/{id}/comments
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// Для PATCH /v1/tasks/{task_id}/comments/{id}
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// Для DELETE /v1/tasks/{task_id}/comments/{id}
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+}
+
+type commentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentServiceClient(cc grpc.ClientConnInterface) CommentServiceClient {
+	return &commentServiceClient{cc}
+}
+
+func (c *commentServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentServiceServer is the server API for CommentService service.
+// All implementations must embed UnimplementedCommentServiceServer
+// for forward compatibility.
+type CommentServiceServer interface {
+	// Для POST /v1/tasks/{id}/comments
+	AddComment(context.Context, *AddCommentRequest) (*Comment, error)
+	// Для GET /v1/tasks/{id}/comments
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// Для PATCH /v1/tasks/{task_id}/comments/{id}
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	// Для DELETE /v1/tasks/{task_id}/comments/{id}
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	mustEmbedUnimplementedCommentServiceServer()
 }
 
-// UnimplementedChecklistServiceServer must be embedded to have
+// UnimplementedCommentServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedChecklistServiceServer struct{}
+type UnimplementedCommentServiceServer struct{}
 
-func (UnimplementedChecklistServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*Task, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+func (UnimplementedCommentServiceServer) AddComment(context.Context, *AddCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
-func (UnimplementedChecklistServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+func (UnimplementedCommentServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
 }
-func (UnimplementedChecklistServiceServer) DeleteTask(context.Context, *TaskActionRequest) (*DeleteTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+func (UnimplementedCommentServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
 }
-func (UnimplementedChecklistServiceServer) MarkTaskDone(context.Context, *TaskActionRequest) (*Task, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkTaskDone not implemented")
+func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
-func (UnimplementedChecklistServiceServer) mustEmbedUnimplementedChecklistServiceServer() {}
-func (UnimplementedChecklistServiceServer) testEmbeddedByValue()                          {}
+func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
+func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeChecklistServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChecklistServiceServer will
+// UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentServiceServer will
 // result in compilation errors.
-type UnsafeChecklistServiceServer interface {
-	mustEmbedUnimplementedChecklistServiceServer()
+type UnsafeCommentServiceServer interface {
+	mustEmbedUnimplementedCommentServiceServer()
 }
 
-func RegisterChecklistServiceServer(s grpc.ServiceRegistrar, srv ChecklistServiceServer) {
-	// If the following call pancis, it indicates UnimplementedChecklistServiceServer was
+func RegisterCommentServiceServer(s grpc.ServiceRegistrar, srv CommentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ChecklistService_ServiceDesc, srv)
+	s.RegisterService(&CommentService_ServiceDesc, srv)
 }
 
-func _ChecklistService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTaskRequest)
+func _CommentService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChecklistServiceServer).CreateTask(ctx, in)
+		return srv.(CommentServiceServer).AddComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChecklistService_CreateTask_FullMethodName,
+		FullMethod: CommentService_AddComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChecklistServiceServer).CreateTask(ctx, req.(*CreateTaskRequest))
+		return srv.(CommentServiceServer).AddComment(ctx, req.(*AddCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChecklistService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTasksRequest)
+func _CommentService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChecklistServiceServer).ListTasks(ctx, in)
+		return srv.(CommentServiceServer).ListComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChecklistService_ListTasks_FullMethodName,
+		FullMethod: CommentService_ListComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChecklistServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+		return srv.(CommentServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChecklistService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskActionRequest)
+func _CommentService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChecklistServiceServer).DeleteTask(ctx, in)
+		return srv.(CommentServiceServer).EditComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChecklistService_DeleteTask_FullMethodName,
+		FullMethod: CommentService_EditComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChecklistServiceServer).DeleteTask(ctx, req.(*TaskActionRequest))
+		return srv.(CommentServiceServer).EditComment(ctx, req.(*EditCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChecklistService_MarkTaskDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskActionRequest)
+func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChecklistServiceServer).MarkTaskDone(ctx, in)
+		return srv.(CommentServiceServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChecklistService_MarkTaskDone_FullMethodName,
+		FullMethod: CommentService_DeleteComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChecklistServiceServer).MarkTaskDone(ctx, req.(*TaskActionRequest))
+		return srv.(CommentServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ChecklistService_ServiceDesc is the grpc.ServiceDesc for ChecklistService service.
+// CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ChecklistService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.ChecklistService",
-	HandlerType: (*ChecklistServiceServer)(nil),
+var CommentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.CommentService",
+	HandlerType: (*CommentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateTask",
-			Handler:    _ChecklistService_CreateTask_Handler,
+			MethodName: "AddComment",
+			Handler:    _CommentService_AddComment_Handler,
 		},
 		{
-			MethodName: "ListTasks",
-			Handler:    _ChecklistService_ListTasks_Handler,
+			MethodName: "ListComments",
+			Handler:    _CommentService_ListComments_Handler,
 		},
 		{
-			MethodName: "DeleteTask",
-			Handler:    _ChecklistService_DeleteTask_Handler,
+			MethodName: "EditComment",
+			Handler:    _CommentService_EditComment_Handler,
 		},
 		{
-			MethodName: "MarkTaskDone",
-			Handler:    _ChecklistService_MarkTaskDone_Handler,
+			MethodName: "DeleteComment",
+			Handler:    _CommentService_DeleteComment_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,1666 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/checklist.proto
+
+package protoconnect
+
+import (
+	proto "checklist-go/proto"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChecklistServiceName is the fully-qualified name of the ChecklistService service.
+	ChecklistServiceName = "proto.ChecklistService"
+	// CommentServiceName is the fully-qualified name of the CommentService service.
+	CommentServiceName = "proto.CommentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChecklistServiceCreateTaskProcedure is the fully-qualified name of the ChecklistService's
+	// CreateTask RPC.
+	ChecklistServiceCreateTaskProcedure = "/proto.ChecklistService/CreateTask"
+	// ChecklistServiceListTasksProcedure is the fully-qualified name of the ChecklistService's
+	// ListTasks RPC.
+	ChecklistServiceListTasksProcedure = "/proto.ChecklistService/ListTasks"
+	// ChecklistServiceDeleteTaskProcedure is the fully-qualified name of the ChecklistService's
+	// DeleteTask RPC.
+	ChecklistServiceDeleteTaskProcedure = "/proto.ChecklistService/DeleteTask"
+	// ChecklistServiceMarkTaskDoneProcedure is the fully-qualified name of the ChecklistService's
+	// MarkTaskDone RPC.
+	ChecklistServiceMarkTaskDoneProcedure = "/proto.ChecklistService/MarkTaskDone"
+	// ChecklistServiceCreateChecklistProcedure is the fully-qualified name of the ChecklistService's
+	// CreateChecklist RPC.
+	ChecklistServiceCreateChecklistProcedure = "/proto.ChecklistService/CreateChecklist"
+	// ChecklistServiceListChecklistsProcedure is the fully-qualified name of the ChecklistService's
+	// ListChecklists RPC.
+	ChecklistServiceListChecklistsProcedure = "/proto.ChecklistService/ListChecklists"
+	// ChecklistServiceGetChecklistProcedure is the fully-qualified name of the ChecklistService's
+	// GetChecklist RPC.
+	ChecklistServiceGetChecklistProcedure = "/proto.ChecklistService/GetChecklist"
+	// ChecklistServiceCreateShareLinkProcedure is the fully-qualified name of the ChecklistService's
+	// CreateShareLink RPC.
+	ChecklistServiceCreateShareLinkProcedure = "/proto.ChecklistService/CreateShareLink"
+	// ChecklistServiceListShareLinksProcedure is the fully-qualified name of the ChecklistService's
+	// ListShareLinks RPC.
+	ChecklistServiceListShareLinksProcedure = "/proto.ChecklistService/ListShareLinks"
+	// ChecklistServiceRevokeShareLinkProcedure is the fully-qualified name of the ChecklistService's
+	// RevokeShareLink RPC.
+	ChecklistServiceRevokeShareLinkProcedure = "/proto.ChecklistService/RevokeShareLink"
+	// ChecklistServiceResolveShareLinkProcedure is the fully-qualified name of the ChecklistService's
+	// ResolveShareLink RPC.
+	ChecklistServiceResolveShareLinkProcedure = "/proto.ChecklistService/ResolveShareLink"
+	// ChecklistServiceSetRecurrenceProcedure is the fully-qualified name of the ChecklistService's
+	// SetRecurrence RPC.
+	ChecklistServiceSetRecurrenceProcedure = "/proto.ChecklistService/SetRecurrence"
+	// ChecklistServiceListRecurrencesProcedure is the fully-qualified name of the ChecklistService's
+	// ListRecurrences RPC.
+	ChecklistServiceListRecurrencesProcedure = "/proto.ChecklistService/ListRecurrences"
+	// ChecklistServiceDeleteRecurrenceProcedure is the fully-qualified name of the ChecklistService's
+	// DeleteRecurrence RPC.
+	ChecklistServiceDeleteRecurrenceProcedure = "/proto.ChecklistService/DeleteRecurrence"
+	// ChecklistServiceCreateTemplateFromChecklistProcedure is the fully-qualified name of the
+	// ChecklistService's CreateTemplateFromChecklist RPC.
+	ChecklistServiceCreateTemplateFromChecklistProcedure = "/proto.ChecklistService/CreateTemplateFromChecklist"
+	// ChecklistServiceListTemplatesProcedure is the fully-qualified name of the ChecklistService's
+	// ListTemplates RPC.
+	ChecklistServiceListTemplatesProcedure = "/proto.ChecklistService/ListTemplates"
+	// ChecklistServiceGetTemplateProcedure is the fully-qualified name of the ChecklistService's
+	// GetTemplate RPC.
+	ChecklistServiceGetTemplateProcedure = "/proto.ChecklistService/GetTemplate"
+	// ChecklistServiceInstantiateTemplateProcedure is the fully-qualified name of the
+	// ChecklistService's InstantiateTemplate RPC.
+	ChecklistServiceInstantiateTemplateProcedure = "/proto.ChecklistService/InstantiateTemplate"
+	// ChecklistServiceAddDependencyProcedure is the fully-qualified name of the ChecklistService's
+	// AddDependency RPC.
+	ChecklistServiceAddDependencyProcedure = "/proto.ChecklistService/AddDependency"
+	// ChecklistServiceRemoveDependencyProcedure is the fully-qualified name of the ChecklistService's
+	// RemoveDependency RPC.
+	ChecklistServiceRemoveDependencyProcedure = "/proto.ChecklistService/RemoveDependency"
+	// ChecklistServiceSetWorkflowProcedure is the fully-qualified name of the ChecklistService's
+	// SetWorkflow RPC.
+	ChecklistServiceSetWorkflowProcedure = "/proto.ChecklistService/SetWorkflow"
+	// ChecklistServiceGetWorkflowProcedure is the fully-qualified name of the ChecklistService's
+	// GetWorkflow RPC.
+	ChecklistServiceGetWorkflowProcedure = "/proto.ChecklistService/GetWorkflow"
+	// ChecklistServiceTransitionTaskProcedure is the fully-qualified name of the ChecklistService's
+	// TransitionTask RPC.
+	ChecklistServiceTransitionTaskProcedure = "/proto.ChecklistService/TransitionTask"
+	// ChecklistServiceGetBoardProcedure is the fully-qualified name of the ChecklistService's GetBoard
+	// RPC.
+	ChecklistServiceGetBoardProcedure = "/proto.ChecklistService/GetBoard"
+	// ChecklistServiceAddChecklistMemberProcedure is the fully-qualified name of the ChecklistService's
+	// AddChecklistMember RPC.
+	ChecklistServiceAddChecklistMemberProcedure = "/proto.ChecklistService/AddChecklistMember"
+	// ChecklistServiceRemoveChecklistMemberProcedure is the fully-qualified name of the
+	// ChecklistService's RemoveChecklistMember RPC.
+	ChecklistServiceRemoveChecklistMemberProcedure = "/proto.ChecklistService/RemoveChecklistMember"
+	// ChecklistServiceListChecklistMembersProcedure is the fully-qualified name of the
+	// ChecklistService's ListChecklistMembers RPC.
+	ChecklistServiceListChecklistMembersProcedure = "/proto.ChecklistService/ListChecklistMembers"
+	// ChecklistServiceAssignTaskProcedure is the fully-qualified name of the ChecklistService's
+	// AssignTask RPC.
+	ChecklistServiceAssignTaskProcedure = "/proto.ChecklistService/AssignTask"
+	// ChecklistServiceUnassignTaskProcedure is the fully-qualified name of the ChecklistService's
+	// UnassignTask RPC.
+	ChecklistServiceUnassignTaskProcedure = "/proto.ChecklistService/UnassignTask"
+	// ChecklistServiceCreateAttachmentProcedure is the fully-qualified name of the ChecklistService's
+	// CreateAttachment RPC.
+	ChecklistServiceCreateAttachmentProcedure = "/proto.ChecklistService/CreateAttachment"
+	// ChecklistServiceListAttachmentsProcedure is the fully-qualified name of the ChecklistService's
+	// ListAttachments RPC.
+	ChecklistServiceListAttachmentsProcedure = "/proto.ChecklistService/ListAttachments"
+	// ChecklistServiceGetAttachmentProcedure is the fully-qualified name of the ChecklistService's
+	// GetAttachment RPC.
+	ChecklistServiceGetAttachmentProcedure = "/proto.ChecklistService/GetAttachment"
+	// ChecklistServiceDeleteAttachmentProcedure is the fully-qualified name of the ChecklistService's
+	// DeleteAttachment RPC.
+	ChecklistServiceDeleteAttachmentProcedure = "/proto.ChecklistService/DeleteAttachment"
+	// ChecklistServiceSearchTasksProcedure is the fully-qualified name of the ChecklistService's
+	// SearchTasks RPC.
+	ChecklistServiceSearchTasksProcedure = "/proto.ChecklistService/SearchTasks"
+	// ChecklistServiceCreateSavedViewProcedure is the fully-qualified name of the ChecklistService's
+	// CreateSavedView RPC.
+	ChecklistServiceCreateSavedViewProcedure = "/proto.ChecklistService/CreateSavedView"
+	// ChecklistServiceListSavedViewsProcedure is the fully-qualified name of the ChecklistService's
+	// ListSavedViews RPC.
+	ChecklistServiceListSavedViewsProcedure = "/proto.ChecklistService/ListSavedViews"
+	// ChecklistServiceGetSavedViewProcedure is the fully-qualified name of the ChecklistService's
+	// GetSavedView RPC.
+	ChecklistServiceGetSavedViewProcedure = "/proto.ChecklistService/GetSavedView"
+	// ChecklistServiceUpdateSavedViewProcedure is the fully-qualified name of the ChecklistService's
+	// UpdateSavedView RPC.
+	ChecklistServiceUpdateSavedViewProcedure = "/proto.ChecklistService/UpdateSavedView"
+	// ChecklistServiceDeleteSavedViewProcedure is the fully-qualified name of the ChecklistService's
+	// DeleteSavedView RPC.
+	ChecklistServiceDeleteSavedViewProcedure = "/proto.ChecklistService/DeleteSavedView"
+	// ChecklistServiceGetStatsProcedure is the fully-qualified name of the ChecklistService's GetStats
+	// RPC.
+	ChecklistServiceGetStatsProcedure = "/proto.ChecklistService/GetStats"
+	// CommentServiceAddCommentProcedure is the fully-qualified name of the CommentService's AddComment
+	// RPC.
+	CommentServiceAddCommentProcedure = "/proto.CommentService/AddComment"
+	// CommentServiceListCommentsProcedure is the fully-qualified name of the CommentService's
+	// ListComments RPC.
+	CommentServiceListCommentsProcedure = "/proto.CommentService/ListComments"
+	// CommentServiceEditCommentProcedure is the fully-qualified name of the CommentService's
+	// EditComment RPC.
+	CommentServiceEditCommentProcedure = "/proto.CommentService/EditComment"
+	// CommentServiceDeleteCommentProcedure is the fully-qualified name of the CommentService's
+	// DeleteComment RPC.
+	CommentServiceDeleteCommentProcedure = "/proto.CommentService/DeleteComment"
+)
+
+// ChecklistServiceClient is a client for the proto.ChecklistService service.
+type ChecklistServiceClient interface {
+	// Для POST /create
+	CreateTask(context.Context, *proto.CreateTaskRequest) (*proto.Task, error)
+	// Для GET /list
+	ListTasks(context.Context, *proto.ListTasksRequest) (*proto.ListTasksResponse, error)
+	// Для DELETE /delete
+	DeleteTask(context.Context, *proto.TaskActionRequest) (*proto.DeleteTaskResponse, error)
+	// Для PUT /done
+	MarkTaskDone(context.Context, *proto.TaskActionRequest) (*proto.Task, error)
+	// Для POST /v1/checklists
+	CreateChecklist(context.Context, *proto.CreateChecklistRequest) (*proto.Checklist, error)
+	// Для GET /v1/checklists
+	ListChecklists(context.Context, *proto.ListChecklistsRequest) (*proto.ListChecklistsResponse, error)
+	// Для GET /v1/checklists/{id}
+	GetChecklist(context.Context, *proto.ChecklistActionRequest) (*proto.Checklist, error)
+	// Для POST /v1/checklists/{id}/shares
+	CreateShareLink(context.Context, *proto.CreateShareLinkRequest) (*proto.ShareLink, error)
+	// Для GET /v1/checklists/{id}/shares
+	ListShareLinks(context.Context, *proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error)
+	// Для DELETE /v1/shares/{id}
+	RevokeShareLink(context.Context, *proto.RevokeShareLinkRequest) (*proto.ShareLink, error)
+	// Для GET /share/{token}
+	ResolveShareLink(context.Context, *proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error)
+	// Для PUT /v1/tasks/{id}/recurrence и PUT /v1/checklists/{id}/recurrence
+	SetRecurrence(context.Context, *proto.SetRecurrenceRequest) (*proto.Recurrence, error)
+	// Для GET /v1/recurrences
+	ListRecurrences(context.Context, *proto.ListRecurrencesRequest) (*proto.ListRecurrencesResponse, error)
+	// Для DELETE /v1/recurrences/{id}
+	DeleteRecurrence(context.Context, *proto.DeleteRecurrenceRequest) (*proto.DeleteRecurrenceResponse, error)
+	// Для POST /v1/templates
+	CreateTemplateFromChecklist(context.Context, *proto.CreateTemplateFromChecklistRequest) (*proto.Template, error)
+	// Для GET /v1/templates
+	ListTemplates(context.Context, *proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error)
+	// Для GET /v1/templates/{id}
+	GetTemplate(context.Context, *proto.TemplateActionRequest) (*proto.Template, error)
+	// Для POST /v1/templates/{id}/instantiate
+	InstantiateTemplate(context.Context, *proto.InstantiateTemplateRequest) (*proto.Checklist, error)
+	// Для POST /v1/tasks/{id}/dependencies
+	AddDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error)
+	// Для DELETE /v1/tasks/{id}/dependencies/{depends_on_id}
+	RemoveDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error)
+	// Для PUT /v1/checklists/{id}/workflow
+	SetWorkflow(context.Context, *proto.SetWorkflowRequest) (*proto.Workflow, error)
+	// Для GET /v1/checklists/{id}/workflow
+	GetWorkflow(context.Context, *proto.GetWorkflowRequest) (*proto.Workflow, error)
+	// Для POST /v1/tasks/{id}/transition
+	TransitionTask(context.Context, *proto.TransitionTaskRequest) (*proto.Task, error)
+	// Для GET /v1/checklists/{id}/board
+	GetBoard(context.Context, *proto.GetBoardRequest) (*proto.Board, error)
+	// Для POST /v1/checklists/{id}/members
+	AddChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.ChecklistMember, error)
+	// Для DELETE /v1/checklists/{id}/members/{user_id}
+	RemoveChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error)
+	// Для GET /v1/checklists/{id}/members
+	ListChecklistMembers(context.Context, *proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error)
+	// Для POST /v1/tasks/{id}/assignees
+	AssignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error)
+	// Для DELETE /v1/tasks/{id}/assignees/{user_id}
+	UnassignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error)
+	// Для POST /v1/tasks/{id}/attachments
+	CreateAttachment(context.Context, *proto.CreateAttachmentRequest) (*proto.Attachment, error)
+	// Для GET /v1/tasks/{id}/attachments
+	ListAttachments(context.Context, *proto.ListAttachmentsRequest) (*proto.ListAttachmentsResponse, error)
+	// Для GET /v1/attachments/{id} и GET /v1/attachments/{id}/content
+	GetAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error)
+	// Для DELETE /v1/attachments/{id}; возвращает удаленные метаданные, чтобы удалить содержимое
+	DeleteAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error)
+	// Для GET /v1/search
+	SearchTasks(context.Context, *proto.SearchTasksRequest) (*proto.SearchTasksResponse, error)
+	// Для POST /v1/views
+	CreateSavedView(context.Context, *proto.CreateSavedViewRequest) (*proto.SavedView, error)
+	// Для GET /v1/views
+	ListSavedViews(context.Context, *proto.ListSavedViewsRequest) (*proto.ListSavedViewsResponse, error)
+	// Для GET /v1/views/{id}
+	GetSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.SavedView, error)
+	// Для PUT /v1/views/{id}
+	UpdateSavedView(context.Context, *proto.UpdateSavedViewRequest) (*proto.SavedView, error)
+	// Для DELETE /v1/views/{id}
+	DeleteSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.DeleteSavedViewResponse, error)
+	// Для GET /v1/stats
+	GetStats(context.Context, *proto.GetStatsRequest) (*proto.Stats, error)
+}
+
+// NewChecklistServiceClient constructs a client for the proto.ChecklistService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChecklistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChecklistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	checklistServiceMethods := proto.File_proto_checklist_proto.Services().ByName("ChecklistService").Methods()
+	return &checklistServiceClient{
+		createTask: connect.NewClient[proto.CreateTaskRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceCreateTaskProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listTasks: connect.NewClient[proto.ListTasksRequest, proto.ListTasksResponse](
+			httpClient,
+			baseURL+ChecklistServiceListTasksProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTask: connect.NewClient[proto.TaskActionRequest, proto.DeleteTaskResponse](
+			httpClient,
+			baseURL+ChecklistServiceDeleteTaskProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("DeleteTask")),
+			connect.WithClientOptions(opts...),
+		),
+		markTaskDone: connect.NewClient[proto.TaskActionRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceMarkTaskDoneProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("MarkTaskDone")),
+			connect.WithClientOptions(opts...),
+		),
+		createChecklist: connect.NewClient[proto.CreateChecklistRequest, proto.Checklist](
+			httpClient,
+			baseURL+ChecklistServiceCreateChecklistProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateChecklist")),
+			connect.WithClientOptions(opts...),
+		),
+		listChecklists: connect.NewClient[proto.ListChecklistsRequest, proto.ListChecklistsResponse](
+			httpClient,
+			baseURL+ChecklistServiceListChecklistsProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListChecklists")),
+			connect.WithClientOptions(opts...),
+		),
+		getChecklist: connect.NewClient[proto.ChecklistActionRequest, proto.Checklist](
+			httpClient,
+			baseURL+ChecklistServiceGetChecklistProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetChecklist")),
+			connect.WithClientOptions(opts...),
+		),
+		createShareLink: connect.NewClient[proto.CreateShareLinkRequest, proto.ShareLink](
+			httpClient,
+			baseURL+ChecklistServiceCreateShareLinkProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listShareLinks: connect.NewClient[proto.ListShareLinksRequest, proto.ListShareLinksResponse](
+			httpClient,
+			baseURL+ChecklistServiceListShareLinksProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListShareLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeShareLink: connect.NewClient[proto.RevokeShareLinkRequest, proto.ShareLink](
+			httpClient,
+			baseURL+ChecklistServiceRevokeShareLinkProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("RevokeShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveShareLink: connect.NewClient[proto.ResolveShareLinkRequest, proto.SharedChecklist](
+			httpClient,
+			baseURL+ChecklistServiceResolveShareLinkProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ResolveShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		setRecurrence: connect.NewClient[proto.SetRecurrenceRequest, proto.Recurrence](
+			httpClient,
+			baseURL+ChecklistServiceSetRecurrenceProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("SetRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		listRecurrences: connect.NewClient[proto.ListRecurrencesRequest, proto.ListRecurrencesResponse](
+			httpClient,
+			baseURL+ChecklistServiceListRecurrencesProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListRecurrences")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRecurrence: connect.NewClient[proto.DeleteRecurrenceRequest, proto.DeleteRecurrenceResponse](
+			httpClient,
+			baseURL+ChecklistServiceDeleteRecurrenceProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("DeleteRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		createTemplateFromChecklist: connect.NewClient[proto.CreateTemplateFromChecklistRequest, proto.Template](
+			httpClient,
+			baseURL+ChecklistServiceCreateTemplateFromChecklistProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateTemplateFromChecklist")),
+			connect.WithClientOptions(opts...),
+		),
+		listTemplates: connect.NewClient[proto.ListTemplatesRequest, proto.ListTemplatesResponse](
+			httpClient,
+			baseURL+ChecklistServiceListTemplatesProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplate: connect.NewClient[proto.TemplateActionRequest, proto.Template](
+			httpClient,
+			baseURL+ChecklistServiceGetTemplateProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		instantiateTemplate: connect.NewClient[proto.InstantiateTemplateRequest, proto.Checklist](
+			httpClient,
+			baseURL+ChecklistServiceInstantiateTemplateProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("InstantiateTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		addDependency: connect.NewClient[proto.TaskDependencyRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceAddDependencyProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("AddDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDependency: connect.NewClient[proto.TaskDependencyRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceRemoveDependencyProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("RemoveDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		setWorkflow: connect.NewClient[proto.SetWorkflowRequest, proto.Workflow](
+			httpClient,
+			baseURL+ChecklistServiceSetWorkflowProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("SetWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkflow: connect.NewClient[proto.GetWorkflowRequest, proto.Workflow](
+			httpClient,
+			baseURL+ChecklistServiceGetWorkflowProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+		transitionTask: connect.NewClient[proto.TransitionTaskRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceTransitionTaskProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("TransitionTask")),
+			connect.WithClientOptions(opts...),
+		),
+		getBoard: connect.NewClient[proto.GetBoardRequest, proto.Board](
+			httpClient,
+			baseURL+ChecklistServiceGetBoardProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetBoard")),
+			connect.WithClientOptions(opts...),
+		),
+		addChecklistMember: connect.NewClient[proto.ChecklistMemberRequest, proto.ChecklistMember](
+			httpClient,
+			baseURL+ChecklistServiceAddChecklistMemberProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("AddChecklistMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeChecklistMember: connect.NewClient[proto.ChecklistMemberRequest, proto.RemoveChecklistMemberResponse](
+			httpClient,
+			baseURL+ChecklistServiceRemoveChecklistMemberProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("RemoveChecklistMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listChecklistMembers: connect.NewClient[proto.ListChecklistMembersRequest, proto.ListChecklistMembersResponse](
+			httpClient,
+			baseURL+ChecklistServiceListChecklistMembersProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListChecklistMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		assignTask: connect.NewClient[proto.TaskAssigneeRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceAssignTaskProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("AssignTask")),
+			connect.WithClientOptions(opts...),
+		),
+		unassignTask: connect.NewClient[proto.TaskAssigneeRequest, proto.Task](
+			httpClient,
+			baseURL+ChecklistServiceUnassignTaskProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("UnassignTask")),
+			connect.WithClientOptions(opts...),
+		),
+		createAttachment: connect.NewClient[proto.CreateAttachmentRequest, proto.Attachment](
+			httpClient,
+			baseURL+ChecklistServiceCreateAttachmentProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[proto.ListAttachmentsRequest, proto.ListAttachmentsResponse](
+			httpClient,
+			baseURL+ChecklistServiceListAttachmentsProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		getAttachment: connect.NewClient[proto.AttachmentActionRequest, proto.Attachment](
+			httpClient,
+			baseURL+ChecklistServiceGetAttachmentProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttachment: connect.NewClient[proto.AttachmentActionRequest, proto.Attachment](
+			httpClient,
+			baseURL+ChecklistServiceDeleteAttachmentProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("DeleteAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		searchTasks: connect.NewClient[proto.SearchTasksRequest, proto.SearchTasksResponse](
+			httpClient,
+			baseURL+ChecklistServiceSearchTasksProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("SearchTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		createSavedView: connect.NewClient[proto.CreateSavedViewRequest, proto.SavedView](
+			httpClient,
+			baseURL+ChecklistServiceCreateSavedViewProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("CreateSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		listSavedViews: connect.NewClient[proto.ListSavedViewsRequest, proto.ListSavedViewsResponse](
+			httpClient,
+			baseURL+ChecklistServiceListSavedViewsProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("ListSavedViews")),
+			connect.WithClientOptions(opts...),
+		),
+		getSavedView: connect.NewClient[proto.SavedViewActionRequest, proto.SavedView](
+			httpClient,
+			baseURL+ChecklistServiceGetSavedViewProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSavedView: connect.NewClient[proto.UpdateSavedViewRequest, proto.SavedView](
+			httpClient,
+			baseURL+ChecklistServiceUpdateSavedViewProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("UpdateSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSavedView: connect.NewClient[proto.SavedViewActionRequest, proto.DeleteSavedViewResponse](
+			httpClient,
+			baseURL+ChecklistServiceDeleteSavedViewProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("DeleteSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		getStats: connect.NewClient[proto.GetStatsRequest, proto.Stats](
+			httpClient,
+			baseURL+ChecklistServiceGetStatsProcedure,
+			connect.WithSchema(checklistServiceMethods.ByName("GetStats")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// checklistServiceClient implements ChecklistServiceClient.
+type checklistServiceClient struct {
+	createTask                  *connect.Client[proto.CreateTaskRequest, proto.Task]
+	listTasks                   *connect.Client[proto.ListTasksRequest, proto.ListTasksResponse]
+	deleteTask                  *connect.Client[proto.TaskActionRequest, proto.DeleteTaskResponse]
+	markTaskDone                *connect.Client[proto.TaskActionRequest, proto.Task]
+	createChecklist             *connect.Client[proto.CreateChecklistRequest, proto.Checklist]
+	listChecklists              *connect.Client[proto.ListChecklistsRequest, proto.ListChecklistsResponse]
+	getChecklist                *connect.Client[proto.ChecklistActionRequest, proto.Checklist]
+	createShareLink             *connect.Client[proto.CreateShareLinkRequest, proto.ShareLink]
+	listShareLinks              *connect.Client[proto.ListShareLinksRequest, proto.ListShareLinksResponse]
+	revokeShareLink             *connect.Client[proto.RevokeShareLinkRequest, proto.ShareLink]
+	resolveShareLink            *connect.Client[proto.ResolveShareLinkRequest, proto.SharedChecklist]
+	setRecurrence               *connect.Client[proto.SetRecurrenceRequest, proto.Recurrence]
+	listRecurrences             *connect.Client[proto.ListRecurrencesRequest, proto.ListRecurrencesResponse]
+	deleteRecurrence            *connect.Client[proto.DeleteRecurrenceRequest, proto.DeleteRecurrenceResponse]
+	createTemplateFromChecklist *connect.Client[proto.CreateTemplateFromChecklistRequest, proto.Template]
+	listTemplates               *connect.Client[proto.ListTemplatesRequest, proto.ListTemplatesResponse]
+	getTemplate                 *connect.Client[proto.TemplateActionRequest, proto.Template]
+	instantiateTemplate         *connect.Client[proto.InstantiateTemplateRequest, proto.Checklist]
+	addDependency               *connect.Client[proto.TaskDependencyRequest, proto.Task]
+	removeDependency            *connect.Client[proto.TaskDependencyRequest, proto.Task]
+	setWorkflow                 *connect.Client[proto.SetWorkflowRequest, proto.Workflow]
+	getWorkflow                 *connect.Client[proto.GetWorkflowRequest, proto.Workflow]
+	transitionTask              *connect.Client[proto.TransitionTaskRequest, proto.Task]
+	getBoard                    *connect.Client[proto.GetBoardRequest, proto.Board]
+	addChecklistMember          *connect.Client[proto.ChecklistMemberRequest, proto.ChecklistMember]
+	removeChecklistMember       *connect.Client[proto.ChecklistMemberRequest, proto.RemoveChecklistMemberResponse]
+	listChecklistMembers        *connect.Client[proto.ListChecklistMembersRequest, proto.ListChecklistMembersResponse]
+	assignTask                  *connect.Client[proto.TaskAssigneeRequest, proto.Task]
+	unassignTask                *connect.Client[proto.TaskAssigneeRequest, proto.Task]
+	createAttachment            *connect.Client[proto.CreateAttachmentRequest, proto.Attachment]
+	listAttachments             *connect.Client[proto.ListAttachmentsRequest, proto.ListAttachmentsResponse]
+	getAttachment               *connect.Client[proto.AttachmentActionRequest, proto.Attachment]
+	deleteAttachment            *connect.Client[proto.AttachmentActionRequest, proto.Attachment]
+	searchTasks                 *connect.Client[proto.SearchTasksRequest, proto.SearchTasksResponse]
+	createSavedView             *connect.Client[proto.CreateSavedViewRequest, proto.SavedView]
+	listSavedViews              *connect.Client[proto.ListSavedViewsRequest, proto.ListSavedViewsResponse]
+	getSavedView                *connect.Client[proto.SavedViewActionRequest, proto.SavedView]
+	updateSavedView             *connect.Client[proto.UpdateSavedViewRequest, proto.SavedView]
+	deleteSavedView             *connect.Client[proto.SavedViewActionRequest, proto.DeleteSavedViewResponse]
+	getStats                    *connect.Client[proto.GetStatsRequest, proto.Stats]
+}
+
+// CreateTask calls proto.ChecklistService.CreateTask.
+func (c *checklistServiceClient) CreateTask(ctx context.Context, req *proto.CreateTaskRequest) (*proto.Task, error) {
+	response, err := c.createTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTasks calls proto.ChecklistService.ListTasks.
+func (c *checklistServiceClient) ListTasks(ctx context.Context, req *proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+	response, err := c.listTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTask calls proto.ChecklistService.DeleteTask.
+func (c *checklistServiceClient) DeleteTask(ctx context.Context, req *proto.TaskActionRequest) (*proto.DeleteTaskResponse, error) {
+	response, err := c.deleteTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MarkTaskDone calls proto.ChecklistService.MarkTaskDone.
+func (c *checklistServiceClient) MarkTaskDone(ctx context.Context, req *proto.TaskActionRequest) (*proto.Task, error) {
+	response, err := c.markTaskDone.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateChecklist calls proto.ChecklistService.CreateChecklist.
+func (c *checklistServiceClient) CreateChecklist(ctx context.Context, req *proto.CreateChecklistRequest) (*proto.Checklist, error) {
+	response, err := c.createChecklist.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListChecklists calls proto.ChecklistService.ListChecklists.
+func (c *checklistServiceClient) ListChecklists(ctx context.Context, req *proto.ListChecklistsRequest) (*proto.ListChecklistsResponse, error) {
+	response, err := c.listChecklists.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetChecklist calls proto.ChecklistService.GetChecklist.
+func (c *checklistServiceClient) GetChecklist(ctx context.Context, req *proto.ChecklistActionRequest) (*proto.Checklist, error) {
+	response, err := c.getChecklist.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateShareLink calls proto.ChecklistService.CreateShareLink.
+func (c *checklistServiceClient) CreateShareLink(ctx context.Context, req *proto.CreateShareLinkRequest) (*proto.ShareLink, error) {
+	response, err := c.createShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListShareLinks calls proto.ChecklistService.ListShareLinks.
+func (c *checklistServiceClient) ListShareLinks(ctx context.Context, req *proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error) {
+	response, err := c.listShareLinks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeShareLink calls proto.ChecklistService.RevokeShareLink.
+func (c *checklistServiceClient) RevokeShareLink(ctx context.Context, req *proto.RevokeShareLinkRequest) (*proto.ShareLink, error) {
+	response, err := c.revokeShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ResolveShareLink calls proto.ChecklistService.ResolveShareLink.
+func (c *checklistServiceClient) ResolveShareLink(ctx context.Context, req *proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error) {
+	response, err := c.resolveShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRecurrence calls proto.ChecklistService.SetRecurrence.
+func (c *checklistServiceClient) SetRecurrence(ctx context.Context, req *proto.SetRecurrenceRequest) (*proto.Recurrence, error) {
+	response, err := c.setRecurrence.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListRecurrences calls proto.ChecklistService.ListRecurrences.
+func (c *checklistServiceClient) ListRecurrences(ctx context.Context, req *proto.ListRecurrencesRequest) (*proto.ListRecurrencesResponse, error) {
+	response, err := c.listRecurrences.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteRecurrence calls proto.ChecklistService.DeleteRecurrence.
+func (c *checklistServiceClient) DeleteRecurrence(ctx context.Context, req *proto.DeleteRecurrenceRequest) (*proto.DeleteRecurrenceResponse, error) {
+	response, err := c.deleteRecurrence.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateTemplateFromChecklist calls proto.ChecklistService.CreateTemplateFromChecklist.
+func (c *checklistServiceClient) CreateTemplateFromChecklist(ctx context.Context, req *proto.CreateTemplateFromChecklistRequest) (*proto.Template, error) {
+	response, err := c.createTemplateFromChecklist.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTemplates calls proto.ChecklistService.ListTemplates.
+func (c *checklistServiceClient) ListTemplates(ctx context.Context, req *proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error) {
+	response, err := c.listTemplates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTemplate calls proto.ChecklistService.GetTemplate.
+func (c *checklistServiceClient) GetTemplate(ctx context.Context, req *proto.TemplateActionRequest) (*proto.Template, error) {
+	response, err := c.getTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// InstantiateTemplate calls proto.ChecklistService.InstantiateTemplate.
+func (c *checklistServiceClient) InstantiateTemplate(ctx context.Context, req *proto.InstantiateTemplateRequest) (*proto.Checklist, error) {
+	response, err := c.instantiateTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddDependency calls proto.ChecklistService.AddDependency.
+func (c *checklistServiceClient) AddDependency(ctx context.Context, req *proto.TaskDependencyRequest) (*proto.Task, error) {
+	response, err := c.addDependency.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveDependency calls proto.ChecklistService.RemoveDependency.
+func (c *checklistServiceClient) RemoveDependency(ctx context.Context, req *proto.TaskDependencyRequest) (*proto.Task, error) {
+	response, err := c.removeDependency.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetWorkflow calls proto.ChecklistService.SetWorkflow.
+func (c *checklistServiceClient) SetWorkflow(ctx context.Context, req *proto.SetWorkflowRequest) (*proto.Workflow, error) {
+	response, err := c.setWorkflow.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetWorkflow calls proto.ChecklistService.GetWorkflow.
+func (c *checklistServiceClient) GetWorkflow(ctx context.Context, req *proto.GetWorkflowRequest) (*proto.Workflow, error) {
+	response, err := c.getWorkflow.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TransitionTask calls proto.ChecklistService.TransitionTask.
+func (c *checklistServiceClient) TransitionTask(ctx context.Context, req *proto.TransitionTaskRequest) (*proto.Task, error) {
+	response, err := c.transitionTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBoard calls proto.ChecklistService.GetBoard.
+func (c *checklistServiceClient) GetBoard(ctx context.Context, req *proto.GetBoardRequest) (*proto.Board, error) {
+	response, err := c.getBoard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddChecklistMember calls proto.ChecklistService.AddChecklistMember.
+func (c *checklistServiceClient) AddChecklistMember(ctx context.Context, req *proto.ChecklistMemberRequest) (*proto.ChecklistMember, error) {
+	response, err := c.addChecklistMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveChecklistMember calls proto.ChecklistService.RemoveChecklistMember.
+func (c *checklistServiceClient) RemoveChecklistMember(ctx context.Context, req *proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error) {
+	response, err := c.removeChecklistMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListChecklistMembers calls proto.ChecklistService.ListChecklistMembers.
+func (c *checklistServiceClient) ListChecklistMembers(ctx context.Context, req *proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error) {
+	response, err := c.listChecklistMembers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AssignTask calls proto.ChecklistService.AssignTask.
+func (c *checklistServiceClient) AssignTask(ctx context.Context, req *proto.TaskAssigneeRequest) (*proto.Task, error) {
+	response, err := c.assignTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnassignTask calls proto.ChecklistService.UnassignTask.
+func (c *checklistServiceClient) UnassignTask(ctx context.Context, req *proto.TaskAssigneeRequest) (*proto.Task, error) {
+	response, err := c.unassignTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAttachment calls proto.ChecklistService.CreateAttachment.
+func (c *checklistServiceClient) CreateAttachment(ctx context.Context, req *proto.CreateAttachmentRequest) (*proto.Attachment, error) {
+	response, err := c.createAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAttachments calls proto.ChecklistService.ListAttachments.
+func (c *checklistServiceClient) ListAttachments(ctx context.Context, req *proto.ListAttachmentsRequest) (*proto.ListAttachmentsResponse, error) {
+	response, err := c.listAttachments.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAttachment calls proto.ChecklistService.GetAttachment.
+func (c *checklistServiceClient) GetAttachment(ctx context.Context, req *proto.AttachmentActionRequest) (*proto.Attachment, error) {
+	response, err := c.getAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAttachment calls proto.ChecklistService.DeleteAttachment.
+func (c *checklistServiceClient) DeleteAttachment(ctx context.Context, req *proto.AttachmentActionRequest) (*proto.Attachment, error) {
+	response, err := c.deleteAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchTasks calls proto.ChecklistService.SearchTasks.
+func (c *checklistServiceClient) SearchTasks(ctx context.Context, req *proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+	response, err := c.searchTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateSavedView calls proto.ChecklistService.CreateSavedView.
+func (c *checklistServiceClient) CreateSavedView(ctx context.Context, req *proto.CreateSavedViewRequest) (*proto.SavedView, error) {
+	response, err := c.createSavedView.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListSavedViews calls proto.ChecklistService.ListSavedViews.
+func (c *checklistServiceClient) ListSavedViews(ctx context.Context, req *proto.ListSavedViewsRequest) (*proto.ListSavedViewsResponse, error) {
+	response, err := c.listSavedViews.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetSavedView calls proto.ChecklistService.GetSavedView.
+func (c *checklistServiceClient) GetSavedView(ctx context.Context, req *proto.SavedViewActionRequest) (*proto.SavedView, error) {
+	response, err := c.getSavedView.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateSavedView calls proto.ChecklistService.UpdateSavedView.
+func (c *checklistServiceClient) UpdateSavedView(ctx context.Context, req *proto.UpdateSavedViewRequest) (*proto.SavedView, error) {
+	response, err := c.updateSavedView.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteSavedView calls proto.ChecklistService.DeleteSavedView.
+func (c *checklistServiceClient) DeleteSavedView(ctx context.Context, req *proto.SavedViewActionRequest) (*proto.DeleteSavedViewResponse, error) {
+	response, err := c.deleteSavedView.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetStats calls proto.ChecklistService.GetStats.
+func (c *checklistServiceClient) GetStats(ctx context.Context, req *proto.GetStatsRequest) (*proto.Stats, error) {
+	response, err := c.getStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChecklistServiceHandler is an implementation of the proto.ChecklistService service.
+type ChecklistServiceHandler interface {
+	// Для POST /create
+	CreateTask(context.Context, *proto.CreateTaskRequest) (*proto.Task, error)
+	// Для GET /list
+	ListTasks(context.Context, *proto.ListTasksRequest) (*proto.ListTasksResponse, error)
+	// Для DELETE /delete
+	DeleteTask(context.Context, *proto.TaskActionRequest) (*proto.DeleteTaskResponse, error)
+	// Для PUT /done
+	MarkTaskDone(context.Context, *proto.TaskActionRequest) (*proto.Task, error)
+	// Для POST /v1/checklists
+	CreateChecklist(context.Context, *proto.CreateChecklistRequest) (*proto.Checklist, error)
+	// Для GET /v1/checklists
+	ListChecklists(context.Context, *proto.ListChecklistsRequest) (*proto.ListChecklistsResponse, error)
+	// Для GET /v1/checklists/{id}
+	GetChecklist(context.Context, *proto.ChecklistActionRequest) (*proto.Checklist, error)
+	// Для POST /v1/checklists/{id}/shares
+	CreateShareLink(context.Context, *proto.CreateShareLinkRequest) (*proto.ShareLink, error)
+	// Для GET /v1/checklists/{id}/shares
+	ListShareLinks(context.Context, *proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error)
+	// Для DELETE /v1/shares/{id}
+	RevokeShareLink(context.Context, *proto.RevokeShareLinkRequest) (*proto.ShareLink, error)
+	// Для GET /share/{token}
+	ResolveShareLink(context.Context, *proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error)
+	// Для PUT /v1/tasks/{id}/recurrence и PUT /v1/checklists/{id}/recurrence
+	SetRecurrence(context.Context, *proto.SetRecurrenceRequest) (*proto.Recurrence, error)
+	// Для GET /v1/recurrences
+	ListRecurrences(context.Context, *proto.ListRecurrencesRequest) (*proto.ListRecurrencesResponse, error)
+	// Для DELETE /v1/recurrences/{id}
+	DeleteRecurrence(context.Context, *proto.DeleteRecurrenceRequest) (*proto.DeleteRecurrenceResponse, error)
+	// Для POST /v1/templates
+	CreateTemplateFromChecklist(context.Context, *proto.CreateTemplateFromChecklistRequest) (*proto.Template, error)
+	// Для GET /v1/templates
+	ListTemplates(context.Context, *proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error)
+	// Для GET /v1/templates/{id}
+	GetTemplate(context.Context, *proto.TemplateActionRequest) (*proto.Template, error)
+	// Для POST /v1/templates/{id}/instantiate
+	InstantiateTemplate(context.Context, *proto.InstantiateTemplateRequest) (*proto.Checklist, error)
+	// Для POST /v1/tasks/{id}/dependencies
+	AddDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error)
+	// Для DELETE /v1/tasks/{id}/dependencies/{depends_on_id}
+	RemoveDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error)
+	// Для PUT /v1/checklists/{id}/workflow
+	SetWorkflow(context.Context, *proto.SetWorkflowRequest) (*proto.Workflow, error)
+	// Для GET /v1/checklists/{id}/workflow
+	GetWorkflow(context.Context, *proto.GetWorkflowRequest) (*proto.Workflow, error)
+	// Для POST /v1/tasks/{id}/transition
+	TransitionTask(context.Context, *proto.TransitionTaskRequest) (*proto.Task, error)
+	// Для GET /v1/checklists/{id}/board
+	GetBoard(context.Context, *proto.GetBoardRequest) (*proto.Board, error)
+	// Для POST /v1/checklists/{id}/members
+	AddChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.ChecklistMember, error)
+	// Для DELETE /v1/checklists/{id}/members/{user_id}
+	RemoveChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error)
+	// Для GET /v1/checklists/{id}/members
+	ListChecklistMembers(context.Context, *proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error)
+	// Для POST /v1/tasks/{id}/assignees
+	AssignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error)
+	// Для DELETE /v1/tasks/{id}/assignees/{user_id}
+	UnassignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error)
+	// Для POST /v1/tasks/{id}/attachments
+	CreateAttachment(context.Context, *proto.CreateAttachmentRequest) (*proto.Attachment, error)
+	// Для GET /v1/tasks/{id}/attachments
+	ListAttachments(context.Context, *proto.ListAttachmentsRequest) (*proto.ListAttachmentsResponse, error)
+	// Для GET /v1/attachments/{id} и GET /v1/attachments/{id}/content
+	GetAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error)
+	// Для DELETE /v1/attachments/{id}; возвращает удаленные метаданные, чтобы удалить содержимое
+	DeleteAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error)
+	// Для GET /v1/search
+	SearchTasks(context.Context, *proto.SearchTasksRequest) (*proto.SearchTasksResponse, error)
+	// Для POST /v1/views
+	CreateSavedView(context.Context, *proto.CreateSavedViewRequest) (*proto.SavedView, error)
+	// Для GET /v1/views
+	ListSavedViews(context.Context, *proto.ListSavedViewsRequest) (*proto.ListSavedViewsResponse, error)
+	// Для GET /v1/views/{id}
+	GetSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.SavedView, error)
+	// Для PUT /v1/views/{id}
+	UpdateSavedView(context.Context, *proto.UpdateSavedViewRequest) (*proto.SavedView, error)
+	// Для DELETE /v1/views/{id}
+	DeleteSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.DeleteSavedViewResponse, error)
+	// Для GET /v1/stats
+	GetStats(context.Context, *proto.GetStatsRequest) (*proto.Stats, error)
+}
+
+// NewChecklistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChecklistServiceHandler(svc ChecklistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	checklistServiceMethods := proto.File_proto_checklist_proto.Services().ByName("ChecklistService").Methods()
+	checklistServiceCreateTaskHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateTaskProcedure,
+		svc.CreateTask,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListTasksHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListTasksProcedure,
+		svc.ListTasks,
+		connect.WithSchema(checklistServiceMethods.ByName("ListTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceDeleteTaskHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceDeleteTaskProcedure,
+		svc.DeleteTask,
+		connect.WithSchema(checklistServiceMethods.ByName("DeleteTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceMarkTaskDoneHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceMarkTaskDoneProcedure,
+		svc.MarkTaskDone,
+		connect.WithSchema(checklistServiceMethods.ByName("MarkTaskDone")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceCreateChecklistHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateChecklistProcedure,
+		svc.CreateChecklist,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateChecklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListChecklistsHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListChecklistsProcedure,
+		svc.ListChecklists,
+		connect.WithSchema(checklistServiceMethods.ByName("ListChecklists")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetChecklistHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetChecklistProcedure,
+		svc.GetChecklist,
+		connect.WithSchema(checklistServiceMethods.ByName("GetChecklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceCreateShareLinkHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateShareLinkProcedure,
+		svc.CreateShareLink,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListShareLinksHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListShareLinksProcedure,
+		svc.ListShareLinks,
+		connect.WithSchema(checklistServiceMethods.ByName("ListShareLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceRevokeShareLinkHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceRevokeShareLinkProcedure,
+		svc.RevokeShareLink,
+		connect.WithSchema(checklistServiceMethods.ByName("RevokeShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceResolveShareLinkHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceResolveShareLinkProcedure,
+		svc.ResolveShareLink,
+		connect.WithSchema(checklistServiceMethods.ByName("ResolveShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceSetRecurrenceHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceSetRecurrenceProcedure,
+		svc.SetRecurrence,
+		connect.WithSchema(checklistServiceMethods.ByName("SetRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListRecurrencesHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListRecurrencesProcedure,
+		svc.ListRecurrences,
+		connect.WithSchema(checklistServiceMethods.ByName("ListRecurrences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceDeleteRecurrenceHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceDeleteRecurrenceProcedure,
+		svc.DeleteRecurrence,
+		connect.WithSchema(checklistServiceMethods.ByName("DeleteRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceCreateTemplateFromChecklistHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateTemplateFromChecklistProcedure,
+		svc.CreateTemplateFromChecklist,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateTemplateFromChecklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListTemplatesHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListTemplatesProcedure,
+		svc.ListTemplates,
+		connect.WithSchema(checklistServiceMethods.ByName("ListTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetTemplateHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetTemplateProcedure,
+		svc.GetTemplate,
+		connect.WithSchema(checklistServiceMethods.ByName("GetTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceInstantiateTemplateHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceInstantiateTemplateProcedure,
+		svc.InstantiateTemplate,
+		connect.WithSchema(checklistServiceMethods.ByName("InstantiateTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceAddDependencyHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceAddDependencyProcedure,
+		svc.AddDependency,
+		connect.WithSchema(checklistServiceMethods.ByName("AddDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceRemoveDependencyHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceRemoveDependencyProcedure,
+		svc.RemoveDependency,
+		connect.WithSchema(checklistServiceMethods.ByName("RemoveDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceSetWorkflowHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceSetWorkflowProcedure,
+		svc.SetWorkflow,
+		connect.WithSchema(checklistServiceMethods.ByName("SetWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetWorkflowHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetWorkflowProcedure,
+		svc.GetWorkflow,
+		connect.WithSchema(checklistServiceMethods.ByName("GetWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceTransitionTaskHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceTransitionTaskProcedure,
+		svc.TransitionTask,
+		connect.WithSchema(checklistServiceMethods.ByName("TransitionTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetBoardHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetBoardProcedure,
+		svc.GetBoard,
+		connect.WithSchema(checklistServiceMethods.ByName("GetBoard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceAddChecklistMemberHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceAddChecklistMemberProcedure,
+		svc.AddChecklistMember,
+		connect.WithSchema(checklistServiceMethods.ByName("AddChecklistMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceRemoveChecklistMemberHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceRemoveChecklistMemberProcedure,
+		svc.RemoveChecklistMember,
+		connect.WithSchema(checklistServiceMethods.ByName("RemoveChecklistMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListChecklistMembersHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListChecklistMembersProcedure,
+		svc.ListChecklistMembers,
+		connect.WithSchema(checklistServiceMethods.ByName("ListChecklistMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceAssignTaskHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceAssignTaskProcedure,
+		svc.AssignTask,
+		connect.WithSchema(checklistServiceMethods.ByName("AssignTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceUnassignTaskHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceUnassignTaskProcedure,
+		svc.UnassignTask,
+		connect.WithSchema(checklistServiceMethods.ByName("UnassignTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceCreateAttachmentHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateAttachmentProcedure,
+		svc.CreateAttachment,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListAttachmentsHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(checklistServiceMethods.ByName("ListAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetAttachmentHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetAttachmentProcedure,
+		svc.GetAttachment,
+		connect.WithSchema(checklistServiceMethods.ByName("GetAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceDeleteAttachmentHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		connect.WithSchema(checklistServiceMethods.ByName("DeleteAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceSearchTasksHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceSearchTasksProcedure,
+		svc.SearchTasks,
+		connect.WithSchema(checklistServiceMethods.ByName("SearchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceCreateSavedViewHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceCreateSavedViewProcedure,
+		svc.CreateSavedView,
+		connect.WithSchema(checklistServiceMethods.ByName("CreateSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceListSavedViewsHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceListSavedViewsProcedure,
+		svc.ListSavedViews,
+		connect.WithSchema(checklistServiceMethods.ByName("ListSavedViews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetSavedViewHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetSavedViewProcedure,
+		svc.GetSavedView,
+		connect.WithSchema(checklistServiceMethods.ByName("GetSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceUpdateSavedViewHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceUpdateSavedViewProcedure,
+		svc.UpdateSavedView,
+		connect.WithSchema(checklistServiceMethods.ByName("UpdateSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceDeleteSavedViewHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceDeleteSavedViewProcedure,
+		svc.DeleteSavedView,
+		connect.WithSchema(checklistServiceMethods.ByName("DeleteSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	checklistServiceGetStatsHandler := connect.NewUnaryHandlerSimple(
+		ChecklistServiceGetStatsProcedure,
+		svc.GetStats,
+		connect.WithSchema(checklistServiceMethods.ByName("GetStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.ChecklistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChecklistServiceCreateTaskProcedure:
+			checklistServiceCreateTaskHandler.ServeHTTP(w, r)
+		case ChecklistServiceListTasksProcedure:
+			checklistServiceListTasksHandler.ServeHTTP(w, r)
+		case ChecklistServiceDeleteTaskProcedure:
+			checklistServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case ChecklistServiceMarkTaskDoneProcedure:
+			checklistServiceMarkTaskDoneHandler.ServeHTTP(w, r)
+		case ChecklistServiceCreateChecklistProcedure:
+			checklistServiceCreateChecklistHandler.ServeHTTP(w, r)
+		case ChecklistServiceListChecklistsProcedure:
+			checklistServiceListChecklistsHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetChecklistProcedure:
+			checklistServiceGetChecklistHandler.ServeHTTP(w, r)
+		case ChecklistServiceCreateShareLinkProcedure:
+			checklistServiceCreateShareLinkHandler.ServeHTTP(w, r)
+		case ChecklistServiceListShareLinksProcedure:
+			checklistServiceListShareLinksHandler.ServeHTTP(w, r)
+		case ChecklistServiceRevokeShareLinkProcedure:
+			checklistServiceRevokeShareLinkHandler.ServeHTTP(w, r)
+		case ChecklistServiceResolveShareLinkProcedure:
+			checklistServiceResolveShareLinkHandler.ServeHTTP(w, r)
+		case ChecklistServiceSetRecurrenceProcedure:
+			checklistServiceSetRecurrenceHandler.ServeHTTP(w, r)
+		case ChecklistServiceListRecurrencesProcedure:
+			checklistServiceListRecurrencesHandler.ServeHTTP(w, r)
+		case ChecklistServiceDeleteRecurrenceProcedure:
+			checklistServiceDeleteRecurrenceHandler.ServeHTTP(w, r)
+		case ChecklistServiceCreateTemplateFromChecklistProcedure:
+			checklistServiceCreateTemplateFromChecklistHandler.ServeHTTP(w, r)
+		case ChecklistServiceListTemplatesProcedure:
+			checklistServiceListTemplatesHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetTemplateProcedure:
+			checklistServiceGetTemplateHandler.ServeHTTP(w, r)
+		case ChecklistServiceInstantiateTemplateProcedure:
+			checklistServiceInstantiateTemplateHandler.ServeHTTP(w, r)
+		case ChecklistServiceAddDependencyProcedure:
+			checklistServiceAddDependencyHandler.ServeHTTP(w, r)
+		case ChecklistServiceRemoveDependencyProcedure:
+			checklistServiceRemoveDependencyHandler.ServeHTTP(w, r)
+		case ChecklistServiceSetWorkflowProcedure:
+			checklistServiceSetWorkflowHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetWorkflowProcedure:
+			checklistServiceGetWorkflowHandler.ServeHTTP(w, r)
+		case ChecklistServiceTransitionTaskProcedure:
+			checklistServiceTransitionTaskHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetBoardProcedure:
+			checklistServiceGetBoardHandler.ServeHTTP(w, r)
+		case ChecklistServiceAddChecklistMemberProcedure:
+			checklistServiceAddChecklistMemberHandler.ServeHTTP(w, r)
+		case ChecklistServiceRemoveChecklistMemberProcedure:
+			checklistServiceRemoveChecklistMemberHandler.ServeHTTP(w, r)
+		case ChecklistServiceListChecklistMembersProcedure:
+			checklistServiceListChecklistMembersHandler.ServeHTTP(w, r)
+		case ChecklistServiceAssignTaskProcedure:
+			checklistServiceAssignTaskHandler.ServeHTTP(w, r)
+		case ChecklistServiceUnassignTaskProcedure:
+			checklistServiceUnassignTaskHandler.ServeHTTP(w, r)
+		case ChecklistServiceCreateAttachmentProcedure:
+			checklistServiceCreateAttachmentHandler.ServeHTTP(w, r)
+		case ChecklistServiceListAttachmentsProcedure:
+			checklistServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetAttachmentProcedure:
+			checklistServiceGetAttachmentHandler.ServeHTTP(w, r)
+		case ChecklistServiceDeleteAttachmentProcedure:
+			checklistServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		case ChecklistServiceSearchTasksProcedure:
+			checklistServiceSearchTasksHandler.ServeHTTP(w, r)
+		case ChecklistServiceCreateSavedViewProcedure:
+			checklistServiceCreateSavedViewHandler.ServeHTTP(w, r)
+		case ChecklistServiceListSavedViewsProcedure:
+			checklistServiceListSavedViewsHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetSavedViewProcedure:
+			checklistServiceGetSavedViewHandler.ServeHTTP(w, r)
+		case ChecklistServiceUpdateSavedViewProcedure:
+			checklistServiceUpdateSavedViewHandler.ServeHTTP(w, r)
+		case ChecklistServiceDeleteSavedViewProcedure:
+			checklistServiceDeleteSavedViewHandler.ServeHTTP(w, r)
+		case ChecklistServiceGetStatsProcedure:
+			checklistServiceGetStatsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChecklistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChecklistServiceHandler struct{}
+
+func (UnimplementedChecklistServiceHandler) CreateTask(context.Context, *proto.CreateTaskRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateTask is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListTasks(context.Context, *proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListTasks is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) DeleteTask(context.Context, *proto.TaskActionRequest) (*proto.DeleteTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.DeleteTask is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) MarkTaskDone(context.Context, *proto.TaskActionRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.MarkTaskDone is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) CreateChecklist(context.Context, *proto.CreateChecklistRequest) (*proto.Checklist, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateChecklist is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListChecklists(context.Context, *proto.ListChecklistsRequest) (*proto.ListChecklistsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListChecklists is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetChecklist(context.Context, *proto.ChecklistActionRequest) (*proto.Checklist, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetChecklist is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) CreateShareLink(context.Context, *proto.CreateShareLinkRequest) (*proto.ShareLink, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateShareLink is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListShareLinks(context.Context, *proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListShareLinks is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) RevokeShareLink(context.Context, *proto.RevokeShareLinkRequest) (*proto.ShareLink, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.RevokeShareLink is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ResolveShareLink(context.Context, *proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ResolveShareLink is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) SetRecurrence(context.Context, *proto.SetRecurrenceRequest) (*proto.Recurrence, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.SetRecurrence is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListRecurrences(context.Context, *proto.ListRecurrencesRequest) (*proto.ListRecurrencesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListRecurrences is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) DeleteRecurrence(context.Context, *proto.DeleteRecurrenceRequest) (*proto.DeleteRecurrenceResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.DeleteRecurrence is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) CreateTemplateFromChecklist(context.Context, *proto.CreateTemplateFromChecklistRequest) (*proto.Template, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateTemplateFromChecklist is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListTemplates(context.Context, *proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListTemplates is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetTemplate(context.Context, *proto.TemplateActionRequest) (*proto.Template, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetTemplate is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) InstantiateTemplate(context.Context, *proto.InstantiateTemplateRequest) (*proto.Checklist, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.InstantiateTemplate is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) AddDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.AddDependency is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) RemoveDependency(context.Context, *proto.TaskDependencyRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.RemoveDependency is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) SetWorkflow(context.Context, *proto.SetWorkflowRequest) (*proto.Workflow, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.SetWorkflow is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetWorkflow(context.Context, *proto.GetWorkflowRequest) (*proto.Workflow, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetWorkflow is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) TransitionTask(context.Context, *proto.TransitionTaskRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.TransitionTask is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetBoard(context.Context, *proto.GetBoardRequest) (*proto.Board, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetBoard is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) AddChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.ChecklistMember, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.AddChecklistMember is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) RemoveChecklistMember(context.Context, *proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.RemoveChecklistMember is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListChecklistMembers(context.Context, *proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListChecklistMembers is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) AssignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.AssignTask is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) UnassignTask(context.Context, *proto.TaskAssigneeRequest) (*proto.Task, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.UnassignTask is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) CreateAttachment(context.Context, *proto.CreateAttachmentRequest) (*proto.Attachment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateAttachment is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListAttachments(context.Context, *proto.ListAttachmentsRequest) (*proto.ListAttachmentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetAttachment is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) DeleteAttachment(context.Context, *proto.AttachmentActionRequest) (*proto.Attachment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.DeleteAttachment is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) SearchTasks(context.Context, *proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.SearchTasks is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) CreateSavedView(context.Context, *proto.CreateSavedViewRequest) (*proto.SavedView, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.CreateSavedView is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) ListSavedViews(context.Context, *proto.ListSavedViewsRequest) (*proto.ListSavedViewsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.ListSavedViews is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.SavedView, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetSavedView is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) UpdateSavedView(context.Context, *proto.UpdateSavedViewRequest) (*proto.SavedView, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.UpdateSavedView is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) DeleteSavedView(context.Context, *proto.SavedViewActionRequest) (*proto.DeleteSavedViewResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.DeleteSavedView is not implemented"))
+}
+
+func (UnimplementedChecklistServiceHandler) GetStats(context.Context, *proto.GetStatsRequest) (*proto.Stats, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ChecklistService.GetStats is not implemented"))
+}
+
+// CommentServiceClient is a client for the proto.CommentService service.
+type CommentServiceClient interface {
+	// Для POST /v1/tasks/{id}/comments
+	AddComment(context.Context, *proto.AddCommentRequest) (*proto.Comment, error)
+	// Для GET /v1/tasks/{id}/comments
+	ListComments(context.Context, *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error)
+	// Для PATCH /v1/tasks/{task_id}/comments/{id}
+	EditComment(context.Context, *proto.EditCommentRequest) (*proto.Comment, error)
+	// Для DELETE /v1/tasks/{task_id}/comments/{id}
+	DeleteComment(context.Context, *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error)
+}
+
+// NewCommentServiceClient constructs a client for the proto.CommentService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCommentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CommentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	commentServiceMethods := proto.File_proto_checklist_proto.Services().ByName("CommentService").Methods()
+	return &commentServiceClient{
+		addComment: connect.NewClient[proto.AddCommentRequest, proto.Comment](
+			httpClient,
+			baseURL+CommentServiceAddCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listComments: connect.NewClient[proto.ListCommentsRequest, proto.ListCommentsResponse](
+			httpClient,
+			baseURL+CommentServiceListCommentsProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+			connect.WithClientOptions(opts...),
+		),
+		editComment: connect.NewClient[proto.EditCommentRequest, proto.Comment](
+			httpClient,
+			baseURL+CommentServiceEditCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteComment: connect.NewClient[proto.DeleteCommentRequest, proto.DeleteCommentResponse](
+			httpClient,
+			baseURL+CommentServiceDeleteCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// commentServiceClient implements CommentServiceClient.
+type commentServiceClient struct {
+	addComment    *connect.Client[proto.AddCommentRequest, proto.Comment]
+	listComments  *connect.Client[proto.ListCommentsRequest, proto.ListCommentsResponse]
+	editComment   *connect.Client[proto.EditCommentRequest, proto.Comment]
+	deleteComment *connect.Client[proto.DeleteCommentRequest, proto.DeleteCommentResponse]
+}
+
+// AddComment calls proto.CommentService.AddComment.
+func (c *commentServiceClient) AddComment(ctx context.Context, req *proto.AddCommentRequest) (*proto.Comment, error) {
+	response, err := c.addComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListComments calls proto.CommentService.ListComments.
+func (c *commentServiceClient) ListComments(ctx context.Context, req *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+	response, err := c.listComments.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditComment calls proto.CommentService.EditComment.
+func (c *commentServiceClient) EditComment(ctx context.Context, req *proto.EditCommentRequest) (*proto.Comment, error) {
+	response, err := c.editComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteComment calls proto.CommentService.DeleteComment.
+func (c *commentServiceClient) DeleteComment(ctx context.Context, req *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error) {
+	response, err := c.deleteComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CommentServiceHandler is an implementation of the proto.CommentService service.
+type CommentServiceHandler interface {
+	// Для POST /v1/tasks/{id}/comments
+	AddComment(context.Context, *proto.AddCommentRequest) (*proto.Comment, error)
+	// Для GET /v1/tasks/{id}/comments
+	ListComments(context.Context, *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error)
+	// Для PATCH /v1/tasks/{task_id}/comments/{id}
+	EditComment(context.Context, *proto.EditCommentRequest) (*proto.Comment, error)
+	// Для DELETE /v1/tasks/{task_id}/comments/{id}
+	DeleteComment(context.Context, *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error)
+}
+
+// NewCommentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCommentServiceHandler(svc CommentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	commentServiceMethods := proto.File_proto_checklist_proto.Services().ByName("CommentService").Methods()
+	commentServiceAddCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceAddCommentProcedure,
+		svc.AddComment,
+		connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceListCommentsHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceListCommentsProcedure,
+		svc.ListComments,
+		connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceEditCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceEditCommentProcedure,
+		svc.EditComment,
+		connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceDeleteCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceDeleteCommentProcedure,
+		svc.DeleteComment,
+		connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.CommentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CommentServiceAddCommentProcedure:
+			commentServiceAddCommentHandler.ServeHTTP(w, r)
+		case CommentServiceListCommentsProcedure:
+			commentServiceListCommentsHandler.ServeHTTP(w, r)
+		case CommentServiceEditCommentProcedure:
+			commentServiceEditCommentHandler.ServeHTTP(w, r)
+		case CommentServiceDeleteCommentProcedure:
+			commentServiceDeleteCommentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCommentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCommentServiceHandler struct{}
+
+func (UnimplementedCommentServiceHandler) AddComment(context.Context, *proto.AddCommentRequest) (*proto.Comment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.CommentService.AddComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) ListComments(context.Context, *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.CommentService.ListComments is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) EditComment(context.Context, *proto.EditCommentRequest) (*proto.Comment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.CommentService.EditComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) DeleteComment(context.Context, *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.CommentService.DeleteComment is not implemented"))
+}
@@ -1,8 +1,11 @@
 package api
 
 type CreateTaskRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	ChecklistID string   `json:"checklist_id"`
+	DueAt       string   `json:"due_at"`
+	Tags        []string `json:"tags"`
 }
 
 type TaskActionRequest struct {
@@ -10,10 +13,245 @@ type TaskActionRequest struct {
 }
 
 type TaskResponse struct {
-	ID          string `json:"id"`
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Done         bool     `json:"completed"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+	ChecklistID  string   `json:"checklist_id,omitempty"`
+	DueAt        string   `json:"due_at,omitempty"`
+	CompletedAt  string   `json:"completed_at,omitempty"`
+	RecurrenceID string   `json:"recurrence_id,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Blocked      bool     `json:"blocked"`
+	DependsOn    []string `json:"depends_on,omitempty"`
+	Status       string   `json:"status"`
+	AssigneeIDs  []string `json:"assignee_ids,omitempty"`
+}
+
+type AddDependencyRequest struct {
+	DependsOnID string `json:"depends_on_id"`
+}
+
+type CreateChecklistRequest struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
-	Done        bool   `json:"completed"`
+}
+
+type ChecklistResponse struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+	RecurrenceID string `json:"recurrence_id,omitempty"`
+	OccurrenceAt string `json:"occurrence_at,omitempty"`
+}
+
+type CreateShareLinkRequest struct {
+	ExpiresAt string `json:"expires_at"`
+	Password  string `json:"password"`
+}
+
+type ShareLinkResponse struct {
+	ID                string `json:"id"`
+	ChecklistID       string `json:"checklist_id"`
+	Token             string `json:"token,omitempty"`
+	URL               string `json:"url,omitempty"`
+	PasswordProtected bool   `json:"password_protected"`
+	ExpiresAt         string `json:"expires_at,omitempty"`
+	RevokedAt         string `json:"revoked_at,omitempty"`
+	CreatedAt         string `json:"created_at"`
+}
+
+type SharedChecklistResponse struct {
+	Checklist *ChecklistResponse `json:"checklist"`
+	Tasks     []*TaskResponse    `json:"tasks"`
+}
+
+type SetRecurrenceRequest struct {
+	RRule    string `json:"rrule"`
+	DTStart  string `json:"dtstart"`
+	Timezone string `json:"timezone"`
+}
+
+type RecurrenceResponse struct {
+	ID               string `json:"id"`
+	TaskID           string `json:"task_id,omitempty"`
+	ChecklistID      string `json:"checklist_id,omitempty"`
+	RRule            string `json:"rrule"`
+	DTStart          string `json:"dtstart"`
+	Timezone         string `json:"timezone"`
+	NextOccurrenceAt string `json:"next_occurrence_at,omitempty"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+	// LastError says why the scheduler disabled the rule; it is empty while
+	// the rule runs.
+	LastError string `json:"last_error,omitempty"`
+}
+
+type CreateTemplateRequest struct {
+	ChecklistID    string `json:"checklist_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ChecklistTitle string `json:"checklist_title"`
+}
+
+type TaskBlueprintResponse struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	DueOffset   *int64   `json:"due_offset_seconds,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type TemplateResponse struct {
+	ID             string                   `json:"id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	ChecklistTitle string                   `json:"checklist_title"`
+	Tasks          []*TaskBlueprintResponse `json:"tasks"`
+	CreatedAt      string                   `json:"created_at"`
+	UpdatedAt      string                   `json:"updated_at"`
+}
+
+type InstantiateTemplateRequest struct {
+	Variables map[string]string `json:"variables"`
+	StartAt   string            `json:"start_at"`
+}
+
+type WorkflowState struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	IsDone bool   `json:"is_done"`
+}
+
+type WorkflowTransition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type SetWorkflowRequest struct {
+	States      []WorkflowState      `json:"states"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+type WorkflowResponse struct {
+	ChecklistID string               `json:"checklist_id"`
+	States      []WorkflowState      `json:"states"`
+	Transitions []WorkflowTransition `json:"transitions"`
+	IsDefault   bool                 `json:"is_default"`
+}
+
+type TransitionTaskRequest struct {
+	Status string `json:"status"`
+}
+
+type BoardColumnResponse struct {
+	State WorkflowState   `json:"state"`
+	Tasks []*TaskResponse `json:"tasks"`
+}
+
+type BoardResponse struct {
+	Checklist *ChecklistResponse     `json:"checklist"`
+	Columns   []*BoardColumnResponse `json:"columns"`
+}
+
+type ChecklistMemberRequest struct {
+	UserID string `json:"user_id"`
+}
+
+type ChecklistMemberResponse struct {
+	ChecklistID string `json:"checklist_id"`
+	UserID      string `json:"user_id"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type AssignTaskRequest struct {
+	UserID string `json:"user_id"`
+}
+
+type CommentRequest struct {
+	Body string `json:"body"`
+}
+
+type CommentResponse struct {
+	ID        string `json:"id"`
+	TaskID    string `json:"task_id"`
+	AuthorID  string `json:"author_id"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	EditedAt  string `json:"edited_at,omitempty"`
+}
+
+type CommentListResponse struct {
+	Comments      []*CommentResponse `json:"comments"`
+	NextPageToken string             `json:"next_page_token,omitempty"`
+}
+
+type AttachmentResponse struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	SHA256      string `json:"sha256"`
+	UploadedBy  string `json:"uploaded_by,omitempty"`
 	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
-}
\ No newline at end of file
+	URL         string `json:"url"`
+}
+
+type SearchResultResponse struct {
+	Task               *TaskResponse `json:"task"`
+	Rank               float32       `json:"rank"`
+	TitleHighlight     string        `json:"title_highlight"`
+	DescriptionSnippet string        `json:"description_snippet,omitempty"`
+	CommentID          string        `json:"comment_id,omitempty"`
+	CommentSnippet     string        `json:"comment_snippet,omitempty"`
+}
+
+type SavedViewRequest struct {
+	Name   string `json:"name"`
+	Filter string `json:"filter"`
+}
+
+type SavedViewResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Filter    string `json:"filter"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type ChecklistStatsResponse struct {
+	ChecklistID     string  `json:"checklist_id,omitempty"`
+	ChecklistTitle  string  `json:"checklist_title,omitempty"`
+	Total           int64   `json:"total"`
+	Completed       int64   `json:"completed"`
+	CompletionRatio float64 `json:"completion_ratio"`
+	// MedianTimeToCompleteSeconds is null when no task has been completed.
+	MedianTimeToCompleteSeconds *float64 `json:"median_time_to_complete_seconds"`
+	Overdue                     int64    `json:"overdue"`
+}
+
+type StatsBucketResponse struct {
+	Start     string `json:"start"`
+	Created   int64  `json:"created"`
+	Completed int64  `json:"completed"`
+}
+
+type StatsResponse struct {
+	From       string                    `json:"from"`
+	To         string                    `json:"to"`
+	GroupBy    string                    `json:"group_by"`
+	Timezone   string                    `json:"timezone"`
+	Totals     *ChecklistStatsResponse   `json:"totals"`
+	Checklists []*ChecklistStatsResponse `json:"checklists"`
+	Series     []*StatsBucketResponse    `json:"series"`
+}
+
+type HealthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
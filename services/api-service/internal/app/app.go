@@ -3,93 +3,155 @@ package app
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
+	"checklist-go/internal/config"
+	"checklist-go/internal/lifecycle"
+	"checklist-go/internal/tracing"
 	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/blob"
 	"checklist-go/services/api-service/internal/handlers"
+	"checklist-go/services/api-service/internal/logging"
+	"checklist-go/services/api-service/internal/openapi"
 
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type App struct {
+	cfg *config.API
 	httpServer *http.Server
 	grpcServer *grpc.ClientConn
+	healthHandler *handlers.HealthHandler
+	shutdownTracing func(context.Context) error
 }
 
-func New() (*App, error) {
+func New(cfg *config.API) (*App, error) {
+	logging.Setup("api-service", cfg.LogLevel)
 
-	dbServiceAddr := "db-service:50051"
+	dbServiceAddr := cfg.DBServiceAddr()
 
-	conn, err := grpc.NewClient(dbServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	shutdownTracing, err := tracing.Setup(context.Background(), "api-service")
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tracing: %w", err)
+	}
+
+	conn, err := grpc.NewClient(dbServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(logging.UnaryClientInterceptor),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to db service: %w", err)
 	}
 	
 
 	grpcClient := proto.NewChecklistServiceClient(conn)
-	log.Println("Successfully connected to db-service")
+	commentClient := proto.NewCommentServiceClient(conn)
+	slog.Info("Successfully connected to db-service", "addr", dbServiceAddr)
 
-	taskHandler := handlers.NewTaskHandler(grpcClient)
+	timeout := cfg.RequestTimeout
+	shareHandler := handlers.NewShareHandler(grpcClient, timeout)
+	assigneeHandler := handlers.NewAssigneeHandler(grpcClient, timeout)
+	commentHandler := handlers.NewCommentHandler(commentClient, timeout)
 
+	blobStore, err := newBlobStore(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize blob store: %w", err)
+	}
+	attachmentHandler := handlers.NewAttachmentHandler(grpcClient, blobStore, cfg.MaxAttachmentSize, timeout)
+	searchHandler := handlers.NewSearchHandler(grpcClient, timeout)
+	viewHandler := handlers.NewViewHandler(grpcClient, timeout)
+	statsHandler := handlers.NewStatsHandler(grpcClient, timeout)
+	healthHandler := handlers.NewHealthHandler(healthpb.NewHealthClient(conn))
+	gateway, err := handlers.NewGateway(grpcClient, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize gateway: %w", err)
+	}
 
-	router := chi.NewRouter()
-	router.Use(middleware.Logger)
-	router.Use(middleware.Recoverer)
-
-	router.Post("/create", taskHandler.CreateTask)
-	router.Get("/list", taskHandler.ListTasks)
-	router.Delete("/delete", taskHandler.DeleteTask)
-	router.Put("/done", taskHandler.MarkTaskDone)
 
-	httpServerAddr := os.Getenv("HTTP_SERVER_ADDR")
-	if httpServerAddr == "" {
-		httpServerAddr = ":8080"
+	doc, err := openapi.Document()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI document: %w", err)
 	}
-
+	router := newRouter(routeHandlers{
+		share:      shareHandler,
+		assignee:   assigneeHandler,
+		comment:    commentHandler,
+		attachment: attachmentHandler,
+		search:     searchHandler,
+		view:       viewHandler,
+		stats:      statsHandler,
+		health:     healthHandler,
+		gateway:    gateway,
+	}, doc)
+
+	// No WriteTimeout: attachment downloads stream for as long as they take.
 	server := &http.Server{
-		Addr:    httpServerAddr,
-		Handler: router,
+		Addr:              cfg.HTTPAddr(),
+		Handler:           router,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
 	}
 
 	return &App{
 		httpServer: server,
+		cfg: cfg,
 		grpcServer: conn,
+		healthHandler: healthHandler,
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
-func (a *App) Run() {
-	go func(){
-	log.Printf("HTTP server is listening on %s", a.httpServer.Addr)
-		if err:= a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("HTTP server failed to start: %v", err)
+// Run serves until SIGINT or SIGTERM and then fails /readyz for the drain
+// delay, so the load balancer stops routing here, before it lets in-flight
+// requests finish and closes the connection to the db-service.
+func (a *App) Run() error {
+	r := lifecycle.New(a.cfg.ShutdownTimeout)
+
+	r.Serve("HTTP server", func() error {
+		slog.Info("HTTP server is listening", "addr", a.httpServer.Addr)
+		return a.httpServer.ListenAndServe()
+	})
+
+	// The steps run in the reverse of this order.
+	r.OnShutdown("tracing", a.shutdownTracing)
+	r.OnShutdown("gRPC client connection", func(context.Context) error {
+		return a.grpcServer.Close()
+	})
+	r.OnShutdown("HTTP server", a.httpServer.Shutdown)
+	r.OnShutdown("drain", func(ctx context.Context) error {
+		a.healthHandler.StartDraining()
+		select {
+		case <-time.After(a.cfg.ShutdownDrainDelay):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
 		}
-	}()
-
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit 
-
-	log.Println("Shutting down HTTP server...")
+	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := a.httpServer.Shutdown(ctx); err != nil {
-		log.Fatalf("HTTP server failed to shutdown: %v", err)
-	}
+	return r.Run(context.Background())
+}
 
-	if err := a.grpcServer.Close(); err != nil {
-		log.Fatalf("gRPC client connection failed to close: %v", err)
+// newBlobStore builds the attachment store selected by cfg.BlobStore: "local"
+// keeps files under cfg.BlobDir, "s3" uses an S3-compatible bucket such as
+// MinIO.
+func newBlobStore(cfg *config.API) (blob.Store, error) {
+	if cfg.BlobStore == "s3" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return blob.NewS3Store(ctx, blob.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Bucket:          cfg.S3Bucket,
+			Region:          cfg.S3Region,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UseSSL:          cfg.S3UseSSL,
+		})
 	}
-
-	log.Println("HTTP server and gRPC client connection closed")
-
-}
\ No newline at end of file
+	return blob.NewLocalStore(cfg.BlobDir)
+}
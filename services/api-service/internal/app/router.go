@@ -0,0 +1,84 @@
+package app
+
+import (
+	"net/http"
+
+	"checklist-go/services/api-service/internal/handlers"
+	"checklist-go/services/api-service/internal/logging"
+	"checklist-go/services/api-service/internal/metrics"
+	"checklist-go/services/api-service/internal/openapi"
+	"checklist-go/services/api-service/internal/tracing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// routeHandlers are the handlers newRouter mounts.
+type routeHandlers struct {
+	share      *handlers.ShareHandler
+	assignee   *handlers.AssigneeHandler
+	comment    *handlers.CommentHandler
+	attachment *handlers.AttachmentHandler
+	search     *handlers.SearchHandler
+	view       *handlers.ViewHandler
+	stats      *handlers.StatsHandler
+	health     *handlers.HealthHandler
+	// gateway serves the RPCs annotated with google.api.http. It answers
+	// every request no other route matches, so an annotated RPC is served
+	// without being mounted here.
+	gateway http.Handler
+}
+
+// newRouter mounts every route of the api-service. Routes added here, and
+// those of the gateway, must also be described in package openapi;
+// TestRoutesDocumented checks that.
+func newRouter(h routeHandlers, doc []byte) *chi.Mux {
+	router := chi.NewRouter()
+	router.Use(tracing.Middleware)
+	router.Use(logging.Middleware)
+	// Outside Recoverer so that panics are counted as 500s.
+	router.Use(metrics.Middleware)
+	router.Use(middleware.Recoverer)
+
+	router.Method(http.MethodGet, "/metrics", metrics.Handler())
+	router.Get("/healthz", h.health.Healthz)
+	router.Get("/readyz", h.health.Readyz)
+
+	// Set before the /v1 routes so that they inherit it.
+	router.NotFound(h.gateway.ServeHTTP)
+	router.MethodNotAllowed(h.gateway.ServeHTTP)
+
+	router.Route("/v1", func(r chi.Router) {
+		r.Post("/checklists/{id}/shares", h.share.CreateShareLink)
+		r.Get("/checklists/{id}/shares", h.share.ListShareLinks)
+		r.Get("/me/tasks", h.assignee.MyTasks)
+
+		r.Post("/tasks/{id}/comments", h.comment.AddComment)
+		r.Get("/tasks/{id}/comments", h.comment.ListComments)
+		r.Patch("/tasks/{id}/comments/{commentID}", h.comment.EditComment)
+		r.Delete("/tasks/{id}/comments/{commentID}", h.comment.DeleteComment)
+
+		r.Post("/tasks/{id}/attachments", h.attachment.Upload)
+		r.Get("/tasks/{id}/attachments", h.attachment.List)
+		r.Get("/attachments/{id}", h.attachment.Get)
+		r.Get("/attachments/{id}/content", h.attachment.Download)
+		r.Delete("/attachments/{id}", h.attachment.Delete)
+
+		r.Get("/search", h.search.Search)
+
+		r.Get("/views/{id}/tasks", h.view.ListViewTasks)
+
+		r.Get("/stats", h.stats.GetStats)
+	})
+
+	// Публичные ссылки только для чтения, без аутентификации
+	router.Get("/share/{token}", h.share.ViewSharedChecklist)
+	router.Post("/share/{token}", h.share.ViewSharedChecklist)
+
+	router.Get("/openapi.json", openapi.Handler(doc))
+	docs := openapi.DocsHandler("/docs/", "/openapi.json")
+	router.Handle("/docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently))
+	router.Handle("/docs/*", docs)
+
+	return router
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+	"checklist-go/services/api-service/internal/openapi"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// TestRoutesDocumented checks that the router, together with the gateway,
+// and the OpenAPI document list the same routes.
+func TestRoutesDocumented(t *testing.T) {
+	var mounted []string
+	err := chi.Walk(newRouter(routeHandlers{gateway: http.NotFoundHandler()}, nil), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if strings.HasPrefix(route, "/docs") {
+			return nil
+		}
+		mounted = append(mounted, normalizeRoute(method+" "+strings.TrimSuffix(route, "/")))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, route := range annotatedRoutes() {
+		mounted = append(mounted, normalizeRoute(route))
+	}
+	var documented []string
+	for _, route := range openapi.Routes() {
+		documented = append(documented, normalizeRoute(route))
+	}
+
+	for _, route := range mounted {
+		if !slices.Contains(documented, route) {
+			t.Errorf("%s is not documented", route)
+		}
+	}
+	for _, route := range documented {
+		if !slices.Contains(mounted, route) {
+			t.Errorf("%s is documented but not mounted", route)
+		}
+	}
+}
+
+// TestGatewayRoutes checks that every binding of a google.api.http
+// annotation reaches the gateway through the router. The RPCs reach a
+// server that denies all of them, so a request the gateway routed answers
+// 403; a route shadowed by a hand-written one panics on its nil handler
+// (500), and an unrouted one answers 404 or 405.
+func TestGatewayRoutes(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(any, grpc.ServerStream) error {
+		return status.Error(codes.PermissionDenied, "reached the server")
+	}))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	gateway, err := handlers.NewGateway(proto.NewChecklistServiceClient(conn), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := newRouter(routeHandlers{gateway: gateway}, nil)
+	routes := annotatedRoutes()
+	if len(routes) == 0 {
+		t.Fatal("no RPC has a google.api.http annotation")
+	}
+	for _, route := range routes {
+		method, path, _ := strings.Cut(route, " ")
+		path = templateVariable.ReplaceAllString(path, "x")
+		// Enough fields for any of the bodies; routes without one ignore it.
+		body := `{"id":"x","title":"x","rrule":"x","checklist_id":"x","name":"x","depends_on_id":"x","status":"x"}`
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s: status %d, want 403 from the server: %s", route, rec.Code, rec.Body)
+		}
+	}
+}
+
+// templateVariable matches a path parameter of chi or a variable of a
+// google.api.http path template.
+var templateVariable = regexp.MustCompile(`\{[^}]*\}`)
+
+// normalizeRoute drops the names of the path parameters of route.
+func normalizeRoute(route string) string {
+	return templateVariable.ReplaceAllString(route, "{}")
+}
+
+// annotatedRoutes returns the "METHOD /path" of every google.api.http
+// binding in checklist.proto.
+func annotatedRoutes() []string {
+	var routes []string
+	services := proto.File_proto_checklist_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			rule, _ := gproto.GetExtension(methods.Get(j).Options(), annotations.E_Http).(*annotations.HttpRule)
+			if rule == nil {
+				continue
+			}
+			for _, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+				switch p := binding.GetPattern().(type) {
+				case *annotations.HttpRule_Get:
+					routes = append(routes, http.MethodGet+" "+p.Get)
+				case *annotations.HttpRule_Post:
+					routes = append(routes, http.MethodPost+" "+p.Post)
+				case *annotations.HttpRule_Put:
+					routes = append(routes, http.MethodPut+" "+p.Put)
+				case *annotations.HttpRule_Patch:
+					routes = append(routes, http.MethodPatch+" "+p.Patch)
+				case *annotations.HttpRule_Delete:
+					routes = append(routes, http.MethodDelete+" "+p.Delete)
+				case *annotations.HttpRule_Custom:
+					routes = append(routes, p.Custom.Kind+" "+p.Custom.Path)
+				}
+			}
+		}
+	}
+	return routes
+}
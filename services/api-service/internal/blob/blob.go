@@ -0,0 +1,25 @@
+// Package blob stores attachment content outside of Postgres. The store is
+// selected at startup: the local filesystem for single-node deployments, or
+// any S3-compatible service (AWS S3, MinIO) when the api-service is scaled out.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by Get when the store has no object under the key.
+var ErrNotFound = errors.New("blob not found")
+
+// Store is a flat key/value store for large objects. Keys are slash-separated
+// paths generated by the api-service, never user input.
+type Store interface {
+	// Put reads r to EOF and stores it under key, replacing any existing
+	// object. A failed Put leaves no partial object behind.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get opens the object for reading. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+}
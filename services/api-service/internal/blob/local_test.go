@@ -0,0 +1,131 @@
+package blob_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"checklist-go/services/api-service/internal/blob"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put(ctx, "attachments/task/one", strings.NewReader("first"), "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(ctx, "attachments/task/one", strings.NewReader("second"), "text/plain"); err != nil {
+		t.Fatalf("Put again: %v", err)
+	}
+	if got := read(t, store, "attachments/task/one"); got != "second" {
+		t.Errorf("Get = %q, want the replaced content", got)
+	}
+
+	if err := store.Delete(ctx, "attachments/task/one"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Get(ctx, "attachments/task/one"); !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Get after Delete = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "attachments/task/one"); err != nil {
+		t.Errorf("Delete of a missing object = %v, want nil", err)
+	}
+}
+
+// TestLocalStoreFailedPut checks that a Put whose reader fails leaves neither
+// the object nor its temporary file behind.
+func TestLocalStoreFailedPut(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := blob.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	broken := io.MultiReader(strings.NewReader("partial"), errReader{})
+	if err := store.Put(ctx, "attachments/task/one", broken, "text/plain"); err == nil {
+		t.Fatal("Put with a failing reader succeeded")
+	}
+	if _, err := store.Get(ctx, "attachments/task/one"); !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Get = %v, want ErrNotFound", err)
+	}
+	if files := listFiles(t, root); len(files) != 0 {
+		t.Errorf("files left behind: %v", files)
+	}
+}
+
+func TestLocalStoreRejectsEscapingKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	root := filepath.Join(dir, "blobs")
+	store, err := blob.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{
+		"",
+		"../outside",
+		"attachments/../../outside",
+		"/etc/passwd",
+		`..\outside`,
+		`attachments\..\..\outside`,
+	} {
+		t.Run(key, func(t *testing.T) {
+			if err := store.Put(ctx, key, strings.NewReader("x"), "text/plain"); err == nil {
+				t.Error("Put succeeded")
+			}
+			if _, err := store.Get(ctx, key); err == nil || errors.Is(err, blob.ErrNotFound) {
+				t.Errorf("Get = %v, want the key rejected", err)
+			}
+			if err := store.Delete(ctx, key); err == nil {
+				t.Error("Delete succeeded")
+			}
+		})
+	}
+	if files := listFiles(t, dir); len(files) != 0 {
+		t.Errorf("files written: %v", files)
+	}
+}
+
+func read(t *testing.T, store blob.Store, key string) string {
+	t.Helper()
+	rc, err := store.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// listFiles returns the regular files below dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && d.Type().IsRegular() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
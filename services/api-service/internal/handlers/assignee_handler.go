@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// userIDHeader carries the caller's identity, set by the authenticating proxy
+// in front of the api-service.
+const userIDHeader = "X-User-ID"
+
+type AssigneeHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	timeout    time.Duration
+}
+
+func NewAssigneeHandler(grpcClient proto.ChecklistServiceClient, timeout time.Duration) *AssigneeHandler {
+	return &AssigneeHandler{
+		grpcClient: grpcClient,
+		timeout:    timeout,
+	}
+}
+
+// MyTasks handles GET /v1/me/tasks: the open tasks assigned to the caller
+// across all checklists, soonest due first and undated tasks last.
+func (h *AssigneeHandler) MyTasks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := callerID(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.ListTasks(ctx, &proto.ListTasksRequest{
+		AssigneeId: userID,
+		OpenOnly:   true,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	tasks := grpcRes.Tasks
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i].DueAt, tasks[j].DueAt
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.AsTime().Before(b.AsTime())
+	})
+
+	res := make([]*api.TaskResponse, 0, len(tasks))
+	for _, task := range tasks {
+		res = append(res, toTaskResponse(task))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// callerID returns the caller's user ID. If the request carries none, it
+// responds with 401 and returns false.
+func callerID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := strings.TrimSpace(r.Header.Get(userIDHeader))
+	if userID == "" {
+		http.Error(w, userIDHeader+" header is required", http.StatusUnauthorized)
+		return "", false
+	}
+	return userID, true
+}
+
+func toChecklistMemberResponse(member *proto.ChecklistMember) *api.ChecklistMemberResponse {
+	return &api.ChecklistMemberResponse{
+		ChecklistID: member.ChecklistId,
+		UserID:      member.UserId,
+		CreatedAt:   member.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,211 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// assigneeClient answers the member and assignee RPCs with the functions
+// it is given.
+type assigneeClient struct {
+	proto.ChecklistServiceClient
+	listTasks    func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error)
+	addMember    func(*proto.ChecklistMemberRequest) (*proto.ChecklistMember, error)
+	removeMember func(*proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error)
+	listMembers  func(*proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error)
+	assignTask   func(*proto.TaskAssigneeRequest) (*proto.Task, error)
+	unassignTask func(*proto.TaskAssigneeRequest) (*proto.Task, error)
+}
+
+func (c *assigneeClient) ListTasks(_ context.Context, req *proto.ListTasksRequest, _ ...grpc.CallOption) (*proto.ListTasksResponse, error) {
+	return c.listTasks(req)
+}
+
+func (c *assigneeClient) AddChecklistMember(_ context.Context, req *proto.ChecklistMemberRequest, _ ...grpc.CallOption) (*proto.ChecklistMember, error) {
+	return c.addMember(req)
+}
+
+func (c *assigneeClient) RemoveChecklistMember(_ context.Context, req *proto.ChecklistMemberRequest, _ ...grpc.CallOption) (*proto.RemoveChecklistMemberResponse, error) {
+	return c.removeMember(req)
+}
+
+func (c *assigneeClient) ListChecklistMembers(_ context.Context, req *proto.ListChecklistMembersRequest, _ ...grpc.CallOption) (*proto.ListChecklistMembersResponse, error) {
+	return c.listMembers(req)
+}
+
+func (c *assigneeClient) AssignTask(_ context.Context, req *proto.TaskAssigneeRequest, _ ...grpc.CallOption) (*proto.Task, error) {
+	return c.assignTask(req)
+}
+
+func (c *assigneeClient) UnassignTask(_ context.Context, req *proto.TaskAssigneeRequest, _ ...grpc.CallOption) (*proto.Task, error) {
+	return c.unassignTask(req)
+}
+
+// myTasks sends GET /v1/me/tasks as userID, or anonymously when it is "".
+func myTasks(t *testing.T, client proto.ChecklistServiceClient, userID string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/tasks", nil)
+	if userID != "" {
+		req.Header.Set("X-User-ID", userID)
+	}
+	rec := httptest.NewRecorder()
+	handlers.NewAssigneeHandler(client, time.Second).MyTasks(rec, req)
+	return rec
+}
+
+func TestMyTasks(t *testing.T) {
+	var got *proto.ListTasksRequest
+	client := &assigneeClient{listTasks: func(req *proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		got = req
+		undated := openTask()
+		undated.Id, undated.Title, undated.DueAt = "6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d", "Announce the release", nil
+		later := openTask()
+		later.Id, later.Title, later.DueAt = "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f", "Update the changelog", timestamppb.New(due.Add(24*time.Hour))
+		return &proto.ListTasksResponse{Tasks: []*proto.Task{undated, later, openTask()}}, nil
+	}}
+
+	rec := myTasks(t, client, " alice ")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if got.AssigneeId != "alice" || !got.OpenOnly {
+		t.Errorf("forwarded request = %v, want alice's open tasks", got)
+	}
+	// Soonest due first, undated last.
+	checkGolden(t, "my_tasks.json", rec.Body.Bytes())
+
+	empty := &assigneeClient{listTasks: func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		return &proto.ListTasksResponse{}, nil
+	}}
+	if rec := myTasks(t, empty, "bob"); rec.Code != http.StatusOK || rec.Body.String() != "[]\n" {
+		t.Errorf("no tasks: status %d, body %q, want 200 []", rec.Code, rec.Body)
+	}
+}
+
+func TestMyTasksErrors(t *testing.T) {
+	unreachable := &assigneeClient{listTasks: func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		t.Error("the handler must not call the db-service")
+		return nil, nil
+	}}
+	for name, userID := range map[string]string{"without X-User-ID": "", "blank X-User-ID": "  "} {
+		t.Run(name, func(t *testing.T) {
+			rec := myTasks(t, unreachable, userID)
+			if rec.Code != http.StatusUnauthorized || rec.Body.String() != "X-User-ID header is required\n" {
+				t.Errorf("status %d, body %q, want 401", rec.Code, rec.Body)
+			}
+		})
+	}
+
+	failing := &assigneeClient{listTasks: func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		return nil, status.Error(codes.Unavailable, "db-service is down")
+	}}
+	if rec := myTasks(t, failing, "alice"); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestChecklistMembers(t *testing.T) {
+	const checklistID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	member := func(userID string) *proto.ChecklistMember {
+		return &proto.ChecklistMember{ChecklistId: checklistID, UserId: userID, CreatedAt: timestamppb.New(created)}
+	}
+	var added, removed *proto.ChecklistMemberRequest
+	client := &assigneeClient{
+		addMember: func(req *proto.ChecklistMemberRequest) (*proto.ChecklistMember, error) {
+			added = req
+			return member(req.UserId), nil
+		},
+		listMembers: func(req *proto.ListChecklistMembersRequest) (*proto.ListChecklistMembersResponse, error) {
+			if req.ChecklistId != checklistID {
+				return nil, status.Error(codes.NotFound, "checklist not found")
+			}
+			return &proto.ListChecklistMembersResponse{Members: []*proto.ChecklistMember{member("alice"), member("bob")}}, nil
+		},
+		removeMember: func(req *proto.ChecklistMemberRequest) (*proto.RemoveChecklistMemberResponse, error) {
+			if req.UserId != "bob" {
+				return nil, status.Error(codes.NotFound, "checklist member not found")
+			}
+			removed = req
+			return &proto.RemoveChecklistMemberResponse{Success: true}, nil
+		},
+	}
+
+	rec := serve(t, client, http.MethodPost, "/v1/checklists/"+checklistID+"/members", `{"user_id":"alice"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if added.ChecklistId != checklistID || added.UserId != "alice" {
+		t.Errorf("forwarded request = %v", added)
+	}
+	checkGolden(t, "add_checklist_member.json", rec.Body.Bytes())
+
+	rec = serve(t, client, http.MethodGet, "/v1/checklists/"+checklistID+"/members", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	checkGolden(t, "list_checklist_members.json", rec.Body.Bytes())
+	if rec := serve(t, client, http.MethodGet, "/v1/checklists/unknown/members", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown checklist: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	rec = serve(t, client, http.MethodDelete, "/v1/checklists/"+checklistID+"/members/bob", "")
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 || removed.ChecklistId != checklistID {
+		t.Errorf("status %d, body %q, forwarded request %v, want 204", rec.Code, rec.Body, removed)
+	}
+	if rec := serve(t, client, http.MethodDelete, "/v1/checklists/"+checklistID+"/members/carol", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("not a member: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTaskAssignees(t *testing.T) {
+	taskID := openTask().Id
+	var assigned, unassigned *proto.TaskAssigneeRequest
+	client := &assigneeClient{
+		assignTask: func(req *proto.TaskAssigneeRequest) (*proto.Task, error) {
+			if req.UserId != "alice" {
+				return nil, status.Error(codes.FailedPrecondition, "user is not a member of the task's checklist")
+			}
+			assigned = req
+			return openTask(), nil
+		},
+		unassignTask: func(req *proto.TaskAssigneeRequest) (*proto.Task, error) {
+			unassigned = req
+			task := openTask()
+			task.AssigneeIds = nil
+			return task, nil
+		},
+	}
+
+	rec := serve(t, client, http.MethodPost, "/v1/tasks/"+taskID+"/assignees", `{"user_id":"alice"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if assigned.TaskId != taskID || assigned.UserId != "alice" {
+		t.Errorf("forwarded request = %v", assigned)
+	}
+	checkGolden(t, "create_task.json", rec.Body.Bytes())
+
+	rec = serve(t, client, http.MethodPost, "/v1/tasks/"+taskID+"/assignees", `{"user_id":"mallory"}`)
+	if rec.Code != http.StatusConflict || rec.Body.String() != "user is not a member of the task's checklist\n" {
+		t.Errorf("not a member: status %d, body %q, want 409", rec.Code, rec.Body)
+	}
+	if rec := serve(t, client, http.MethodPost, "/v1/tasks/"+taskID+"/assignees", `{"user_id":`); rec.Code != http.StatusBadRequest {
+		t.Errorf("malformed JSON: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	rec = serve(t, client, http.MethodDelete, "/v1/tasks/"+taskID+"/assignees/alice", "")
+	if rec.Code != http.StatusOK || unassigned.TaskId != taskID || unassigned.UserId != "alice" {
+		t.Errorf("status %d, forwarded request %v, want 200", rec.Code, unassigned)
+	}
+}
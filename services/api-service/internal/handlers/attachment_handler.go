@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"bufio"
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"checklist-go/services/api-service/internal/blob"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	// checksumHeader optionally carries the hex SHA-256 of the uploaded file.
+	// The upload is rejected if the received content does not match it.
+	checksumHeader = "X-Checksum-SHA256"
+
+	// multipartOverhead leaves room for part headers and boundaries on top of
+	// the file size limit.
+	multipartOverhead = 64 << 10
+
+	// sniffLen is how much of the content http.DetectContentType looks at.
+	sniffLen = 512
+)
+
+type AttachmentHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	store      blob.Store
+	maxSize    int64
+	timeout    time.Duration
+}
+
+func NewAttachmentHandler(grpcClient proto.ChecklistServiceClient, store blob.Store, maxSize int64, timeout time.Duration) *AttachmentHandler {
+	return &AttachmentHandler{
+		grpcClient: grpcClient,
+		store:      store,
+		maxSize:    maxSize,
+		timeout:    timeout,
+	}
+}
+
+// Upload handles POST /v1/tasks/{id}/attachments. The body is
+// multipart/form-data with a "file" part and, optionally, a preceding
+// "sha256" field (or the X-Checksum-SHA256 header). The file is streamed to
+// the blob store while its size and checksum are computed; the content type
+// is sniffed from the content rather than trusted from the client.
+func (h *AttachmentHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	taskID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(taskID); err != nil {
+		http.Error(w, "task ID must be a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+multipartOverhead)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, "Request body must be multipart/form-data", http.StatusBadRequest)
+		return
+	}
+
+	expectedSum := strings.ToLower(r.Header.Get(checksumHeader))
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			http.Error(w, `Multipart field "file" is required`, http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, "Failed to read multipart body", http.StatusBadRequest)
+			return
+		}
+
+		switch part.FormName() {
+		case "sha256":
+			value, err := io.ReadAll(io.LimitReader(part, 128))
+			if err != nil {
+				http.Error(w, "Failed to read multipart body", http.StatusBadRequest)
+				return
+			}
+			expectedSum = strings.ToLower(strings.TrimSpace(string(value)))
+		case "file":
+			h.storeFile(w, r, taskID, part.FileName(), part, expectedSum)
+			return
+		}
+	}
+}
+
+func (h *AttachmentHandler) storeFile(w http.ResponseWriter, r *http.Request, taskID, filename string, content io.Reader, expectedSum string) {
+	if expectedSum != "" {
+		if sum, err := hex.DecodeString(expectedSum); err != nil || len(sum) != sha256.Size {
+			http.Error(w, "sha256 must be a hex-encoded SHA-256 digest", http.StatusBadRequest)
+			return
+		}
+	}
+
+	filename = sanitizeFilename(filename)
+	if filename == "" {
+		http.Error(w, "File name is required", http.StatusBadRequest)
+		return
+	}
+
+	br := bufio.NewReaderSize(content, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		http.Error(w, "Failed to read file", http.StatusBadRequest)
+		return
+	}
+	contentType := http.DetectContentType(head)
+
+	// Read one byte past the limit so that an oversized file is detected
+	// rather than silently truncated.
+	counted := &countingHash{Hash: sha256.New()}
+	body := io.TeeReader(io.LimitReader(br, h.maxSize+1), counted)
+
+	key := path.Join("attachments", taskID, uuid.NewString())
+	if err := h.store.Put(r.Context(), key, body, contentType); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.discard(r.Context(), key)
+			http.Error(w, fmt.Sprintf("File exceeds the %d byte limit", h.maxSize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to store attachment", "task_id", taskID, "error", err)
+		h.discard(r.Context(), key)
+		http.Error(w, "Failed to store file", http.StatusInternalServerError)
+		return
+	}
+
+	if counted.n > h.maxSize {
+		h.discard(r.Context(), key)
+		http.Error(w, fmt.Sprintf("File exceeds the %d byte limit", h.maxSize), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	sum := hex.EncodeToString(counted.Sum(nil))
+	if expectedSum != "" && sum != expectedSum {
+		h.discard(r.Context(), key)
+		http.Error(w, "Checksum mismatch: the file was corrupted in transit", http.StatusUnprocessableEntity)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	uploadedBy := strings.TrimSpace(r.Header.Get(userIDHeader))
+	grpcRes, err := h.grpcClient.CreateAttachment(ctx, &proto.CreateAttachmentRequest{
+		TaskId:      taskID,
+		Filename:    filename,
+		ContentType: contentType,
+		SizeBytes:   counted.n,
+		Sha256:      sum,
+		StorageKey:  key,
+		UploadedBy:  uploadedBy,
+	})
+	if err != nil {
+		h.discard(r.Context(), key)
+		handleGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toAttachmentResponse(grpcRes))
+}
+
+// List handles GET /v1/tasks/{id}/attachments.
+func (h *AttachmentHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.ListAttachments(ctx, &proto.ListAttachmentsRequest{TaskId: chi.URLParam(r, "id")})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	attachments := make([]*api.AttachmentResponse, 0, len(grpcRes.Attachments))
+	for _, a := range grpcRes.Attachments {
+		attachments = append(attachments, toAttachmentResponse(a))
+	}
+
+	writeJSON(w, http.StatusOK, attachments)
+}
+
+// Get handles GET /v1/attachments/{id}.
+func (h *AttachmentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.GetAttachment(ctx, &proto.AttachmentActionRequest{Id: chi.URLParam(r, "id")})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toAttachmentResponse(grpcRes))
+}
+
+// Download handles GET /v1/attachments/{id}/content, streaming the content
+// from the blob store. The content is always served as a download so that
+// uploaded HTML or SVG cannot run in the API's origin.
+func (h *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	attachment, err := h.grpcClient.GetAttachment(ctx, &proto.AttachmentActionRequest{Id: chi.URLParam(r, "id")})
+	cancel()
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	etag := `"` + attachment.Sha256 + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	content, err := h.store.Get(r.Context(), attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			slog.ErrorContext(r.Context(), "Content of attachment is missing from the blob store", "attachment_id", attachment.Id)
+			http.Error(w, "Attachment content not found", http.StatusNotFound)
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to open attachment", "attachment_id", attachment.Id, "error", err)
+		http.Error(w, "Failed to read attachment", http.StatusInternalServerError)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", etag)
+	w.Header().Set(checksumHeader, attachment.Sha256)
+	w.WriteHeader(http.StatusOK)
+
+	// The status line is already sent, so a checksum mismatch can only be
+	// logged; clients can verify the body against X-Checksum-SHA256.
+	counted := &countingHash{Hash: sha256.New()}
+	if _, err := io.Copy(w, io.TeeReader(content, counted)); err != nil {
+		slog.WarnContext(r.Context(), "Failed to stream attachment", "attachment_id", attachment.Id, "error", err)
+		return
+	}
+	if sum := hex.EncodeToString(counted.Sum(nil)); sum != attachment.Sha256 || counted.n != attachment.SizeBytes {
+		slog.ErrorContext(r.Context(), "Attachment is corrupted", "attachment_id", attachment.Id, "stored_sha256", attachment.Sha256, "actual_sha256", sum)
+	}
+}
+
+// Delete handles DELETE /v1/attachments/{id}. The metadata goes first, so a
+// failure to remove the content leaves an orphaned blob rather than a
+// dangling attachment.
+func (h *AttachmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.DeleteAttachment(ctx, &proto.AttachmentActionRequest{Id: chi.URLParam(r, "id")})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	if err := h.store.Delete(ctx, grpcRes.StorageKey); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete content of attachment", "attachment_id", grpcRes.Id, "error", err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// discard removes an object whose upload was rejected. It runs even if the
+// client has gone away.
+func (h *AttachmentHandler) discard(ctx context.Context, key string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.timeout)
+	defer cancel()
+	if err := h.store.Delete(ctx, key); err != nil {
+		slog.ErrorContext(ctx, "Failed to discard rejected upload", "key", key, "error", err)
+	}
+}
+
+// sanitizeFilename keeps only the base name of a client-supplied file name
+// and strips characters that are unsafe in headers.
+func sanitizeFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "." || name == "/" {
+		return ""
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[:255])
+	}
+	return name
+}
+
+// countingHash hashes everything written to it and counts the bytes.
+type countingHash struct {
+	hash.Hash
+	n int64
+}
+
+func (c *countingHash) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return c.Hash.Write(p)
+}
+
+func toAttachmentResponse(a *proto.Attachment) *api.AttachmentResponse {
+	return &api.AttachmentResponse{
+		ID:          a.Id,
+		TaskID:      a.TaskId,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		SizeBytes:   a.SizeBytes,
+		SHA256:      a.Sha256,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt.AsTime().Format(time.RFC3339),
+		URL:         "/v1/attachments/" + a.Id + "/content",
+	}
+}
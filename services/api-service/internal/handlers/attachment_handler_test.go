@@ -0,0 +1,271 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/blob"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const attachmentTaskID = "3f2b8c1e-6a4d-4f0e-9b7a-1c2d3e4f5a6b"
+
+// pngHeader is enough of a PNG file for content sniffing.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// attachmentClient records CreateAttachment calls and answers GetAttachment
+// with attachment.
+type attachmentClient struct {
+	proto.ChecklistServiceClient
+	created    []*proto.CreateAttachmentRequest
+	createErr  error
+	attachment *proto.Attachment
+}
+
+func (c *attachmentClient) CreateAttachment(_ context.Context, req *proto.CreateAttachmentRequest, _ ...grpc.CallOption) (*proto.Attachment, error) {
+	c.created = append(c.created, req)
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	return &proto.Attachment{
+		Id: "7c1d2e3f-4a5b-4c6d-8e7f-8091a2b3c4d5", TaskId: req.TaskId, Filename: req.Filename,
+		ContentType: req.ContentType, SizeBytes: req.SizeBytes, Sha256: req.Sha256, StorageKey: req.StorageKey,
+		UploadedBy: req.UploadedBy, CreatedAt: timestamppb.New(created),
+	}, nil
+}
+
+func (c *attachmentClient) GetAttachment(_ context.Context, req *proto.AttachmentActionRequest, _ ...grpc.CallOption) (*proto.Attachment, error) {
+	if c.attachment == nil || req.Id != c.attachment.Id {
+		return nil, status.Error(codes.NotFound, "attachment not found")
+	}
+	return c.attachment, nil
+}
+
+// attachmentRouter routes the attachment endpoints like the api-service, with
+// the content in a local store under root.
+func attachmentRouter(t *testing.T, client proto.ChecklistServiceClient, root string, maxSize int64) http.Handler {
+	t.Helper()
+	store, err := blob.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := handlers.NewAttachmentHandler(client, store, maxSize, time.Second)
+	r := chi.NewRouter()
+	r.Post("/v1/tasks/{id}/attachments", h.Upload)
+	r.Get("/v1/attachments/{id}/content", h.Download)
+	return r
+}
+
+// uploadRequest builds a multipart upload of content as filename, declared
+// as text/html, with the sha256 field when sum is set.
+func uploadRequest(t *testing.T, filename string, content []byte, sum string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	if sum != "" {
+		if err := mw.WriteField("sha256", sum); err != nil {
+			t.Fatal(err)
+		}
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": filename}))
+	header.Set("Content-Type", "text/html")
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+attachmentTaskID+"/attachments", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-User-ID", "alice")
+	return req
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// storedFiles returns the files below root.
+func storedFiles(t *testing.T, root string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.Type().IsRegular() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestUploadAttachment(t *testing.T) {
+	client := &attachmentClient{}
+	root := t.TempDir()
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0}, 600)...)
+
+	rec := httptest.NewRecorder()
+	attachmentRouter(t, client, root, 1024).ServeHTTP(rec, uploadRequest(t, `..\..\shots/scr"een.png`, content, sha256Hex(content)))
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if len(client.created) != 1 {
+		t.Fatalf("CreateAttachment called %d times, want once", len(client.created))
+	}
+	req := client.created[0]
+	// The declared text/html is ignored in favour of the sniffed type.
+	if req.ContentType != "image/png" || req.Filename != "screen.png" || req.SizeBytes != int64(len(content)) ||
+		req.Sha256 != sha256Hex(content) || req.UploadedBy != "alice" ||
+		!strings.HasPrefix(req.StorageKey, "attachments/"+attachmentTaskID+"/") {
+		t.Errorf("CreateAttachment request = %v", req)
+	}
+	if files := storedFiles(t, root); len(files) != 1 || filepath.ToSlash(files[0]) != filepath.ToSlash(filepath.Join(root, req.StorageKey)) {
+		t.Errorf("stored files = %v, want the one under %s", files, req.StorageKey)
+	}
+
+	var res struct {
+		ContentType string `json:"content_type"`
+		URL         string `json:"url"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil || res.ContentType != "image/png" ||
+		res.URL != "/v1/attachments/7c1d2e3f-4a5b-4c6d-8e7f-8091a2b3c4d5/content" {
+		t.Errorf("response = %s, %v", rec.Body, err)
+	}
+}
+
+func TestUploadAttachmentRejected(t *testing.T) {
+	const maxSize = 16
+	atLimit := bytes.Repeat([]byte("a"), maxSize)
+	overLimit := bytes.Repeat([]byte("a"), maxSize+1)
+
+	tests := []struct {
+		name      string
+		content   []byte
+		sum       string
+		header    string
+		createErr error
+		want      int
+		// created is whether CreateAttachment is reached.
+		created bool
+	}{
+		{name: "at the limit", content: atLimit, want: http.StatusCreated, created: true},
+		{name: "over the limit", content: overLimit, want: http.StatusRequestEntityTooLarge},
+		{name: "checksum mismatch", content: atLimit, sum: sha256Hex(overLimit), want: http.StatusUnprocessableEntity},
+		{name: "checksum mismatch in the header", content: atLimit, header: sha256Hex(overLimit), want: http.StatusUnprocessableEntity},
+		{name: "checksum not hex", content: atLimit, sum: "not-a-digest", want: http.StatusBadRequest},
+		{name: "task not found", content: atLimit, createErr: status.Error(codes.NotFound, "task not found"),
+			want: http.StatusNotFound, created: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &attachmentClient{createErr: tt.createErr}
+			root := t.TempDir()
+			req := uploadRequest(t, "notes.txt", tt.content, tt.sum)
+			if tt.header != "" {
+				req.Header.Set("X-Checksum-SHA256", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			attachmentRouter(t, client, root, maxSize).ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if created := len(client.created) > 0; created != tt.created {
+				t.Errorf("CreateAttachment called: %t, want %t", created, tt.created)
+			}
+			// Only an accepted upload keeps its content.
+			if files := storedFiles(t, root); len(files) != 0 && tt.want != http.StatusCreated {
+				t.Errorf("rejected upload left %v behind", files)
+			}
+		})
+	}
+}
+
+func TestUploadAttachmentNotMultipart(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+attachmentTaskID+"/attachments", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	attachmentRouter(t, &attachmentClient{}, t.TempDir(), 1024).ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	root := t.TempDir()
+	content := []byte("<script>alert(1)</script>")
+	store, err := blob.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(context.Background(), "attachments/task/page", bytes.NewReader(content), "text/html"); err != nil {
+		t.Fatal(err)
+	}
+	client := &attachmentClient{attachment: &proto.Attachment{
+		Id: "7c1d2e3f-4a5b-4c6d-8e7f-8091a2b3c4d5", Filename: "page.html", ContentType: "text/html; charset=utf-8",
+		SizeBytes: int64(len(content)), Sha256: sha256Hex(content), StorageKey: "attachments/task/page",
+	}}
+	router := attachmentRouter(t, client, root, 1024)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/attachments/"+client.attachment.Id+"/content", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	for name, want := range map[string]string{
+		"Content-Disposition":    `attachment; filename=page.html`,
+		"X-Content-Type-Options": "nosniff",
+		"Content-Type":           "text/html; charset=utf-8",
+		"ETag":                   `"` + sha256Hex(content) + `"`,
+		"X-Checksum-Sha256":      sha256Hex(content),
+	} {
+		if got := rec.Header().Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if body, _ := io.ReadAll(rec.Body); !bytes.Equal(body, content) {
+		t.Errorf("body = %q, want %q", body, content)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/attachments/"+client.attachment.Id+"/content", nil)
+	req.Header.Set("If-None-Match", `"`+sha256Hex(content)+`"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional GET = %d with %d bytes, want 304 without a body", rec.Code, rec.Body.Len())
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/attachments/8d2e3f40-5b6c-4d7e-8f80-91a2b3c4d5e6/content", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("missing attachment: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
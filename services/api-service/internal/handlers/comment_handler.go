@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CommentHandler struct {
+	commentClient proto.CommentServiceClient
+	timeout       time.Duration
+}
+
+func NewCommentHandler(commentClient proto.CommentServiceClient, timeout time.Duration) *CommentHandler {
+	return &CommentHandler{
+		commentClient: commentClient,
+		timeout:       timeout,
+	}
+}
+
+// AddComment handles POST /v1/tasks/{id}/comments. The caller becomes the
+// author of the comment.
+func (h *CommentHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	authorID, ok := callerID(w, r)
+	if !ok {
+		return
+	}
+
+	var req api.CommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Failed to decode request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.commentClient.AddComment(ctx, &proto.AddCommentRequest{
+		TaskId:   chi.URLParam(r, "id"),
+		AuthorId: authorID,
+		Body:     req.Body,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCommentResponse(grpcRes))
+}
+
+// ListComments handles GET /v1/tasks/{id}/comments?page_size=&page_token=.
+func (h *CommentHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	grpcReq := &proto.ListCommentsRequest{
+		TaskId:    chi.URLParam(r, "id"),
+		PageToken: r.URL.Query().Get("page_token"),
+	}
+	if v := r.URL.Query().Get("page_size"); v != "" {
+		pageSize, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			http.Error(w, "page_size must be an integer", http.StatusBadRequest)
+			return
+		}
+		grpcReq.PageSize = int32(pageSize)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.commentClient.ListComments(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	res := &api.CommentListResponse{
+		Comments:      make([]*api.CommentResponse, 0, len(grpcRes.Comments)),
+		NextPageToken: grpcRes.NextPageToken,
+	}
+	for _, comment := range grpcRes.Comments {
+		res.Comments = append(res.Comments, toCommentResponse(comment))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// EditComment handles PATCH /v1/tasks/{id}/comments/{commentID}.
+func (h *CommentHandler) EditComment(w http.ResponseWriter, r *http.Request) {
+	authorID, ok := callerID(w, r)
+	if !ok {
+		return
+	}
+
+	var req api.CommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Failed to decode request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.commentClient.EditComment(ctx, &proto.EditCommentRequest{
+		Id:       chi.URLParam(r, "commentID"),
+		TaskId:   chi.URLParam(r, "id"),
+		AuthorId: authorID,
+		Body:     req.Body,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCommentResponse(grpcRes))
+}
+
+// DeleteComment handles DELETE /v1/tasks/{id}/comments/{commentID}.
+func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	authorID, ok := callerID(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	_, err := h.commentClient.DeleteComment(ctx, &proto.DeleteCommentRequest{
+		Id:       chi.URLParam(r, "commentID"),
+		TaskId:   chi.URLParam(r, "id"),
+		AuthorId: authorID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toCommentResponse(comment *proto.Comment) *api.CommentResponse {
+	return &api.CommentResponse{
+		ID:        comment.Id,
+		TaskID:    comment.TaskId,
+		AuthorID:  comment.AuthorId,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt: comment.UpdatedAt.AsTime().Format(time.RFC3339),
+		EditedAt:  formatOptionalTimestamp(comment.EditedAt),
+	}
+}
@@ -0,0 +1,261 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const commentTaskID = "3f2b8c1e-6a4d-4f0e-9b7a-1c2d3e4f5a6b"
+
+// commentClient answers the comment RPCs with the functions it is given.
+type commentClient struct {
+	proto.CommentServiceClient
+	addComment    func(*proto.AddCommentRequest) (*proto.Comment, error)
+	listComments  func(*proto.ListCommentsRequest) (*proto.ListCommentsResponse, error)
+	editComment   func(*proto.EditCommentRequest) (*proto.Comment, error)
+	deleteComment func(*proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error)
+}
+
+func (c *commentClient) AddComment(_ context.Context, req *proto.AddCommentRequest, _ ...grpc.CallOption) (*proto.Comment, error) {
+	return c.addComment(req)
+}
+
+func (c *commentClient) ListComments(_ context.Context, req *proto.ListCommentsRequest, _ ...grpc.CallOption) (*proto.ListCommentsResponse, error) {
+	return c.listComments(req)
+}
+
+func (c *commentClient) EditComment(_ context.Context, req *proto.EditCommentRequest, _ ...grpc.CallOption) (*proto.Comment, error) {
+	return c.editComment(req)
+}
+
+func (c *commentClient) DeleteComment(_ context.Context, req *proto.DeleteCommentRequest, _ ...grpc.CallOption) (*proto.DeleteCommentResponse, error) {
+	return c.deleteComment(req)
+}
+
+// serveComment routes the request to the comment handler like the
+// api-service, sending it as userID unless that is "".
+func serveComment(t *testing.T, client proto.CommentServiceClient, method, target, userID, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	h := handlers.NewCommentHandler(client, time.Second)
+	r := chi.NewRouter()
+	r.Post("/v1/tasks/{id}/comments", h.AddComment)
+	r.Get("/v1/tasks/{id}/comments", h.ListComments)
+	r.Patch("/v1/tasks/{id}/comments/{commentID}", h.EditComment)
+	r.Delete("/v1/tasks/{id}/comments/{commentID}", h.DeleteComment)
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if userID != "" {
+		req.Header.Set("X-User-ID", userID)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func comment(n int) *proto.Comment {
+	return &proto.Comment{
+		Id:        fmt.Sprintf("c0ffee00-0000-4000-8000-%012d", n),
+		TaskId:    commentTaskID,
+		AuthorId:  "alice",
+		Body:      fmt.Sprintf("Comment **%d**", n),
+		CreatedAt: timestamppb.New(created.Add(time.Duration(n) * time.Minute)),
+		UpdatedAt: timestamppb.New(created.Add(time.Duration(n) * time.Minute)),
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	var got *proto.AddCommentRequest
+	client := &commentClient{addComment: func(req *proto.AddCommentRequest) (*proto.Comment, error) {
+		got = req
+		return comment(1), nil
+	}}
+
+	rec := serveComment(t, client, http.MethodPost, "/v1/tasks/"+commentTaskID+"/comments", "alice", `{"body":"Comment **1**"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if got.TaskId != commentTaskID || got.AuthorId != "alice" || got.Body != "Comment **1**" {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "add_comment.json", rec.Body.Bytes())
+}
+
+func TestListCommentsPages(t *testing.T) {
+	// The fake pages through seven comments with the offset as the cursor.
+	var comments []*proto.Comment
+	for n := 1; n <= 7; n++ {
+		comments = append(comments, comment(n))
+	}
+	var requests []*proto.ListCommentsRequest
+	client := &commentClient{listComments: func(req *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+		requests = append(requests, req)
+		offset := 0
+		if req.PageToken != "" {
+			var err error
+			if offset, err = strconv.Atoi(req.PageToken); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid page token")
+			}
+		}
+		end := min(offset+int(req.PageSize), len(comments))
+		res := &proto.ListCommentsResponse{Comments: comments[offset:end]}
+		if end < len(comments) {
+			res.NextPageToken = strconv.Itoa(end)
+		}
+		return res, nil
+	}}
+
+	var ids []string
+	target := "/v1/tasks/" + commentTaskID + "/comments?page_size=3"
+	for page := 1; ; page++ {
+		rec := serveComment(t, client, http.MethodGet, target, "", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("page %d: status = %d, want %d: %s", page, rec.Code, http.StatusOK, rec.Body)
+		}
+		if page == 1 {
+			checkGolden(t, "list_comments.json", rec.Body.Bytes())
+		}
+		var res api.CommentListResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range res.Comments {
+			ids = append(ids, c.ID)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		target = "/v1/tasks/" + commentTaskID + "/comments?page_size=3&page_token=" + res.NextPageToken
+	}
+
+	if len(ids) != len(comments) {
+		t.Fatalf("got %d comments over %d pages, want %d", len(ids), len(requests), len(comments))
+	}
+	for i, c := range comments {
+		if ids[i] != c.Id {
+			t.Errorf("comment %d = %s, want %s", i, ids[i], c.Id)
+		}
+	}
+	wantTokens := []string{"", "3", "6"}
+	for i, req := range requests {
+		if req.TaskId != commentTaskID || req.PageSize != 3 || req.PageToken != wantTokens[i] {
+			t.Errorf("request %d = %v, want page token %q", i, req, wantTokens[i])
+		}
+	}
+
+	// The last page says so by leaving out next_page_token.
+	rec := serveComment(t, client, http.MethodGet, "/v1/tasks/"+commentTaskID+"/comments?page_size=10", "", "")
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), "next_page_token") {
+		t.Errorf("single page: status %d, body %s", rec.Code, rec.Body)
+	}
+
+	rec = serveComment(t, client, http.MethodGet, "/v1/tasks/"+commentTaskID+"/comments?page_token=bogus", "", "")
+	if rec.Code != http.StatusBadRequest || rec.Body.String() != "invalid page token\n" {
+		t.Errorf("bad page token: status %d, body %q, want 400", rec.Code, rec.Body)
+	}
+}
+
+func TestEditAndDeleteComment(t *testing.T) {
+	id := comment(1).Id
+	var edited *proto.EditCommentRequest
+	var deleted *proto.DeleteCommentRequest
+	client := &commentClient{
+		editComment: func(req *proto.EditCommentRequest) (*proto.Comment, error) {
+			if req.AuthorId != "alice" {
+				return nil, status.Error(codes.PermissionDenied, "only the author can edit a comment")
+			}
+			edited = req
+			c := comment(1)
+			c.Body = req.Body
+			c.UpdatedAt, c.EditedAt = timestamppb.New(updated), timestamppb.New(updated)
+			return c, nil
+		},
+		deleteComment: func(req *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error) {
+			if req.Id != id {
+				return nil, status.Error(codes.NotFound, "comment not found")
+			}
+			deleted = req
+			return &proto.DeleteCommentResponse{Success: true}, nil
+		},
+	}
+	target := "/v1/tasks/" + commentTaskID + "/comments/" + id
+
+	rec := serveComment(t, client, http.MethodPatch, target, "alice", `{"body":"Edited"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if edited.Id != id || edited.TaskId != commentTaskID || edited.Body != "Edited" {
+		t.Errorf("forwarded request = %v", edited)
+	}
+	checkGolden(t, "edit_comment.json", rec.Body.Bytes())
+
+	if rec := serveComment(t, client, http.MethodPatch, target, "mallory", `{"body":"Edited"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("edit by another user: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	rec = serveComment(t, client, http.MethodDelete, target, "alice", "")
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 || deleted.AuthorId != "alice" || deleted.TaskId != commentTaskID {
+		t.Errorf("status %d, body %q, forwarded request %v, want 204", rec.Code, rec.Body, deleted)
+	}
+	if rec := serveComment(t, client, http.MethodDelete, "/v1/tasks/"+commentTaskID+"/comments/unknown", "alice", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown comment: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestCommentErrors(t *testing.T) {
+	fail := func() error {
+		t.Error("the handler must not call the db-service")
+		return nil
+	}
+	unreachable := &commentClient{
+		addComment:    func(*proto.AddCommentRequest) (*proto.Comment, error) { return nil, fail() },
+		listComments:  func(*proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) { return nil, fail() },
+		editComment:   func(*proto.EditCommentRequest) (*proto.Comment, error) { return nil, fail() },
+		deleteComment: func(*proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error) { return nil, fail() },
+	}
+	comments := "/v1/tasks/" + commentTaskID + "/comments"
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		userID     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"add anonymously", http.MethodPost, comments, "", `{"body":"x"}`, http.StatusUnauthorized, "X-User-ID header is required"},
+		{"edit anonymously", http.MethodPatch, comments + "/x", "", `{"body":"x"}`, http.StatusUnauthorized, "X-User-ID header is required"},
+		{"delete anonymously", http.MethodDelete, comments + "/x", "", "", http.StatusUnauthorized, "X-User-ID header is required"},
+		{"add malformed JSON", http.MethodPost, comments, "alice", `{"body":`, http.StatusBadRequest, "Failed to decode request body"},
+		{"edit malformed JSON", http.MethodPatch, comments + "/x", "alice", `body=x`, http.StatusBadRequest, "Failed to decode request body"},
+		{"list with a bad page_size", http.MethodGet, comments + "?page_size=ten", "", "", http.StatusBadRequest, "page_size must be an integer"},
+		{"list with an overflowing page_size", http.MethodGet, comments + "?page_size=4294967296", "", "", http.StatusBadRequest, "page_size must be an integer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveComment(t, unreachable, tt.method, tt.target, tt.userID, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Body.String(); got != tt.wantBody+"\n" {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
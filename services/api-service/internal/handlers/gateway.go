@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errDecode is the error of a request body that is not a JSON object of the
+// request's fields.
+var errDecode = errors.New("Failed to decode request body")
+
+// gatewayRoute is how the gateway answers an RPC: the status of a success
+// and the api model the response is rendered as. A nil render answers
+// without a body.
+type gatewayRoute struct {
+	status int
+	render func(gproto.Message) any
+}
+
+// render adapts a converter of a response message to gatewayRoute.render.
+func render[M gproto.Message, R any](convert func(M) R) func(gproto.Message) any {
+	return func(m gproto.Message) any { return convert(m.(M)) }
+}
+
+// each converts every item with convert. The result is empty rather than
+// nil, so that an empty list is rendered as [].
+func each[T, R any](items []T, convert func(T) R) []R {
+	res := make([]R, 0, len(items))
+	for _, item := range items {
+		res = append(res, convert(item))
+	}
+	return res
+}
+
+// gatewayRoutes overrides the route derived from the google.api.http
+// annotation of an RPC (see deriveRoute). The RPCs here are rendered as their
+// models in package api, so the JSON is the same as that of the hand-written
+// handlers they replaced, or answer with a status other than that of their
+// HTTP method.
+var gatewayRoutes = map[string]gatewayRoute{
+	proto.ChecklistService_CreateTask_FullMethodName: {http.StatusCreated, render(toTaskResponse)},
+	proto.ChecklistService_ListTasks_FullMethodName: {http.StatusOK, render(func(res *proto.ListTasksResponse) []*api.TaskResponse {
+		// GET /list has always answered null rather than [] when there are no tasks.
+		var tasks []*api.TaskResponse
+		for _, task := range res.Tasks {
+			tasks = append(tasks, toTaskResponse(task))
+		}
+		return tasks
+	})},
+	proto.ChecklistService_MarkTaskDone_FullMethodName: {http.StatusNoContent, nil},
+
+	proto.ChecklistService_CreateChecklist_FullMethodName: {http.StatusCreated, render(toChecklistResponse)},
+	proto.ChecklistService_ListChecklists_FullMethodName: {http.StatusOK, render(func(res *proto.ListChecklistsResponse) []*api.ChecklistResponse {
+		return each(res.Checklists, toChecklistResponse)
+	})},
+	proto.ChecklistService_GetChecklist_FullMethodName: {http.StatusOK, render(toChecklistResponse)},
+
+	proto.ChecklistService_SetRecurrence_FullMethodName: {http.StatusOK, render(toRecurrenceResponse)},
+	proto.ChecklistService_ListRecurrences_FullMethodName: {http.StatusOK, render(func(res *proto.ListRecurrencesResponse) []*api.RecurrenceResponse {
+		return each(res.Recurrences, toRecurrenceResponse)
+	})},
+
+	proto.ChecklistService_CreateTemplateFromChecklist_FullMethodName: {http.StatusCreated, render(toTemplateResponse)},
+	proto.ChecklistService_ListTemplates_FullMethodName: {http.StatusOK, render(func(res *proto.ListTemplatesResponse) []*api.TemplateResponse {
+		return each(res.Templates, toTemplateResponse)
+	})},
+	proto.ChecklistService_GetTemplate_FullMethodName:         {http.StatusOK, render(toTemplateResponse)},
+	proto.ChecklistService_InstantiateTemplate_FullMethodName: {http.StatusCreated, render(toChecklistResponse)},
+
+	proto.ChecklistService_AddDependency_FullMethodName:    {http.StatusOK, render(toTaskResponse)},
+	proto.ChecklistService_RemoveDependency_FullMethodName: {http.StatusOK, render(toTaskResponse)},
+
+	proto.ChecklistService_SetWorkflow_FullMethodName:    {http.StatusOK, render(toWorkflowResponse)},
+	proto.ChecklistService_GetWorkflow_FullMethodName:    {http.StatusOK, render(toWorkflowResponse)},
+	proto.ChecklistService_TransitionTask_FullMethodName: {http.StatusOK, render(toTaskResponse)},
+	proto.ChecklistService_GetBoard_FullMethodName:       {http.StatusOK, render(toBoardResponse)},
+
+	proto.ChecklistService_AddChecklistMember_FullMethodName: {http.StatusCreated, render(toChecklistMemberResponse)},
+	proto.ChecklistService_ListChecklistMembers_FullMethodName: {http.StatusOK, render(func(res *proto.ListChecklistMembersResponse) []*api.ChecklistMemberResponse {
+		return each(res.Members, toChecklistMemberResponse)
+	})},
+	proto.ChecklistService_AssignTask_FullMethodName:   {http.StatusOK, render(toTaskResponse)},
+	proto.ChecklistService_UnassignTask_FullMethodName: {http.StatusOK, render(toTaskResponse)},
+
+	proto.ChecklistService_CreateSavedView_FullMethodName: {http.StatusCreated, render(toSavedViewResponse)},
+	proto.ChecklistService_ListSavedViews_FullMethodName: {http.StatusOK, render(func(res *proto.ListSavedViewsResponse) []*api.SavedViewResponse {
+		return each(res.Views, toSavedViewResponse)
+	})},
+	proto.ChecklistService_GetSavedView_FullMethodName:    {http.StatusOK, render(toSavedViewResponse)},
+	proto.ChecklistService_UpdateSavedView_FullMethodName: {http.StatusOK, render(toSavedViewResponse)},
+}
+
+// routes are the routes of every RPC the gateway serves, by full method name.
+var routes = func() map[string]gatewayRoute {
+	routes := make(map[string]gatewayRoute)
+	methods := proto.File_proto_checklist_proto.Services().ByName("ChecklistService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		name := "/" + string(method.Parent().FullName()) + "/" + string(method.Name())
+		if route, ok := gatewayRoutes[name]; ok {
+			routes[name] = route
+		} else if route, ok := deriveRoute(method); ok {
+			routes[name] = route
+		}
+	}
+	return routes
+}()
+
+// deriveRoute answers an RPC by the HTTP method of its annotation: POST with
+// 201, DELETE with 204 and no body, and the others with 200, rendering the
+// response message as JSON. It reports false for an RPC without annotation.
+func deriveRoute(method protoreflect.MethodDescriptor) (gatewayRoute, bool) {
+	rule, ok := gproto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return gatewayRoute{}, false
+	}
+	asMessage := func(m gproto.Message) any { return m }
+	switch rule.GetPattern().(type) {
+	case *annotations.HttpRule_Post:
+		return gatewayRoute{http.StatusCreated, asMessage}, true
+	case *annotations.HttpRule_Delete:
+		return gatewayRoute{http.StatusNoContent, nil}, true
+	}
+	return gatewayRoute{http.StatusOK, asMessage}, true
+}
+
+// NewGateway returns a handler that transcodes HTTP requests into RPCs by
+// the google.api.http annotations in checklist.proto. Request bodies are
+// read as the request models of package api, responses are rendered as its
+// response models with the status codes of routes, and errors are
+// plain text like those of the other handlers.
+func NewGateway(grpcClient proto.ChecklistServiceClient, timeout time.Duration) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &apiMarshaler{}),
+		runtime.WithForwardResponseOption(func(ctx context.Context, w http.ResponseWriter, _ gproto.Message) error {
+			nameRoute(ctx)
+			route, ok := lookupRoute(ctx)
+			if !ok {
+				return nil
+			}
+			if route.render == nil {
+				w.Header().Del("Content-Type")
+			}
+			w.WriteHeader(route.status)
+			return nil
+		}),
+		runtime.WithForwardResponseRewriter(func(ctx context.Context, res gproto.Message) (any, error) {
+			route, ok := lookupRoute(ctx)
+			if !ok {
+				return res, nil
+			}
+			if route.render == nil {
+				return nil, nil
+			}
+			return route.render(res), nil
+		}),
+		runtime.WithErrorHandler(func(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+			nameRoute(ctx)
+			handleGRPCError(w, err)
+		}),
+		runtime.WithRoutingErrorHandler(func(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, code int) {
+			if code == http.StatusNotFound {
+				http.NotFound(w, r)
+				return
+			}
+			http.Error(w, http.StatusText(code), code)
+		}),
+	)
+
+	if err := proto.RegisterChecklistServiceHandlerClient(context.Background(), mux, grpcClient); err != nil {
+		return nil, fmt.Errorf("failed to register ChecklistService routes: %w", err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		mux.ServeHTTP(w, r.WithContext(ctx))
+	}), nil
+}
+
+// nameRoute records the path template of the RPC being served as the chi
+// route pattern, so that the metrics, logs and spans of the request are
+// named after it rather than the route the gateway is mounted on.
+func nameRoute(ctx context.Context) {
+	pattern, ok := runtime.HTTPPathPattern(ctx)
+	if rctx := chi.RouteContext(ctx); ok && rctx != nil {
+		rctx.RoutePatterns = []string{pattern}
+	}
+}
+
+func lookupRoute(ctx context.Context) (gatewayRoute, bool) {
+	method, ok := runtime.RPCMethod(ctx)
+	if !ok {
+		return gatewayRoute{}, false
+	}
+	route, ok := routes[method]
+	return route, ok
+}
+
+// apiMarshaler reads and writes JSON the way the hand-written handlers do
+// with the models of package api. Request fields are named by their
+// json_name, unknown fields are ignored, timestamps are RFC 3339 strings
+// and "" leaves a timestamp unset. Responses rendered as api models are
+// encoded with encoding/json, response messages with protojson; a nil
+// response has no body.
+type apiMarshaler struct{}
+
+func (m *apiMarshaler) ContentType(any) string {
+	return "application/json"
+}
+
+func (m *apiMarshaler) Marshal(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if msg, ok := v.(gproto.Message); ok {
+		return protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	}
+	var buf bytes.Buffer
+	// Like the json.Encoder of writeJSON, ending with a newline.
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *apiMarshaler) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(gproto.Message)
+	if !ok {
+		return json.Unmarshal(data, v)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		// A body is optional only where the handler read it as optional.
+		if _, ok := msg.(*proto.InstantiateTemplateRequest); ok {
+			return io.EOF
+		}
+		return errDecode
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return errDecode
+	}
+	if fields == nil {
+		// The handlers read null as an empty object.
+		fields = map[string]json.RawMessage{}
+	}
+	if err := checkTimestamps(msg.ProtoReflect().Descriptor(), fields); err != nil {
+		return err
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return errDecode
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, msg); err != nil {
+		return errDecode
+	}
+	return validateRequest(msg)
+}
+
+func (m *apiMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return m.Unmarshal(data, v)
+	})
+}
+
+func (m *apiMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v any) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+}
+
+// checkTimestamps drops the timestamp fields set to "" and reports those
+// that are not RFC 3339 timestamps by name, as the handlers did.
+func checkTimestamps(desc protoreflect.MessageDescriptor, fields map[string]json.RawMessage) error {
+	timestamp := (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+	for i := 0; i < desc.Fields().Len(); i++ {
+		field := desc.Fields().Get(i)
+		if field.Message() == nil || field.Message().FullName() != timestamp || field.IsList() {
+			continue
+		}
+		for _, name := range []string{field.JSONName(), string(field.Name())} {
+			raw, ok := fields[name]
+			if !ok {
+				continue
+			}
+			var value string
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return errDecode
+			}
+			if value == "" {
+				delete(fields, name)
+				continue
+			}
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				return fmt.Errorf("%s must be an RFC 3339 timestamp", field.JSONName())
+			}
+		}
+	}
+	return nil
+}
+
+// validateRequest checks the fields the handlers required before calling
+// the db-service.
+func validateRequest(msg gproto.Message) error {
+	switch req := msg.(type) {
+	case *proto.CreateTaskRequest:
+		if req.Title == "" {
+			return errors.New("Title is required")
+		}
+	case *proto.CreateChecklistRequest:
+		if req.Title == "" {
+			return errors.New("Title is required")
+		}
+	case *proto.SetRecurrenceRequest:
+		if req.Rrule == "" {
+			return errors.New("rrule is required")
+		}
+	case *proto.CreateTemplateFromChecklistRequest:
+		if req.ChecklistId == "" || req.Name == "" {
+			return errors.New("checklist_id and name are required")
+		}
+	case *proto.TaskDependencyRequest:
+		if req.DependsOnId == "" {
+			return errors.New("depends_on_id is required")
+		}
+	case *proto.TransitionTaskRequest:
+		if req.Status == "" {
+			return errors.New("status is required")
+		}
+	}
+	return nil
+}
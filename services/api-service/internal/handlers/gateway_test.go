@@ -0,0 +1,310 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// fakeClient answers the task RPCs with the functions it is given; any
+// other RPC panics on the nil embedded interface.
+type fakeClient struct {
+	proto.ChecklistServiceClient
+	createTask   func(*proto.CreateTaskRequest) (*proto.Task, error)
+	listTasks    func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error)
+	deleteTask   func(*proto.TaskActionRequest) (*proto.DeleteTaskResponse, error)
+	markTaskDone func(*proto.TaskActionRequest) (*proto.Task, error)
+}
+
+func (c *fakeClient) CreateTask(_ context.Context, req *proto.CreateTaskRequest, _ ...grpc.CallOption) (*proto.Task, error) {
+	return c.createTask(req)
+}
+
+func (c *fakeClient) ListTasks(_ context.Context, req *proto.ListTasksRequest, _ ...grpc.CallOption) (*proto.ListTasksResponse, error) {
+	return c.listTasks(req)
+}
+
+func (c *fakeClient) DeleteTask(_ context.Context, req *proto.TaskActionRequest, _ ...grpc.CallOption) (*proto.DeleteTaskResponse, error) {
+	return c.deleteTask(req)
+}
+
+func (c *fakeClient) MarkTaskDone(_ context.Context, req *proto.TaskActionRequest, _ ...grpc.CallOption) (*proto.Task, error) {
+	return c.markTaskDone(req)
+}
+
+var (
+	created   = time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	updated   = time.Date(2025, 3, 2, 18, 0, 0, 0, time.UTC)
+	due       = time.Date(2025, 3, 7, 17, 0, 0, 0, time.UTC)
+	completed = time.Date(2025, 3, 5, 12, 15, 0, 0, time.UTC)
+)
+
+// openTask and doneTask cover every field of api.TaskResponse, set and unset.
+func openTask() *proto.Task {
+	return &proto.Task{
+		Id:           "3f2b8c1e-6a4d-4f0e-9b7a-1c2d3e4f5a6b",
+		Title:        "Write release notes",
+		Description:  "Summarize the changes since 1.4",
+		CreatedAt:    timestamppb.New(created),
+		UpdatedAt:    timestamppb.New(updated),
+		ChecklistId:  "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+		DueAt:        timestamppb.New(due),
+		Tags:         []string{"docs", "release"},
+		Blocked:      true,
+		DependsOnIds: []string{"0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"},
+		Status:       "todo",
+		AssigneeIds:  []string{"alice"},
+	}
+}
+
+func doneTask() *proto.Task {
+	return &proto.Task{
+		Id:           "0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e",
+		Title:        "Tag the release",
+		Done:         true,
+		CreatedAt:    timestamppb.New(created),
+		UpdatedAt:    timestamppb.New(completed),
+		CompletedAt:  timestamppb.New(completed),
+		RecurrenceId: "5d6e7f80-91a2-4b3c-8d4e-5f6071829304",
+		Status:       "done",
+	}
+}
+
+// checkGolden compares body with testdata/name, or rewrites the file when
+// the tests run with -update.
+func checkGolden(t *testing.T, name string, body []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, body, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(body, want) {
+		t.Errorf("response body differs from %s:\ngot:  %s\nwant: %s", path, body, want)
+	}
+}
+
+// serve sends the request through the gateway to client.
+func serve(t *testing.T, client proto.ChecklistServiceClient, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	gateway, err := handlers.NewGateway(client, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+	return rec
+}
+
+func TestCreateTask(t *testing.T) {
+	var got *proto.CreateTaskRequest
+	client := &fakeClient{createTask: func(req *proto.CreateTaskRequest) (*proto.Task, error) {
+		got = req
+		return openTask(), nil
+	}}
+
+	rec := serve(t, client, http.MethodPost, "/create", `{"title":"Write release notes","description":"Summarize the changes since 1.4",
+		"checklist_id":"9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d","due_at":"2025-03-07T19:00:00+02:00","tags":["docs","release"]}`)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	if got.Title != "Write release notes" || got.ChecklistId != "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d" ||
+		!got.DueAt.AsTime().Equal(due) || len(got.Tags) != 2 {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "create_task.json", rec.Body.Bytes())
+}
+
+// TestCreateTaskLenient checks that, like the api models, the gateway reads
+// "" as an unset timestamp and ignores unknown fields.
+func TestCreateTaskLenient(t *testing.T) {
+	var got *proto.CreateTaskRequest
+	client := &fakeClient{createTask: func(req *proto.CreateTaskRequest) (*proto.Task, error) {
+		got = req
+		return doneTask(), nil
+	}}
+
+	rec := serve(t, client, http.MethodPost, "/create", `{"title":"x","due_at":"","priority":1}`)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if got.Title != "x" || got.DueAt != nil {
+		t.Errorf("forwarded request = %v", got)
+	}
+}
+
+func TestListTasks(t *testing.T) {
+	var got *proto.ListTasksRequest
+	client := &fakeClient{listTasks: func(req *proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		got = req
+		return &proto.ListTasksResponse{Tasks: []*proto.Task{openTask(), doneTask()}}, nil
+	}}
+
+	rec := serve(t, client, http.MethodGet, "/list?checklist_id=c1&assignee_id=alice&filter=tag%3Adocs", "")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if got.ChecklistId != "c1" || got.AssigneeId != "alice" || got.Filter != "tag:docs" {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "list_tasks.json", rec.Body.Bytes())
+}
+
+func TestListTasksEmpty(t *testing.T) {
+	client := &fakeClient{listTasks: func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+		return &proto.ListTasksResponse{}, nil
+	}}
+
+	rec := serve(t, client, http.MethodGet, "/list", "")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	checkGolden(t, "list_tasks_empty.json", rec.Body.Bytes())
+}
+
+func TestDeleteAndDone(t *testing.T) {
+	var deleted, done string
+	client := &fakeClient{
+		deleteTask: func(req *proto.TaskActionRequest) (*proto.DeleteTaskResponse, error) {
+			deleted = req.Id
+			return &proto.DeleteTaskResponse{Success: true}, nil
+		},
+		markTaskDone: func(req *proto.TaskActionRequest) (*proto.Task, error) {
+			done = req.Id
+			return doneTask(), nil
+		},
+	}
+
+	rec := serve(t, client, http.MethodDelete, "/delete", `{"id":"a"}`)
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 || deleted != "a" {
+		t.Errorf("DELETE /delete: status %d, body %q, forwarded ID %q", rec.Code, rec.Body, deleted)
+	}
+	rec = serve(t, client, http.MethodPut, "/done", `{"id":"b"}`)
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 || done != "b" {
+		t.Errorf("PUT /done: status %d, body %q, forwarded ID %q", rec.Code, rec.Body, done)
+	}
+}
+
+// errorCase sends body, or the endpoint's valid body when nil, to the
+// endpoint and expects a plain text error.
+type errorCase struct {
+	name       string
+	endpoint   string
+	body       *string
+	client     *fakeClient
+	wantStatus int
+	wantBody   string
+}
+
+func TestTaskErrors(t *testing.T) {
+	// rpcErr makes every RPC of the fake fail with err.
+	rpcErr := func(err error) *fakeClient {
+		return &fakeClient{
+			createTask:   func(*proto.CreateTaskRequest) (*proto.Task, error) { return nil, err },
+			listTasks:    func(*proto.ListTasksRequest) (*proto.ListTasksResponse, error) { return nil, err },
+			deleteTask:   func(*proto.TaskActionRequest) (*proto.DeleteTaskResponse, error) { return nil, err },
+			markTaskDone: func(*proto.TaskActionRequest) (*proto.Task, error) { return nil, err },
+		}
+	}
+	unreachable := rpcErr(errors.New("the handler must not call the db-service"))
+
+	endpoints := map[string]struct {
+		method string
+		target string
+		body   string
+	}{
+		"create": {http.MethodPost, "/create", `{"title":"x"}`},
+		"list":   {http.MethodGet, "/list", ""},
+		"delete": {http.MethodDelete, "/delete", `{"id":"x"}`},
+		"done":   {http.MethodPut, "/done", `{"id":"x"}`},
+	}
+
+	tests := []errorCase{
+		{"create malformed JSON", "create", ptr(`{"title":`), unreachable, http.StatusBadRequest, "Failed to decode request body"},
+		{"create without title", "create", ptr(`{"description":"x"}`), unreachable, http.StatusBadRequest, "Title is required"},
+		{"create with bad due_at", "create", ptr(`{"title":"x","due_at":"tomorrow"}`), unreachable, http.StatusBadRequest, "due_at must be an RFC 3339 timestamp"},
+		{"delete malformed JSON", "delete", ptr(`id=x`), unreachable, http.StatusBadRequest, "Failed to decode request body"},
+		{"done malformed JSON", "done", ptr(``), unreachable, http.StatusBadRequest, "Failed to decode request body"},
+		{"create with an array", "create", ptr(`[{"title":"x"}]`), unreachable, http.StatusBadRequest, "Failed to decode request body"},
+		{"create with a mistyped field", "create", ptr(`{"title":1}`), unreachable, http.StatusBadRequest, "Failed to decode request body"},
+	}
+	for _, ep := range []string{"create", "list", "delete", "done"} {
+		for _, c := range []struct {
+			code   codes.Code
+			status int
+		}{
+			{codes.InvalidArgument, http.StatusBadRequest},
+			{codes.NotFound, http.StatusNotFound},
+			{codes.FailedPrecondition, http.StatusConflict},
+			{codes.Aborted, http.StatusConflict},
+			{codes.PermissionDenied, http.StatusForbidden},
+			{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+			{codes.Unavailable, http.StatusServiceUnavailable},
+			{codes.Internal, http.StatusInternalServerError},
+		} {
+			tests = append(tests, errorCase{ep + " " + c.code.String(), ep, nil, rpcErr(status.Error(c.code, "from db-service")), c.status, "from db-service"})
+		}
+		tests = append(tests, errorCase{ep + " non-gRPC error", ep, nil, rpcErr(errors.New("connection reset")), http.StatusInternalServerError, "Internal server error"})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep := endpoints[tt.endpoint]
+			body := ep.body
+			if tt.body != nil {
+				body = *tt.body
+			}
+			rec := serve(t, tt.client, ep.method, ep.target, body)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := strings.TrimSuffix(rec.Body.String(), "\n"); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+				t.Errorf("Content-Type = %q, want text/plain", ct)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
+
+func TestUnknownRoute(t *testing.T) {
+	rec := serve(t, &fakeClient{}, http.MethodGet, "/v1/nowhere", "")
+	if rec.Code != http.StatusNotFound || strings.TrimSpace(rec.Body.String()) != "404 page not found" {
+		t.Errorf("status %d, body %q, want 404 page not found", rec.Code, rec.Body)
+	}
+}
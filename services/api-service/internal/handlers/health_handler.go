@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// downstreamService is the db-service service whose health decides readiness.
+const downstreamService = "proto.ChecklistService"
+
+type HealthHandler struct {
+	healthClient healthpb.HealthClient
+	draining     atomic.Bool
+}
+
+func NewHealthHandler(healthClient healthpb.HealthClient) *HealthHandler {
+	return &HealthHandler{
+		healthClient: healthClient,
+	}
+}
+
+// StartDraining makes /readyz fail from now on, so that load balancers stop
+// routing new requests while in-flight ones finish.
+func (h *HealthHandler) StartDraining() {
+	h.draining.Store(true)
+}
+
+// Healthz handles GET /healthz. It only reports that the process is alive; a
+// db-service outage must not get the api-service restarted. The downstream
+// status is included for information.
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &api.HealthResponse{
+		Status: "ok",
+		Checks: map[string]string{"db_service": h.downstreamStatus(r.Context())},
+	})
+}
+
+// Readyz handles GET /readyz: 200 while the db-service reports SERVING and the
+// api-service is not shutting down, 503 otherwise.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	if h.draining.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, &api.HealthResponse{Status: "draining"})
+		return
+	}
+
+	downstream := h.downstreamStatus(r.Context())
+	res := &api.HealthResponse{
+		Status: "ok",
+		Checks: map[string]string{"db_service": downstream},
+	}
+	if downstream != healthpb.HealthCheckResponse_SERVING.String() {
+		res.Status = "unavailable"
+		writeJSON(w, http.StatusServiceUnavailable, res)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// downstreamStatus asks the db-service health service for its status, or
+// describes why it could not.
+func (h *HealthHandler) downstreamStatus(ctx context.Context) string {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	res, err := h.healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: downstreamService})
+	if status.Code(err) == codes.NotFound {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN.String()
+	}
+	if err != nil {
+		return "UNREACHABLE: " + status.Code(err).String()
+	}
+	return res.Status.String()
+}
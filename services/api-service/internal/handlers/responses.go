@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toTaskResponse(task *proto.Task) *api.TaskResponse {
+	return &api.TaskResponse{
+		ID:           task.Id,
+		Title:        task.Title,
+		Description:  task.Description,
+		Done:         task.Done,
+		CreatedAt:    task.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:    task.UpdatedAt.AsTime().Format(time.RFC3339),
+		ChecklistID:  task.ChecklistId,
+		DueAt:        formatOptionalTimestamp(task.DueAt),
+		CompletedAt:  formatOptionalTimestamp(task.CompletedAt),
+		RecurrenceID: task.RecurrenceId,
+		Tags:         task.Tags,
+		Blocked:      task.Blocked,
+		DependsOn:    task.DependsOnIds,
+		Status:       task.Status,
+		AssigneeIDs:  task.AssigneeIds,
+	}
+}
+
+func toChecklistResponse(checklist *proto.Checklist) *api.ChecklistResponse {
+	return &api.ChecklistResponse{
+		ID:           checklist.Id,
+		Title:        checklist.Title,
+		Description:  checklist.Description,
+		CreatedAt:    checklist.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:    checklist.UpdatedAt.AsTime().Format(time.RFC3339),
+		RecurrenceID: checklist.RecurrenceId,
+		OccurrenceAt: formatOptionalTimestamp(checklist.OccurrenceAt),
+	}
+}
+
+func toRecurrenceResponse(rec *proto.Recurrence) *api.RecurrenceResponse {
+	return &api.RecurrenceResponse{
+		ID:               rec.Id,
+		TaskID:           rec.TaskId,
+		ChecklistID:      rec.ChecklistId,
+		RRule:            rec.Rrule,
+		DTStart:          rec.Dtstart.AsTime().Format(time.RFC3339),
+		Timezone:         rec.Timezone,
+		NextOccurrenceAt: formatOptionalTimestamp(rec.NextOccurrenceAt),
+		CreatedAt:        rec.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:        rec.UpdatedAt.AsTime().Format(time.RFC3339),
+		LastError:        rec.LastError,
+	}
+}
+
+func toTemplateResponse(template *proto.Template) *api.TemplateResponse {
+	tasks := make([]*api.TaskBlueprintResponse, 0, len(template.Tasks))
+	for _, blueprint := range template.Tasks {
+		task := &api.TaskBlueprintResponse{
+			Title:       blueprint.Title,
+			Description: blueprint.Description,
+			Tags:        blueprint.Tags,
+		}
+		if blueprint.DueOffset != nil {
+			seconds := int64(blueprint.DueOffset.AsDuration() / time.Second)
+			task.DueOffset = &seconds
+		}
+		tasks = append(tasks, task)
+	}
+
+	return &api.TemplateResponse{
+		ID:             template.Id,
+		Name:           template.Name,
+		Description:    template.Description,
+		ChecklistTitle: template.ChecklistTitle,
+		Tasks:          tasks,
+		CreatedAt:      template.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:      template.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+func toWorkflowResponse(wf *proto.Workflow) *api.WorkflowResponse {
+	res := &api.WorkflowResponse{
+		ChecklistID: wf.ChecklistId,
+		States:      make([]api.WorkflowState, 0, len(wf.States)),
+		Transitions: make([]api.WorkflowTransition, 0, len(wf.Transitions)),
+		IsDefault:   wf.IsDefault,
+	}
+	for _, state := range wf.States {
+		res.States = append(res.States, toWorkflowState(state))
+	}
+	for _, t := range wf.Transitions {
+		res.Transitions = append(res.Transitions, api.WorkflowTransition{From: t.FromState, To: t.ToState})
+	}
+	return res
+}
+
+func toBoardResponse(board *proto.Board) *api.BoardResponse {
+	res := &api.BoardResponse{
+		Checklist: toChecklistResponse(board.Checklist),
+		Columns:   make([]*api.BoardColumnResponse, 0, len(board.Columns)),
+	}
+	for _, column := range board.Columns {
+		tasks := make([]*api.TaskResponse, 0, len(column.Tasks))
+		for _, task := range column.Tasks {
+			tasks = append(tasks, toTaskResponse(task))
+		}
+		res.Columns = append(res.Columns, &api.BoardColumnResponse{
+			State: toWorkflowState(column.State),
+			Tasks: tasks,
+		})
+	}
+	return res
+}
+
+func toWorkflowState(state *proto.WorkflowState) api.WorkflowState {
+	return api.WorkflowState{
+		Key:    state.Key,
+		Name:   state.Name,
+		IsDone: state.IsDone,
+	}
+}
+
+// formatOptionalTimestamp formats ts as RFC 3339, or returns "" when it is unset.
+func formatOptionalTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func handleGRPCError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	http.Error(w, st.Message(), httpStatusFromCode(st.Code()))
+}
+
+// httpStatusFromCode maps a gRPC status code returned by the db-service to
+// the closest HTTP status.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
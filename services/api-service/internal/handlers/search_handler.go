@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type SearchHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	timeout    time.Duration
+}
+
+func NewSearchHandler(grpcClient proto.ChecklistServiceClient, timeout time.Duration) *SearchHandler {
+	return &SearchHandler{
+		grpcClient: grpcClient,
+		timeout:    timeout,
+	}
+}
+
+// Search handles GET /v1/search?q=&checklist_id=&limit=. The query supports
+// "phrases", prefix* matches, -exclusions and OR.
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("q") == "" {
+		http.Error(w, "q is required", http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &proto.SearchTasksRequest{
+		Query:       q.Get("q"),
+		ChecklistId: q.Get("checklist_id"),
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			http.Error(w, "limit must be an integer", http.StatusBadRequest)
+			return
+		}
+		grpcReq.Limit = int32(limit)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.SearchTasks(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	results := make([]*api.SearchResultResponse, 0, len(grpcRes.Results))
+	for _, result := range grpcRes.Results {
+		results = append(results, &api.SearchResultResponse{
+			Task:               toTaskResponse(result.Task),
+			Rank:               result.Rank,
+			TitleHighlight:     result.TitleHighlight,
+			DescriptionSnippet: result.DescriptionSnippet,
+			CommentID:          result.CommentId,
+			CommentSnippet:     result.CommentSnippet,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
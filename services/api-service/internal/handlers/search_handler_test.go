@@ -0,0 +1,105 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchClient answers SearchTasks with the function it is given.
+type searchClient struct {
+	proto.ChecklistServiceClient
+	searchTasks func(*proto.SearchTasksRequest) (*proto.SearchTasksResponse, error)
+}
+
+func (c *searchClient) SearchTasks(_ context.Context, req *proto.SearchTasksRequest, _ ...grpc.CallOption) (*proto.SearchTasksResponse, error) {
+	return c.searchTasks(req)
+}
+
+func search(t *testing.T, client proto.ChecklistServiceClient, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handlers.NewSearchHandler(client, time.Second).Search(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestSearch(t *testing.T) {
+	var got *proto.SearchTasksRequest
+	client := &searchClient{searchTasks: func(req *proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+		got = req
+		return &proto.SearchTasksResponse{Results: []*proto.SearchResult{
+			{
+				Task:               openTask(),
+				Rank:               0.75,
+				TitleHighlight:     "Write <mark>release</mark> notes",
+				DescriptionSnippet: "Summarize the changes since 1.4",
+			},
+			{
+				Task:           doneTask(),
+				Rank:           0.25,
+				TitleHighlight: "Tag the release",
+				CommentId:      "c0ffee00-0000-4000-8000-000000000001",
+				CommentSnippet: "after the <mark>release</mark> notes are merged",
+			},
+		}}, nil
+	}}
+
+	rec := search(t, client, `/v1/search?q=release+%22release+notes%22&checklist_id=9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d&limit=5`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if got.Query != `release "release notes"` || got.ChecklistId != "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d" || got.Limit != 5 {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "search.json", rec.Body.Bytes())
+
+	none := &searchClient{searchTasks: func(*proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+		return &proto.SearchTasksResponse{}, nil
+	}}
+	if rec := search(t, none, "/v1/search?q=nothing"); rec.Code != http.StatusOK || rec.Body.String() != "[]\n" {
+		t.Errorf("no results: status %d, body %q, want 200 []", rec.Code, rec.Body)
+	}
+}
+
+func TestSearchErrors(t *testing.T) {
+	unreachable := &searchClient{searchTasks: func(*proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+		t.Error("the handler must not call the db-service")
+		return nil, nil
+	}}
+	// The db-service rejects a query that only excludes terms.
+	invalid := &searchClient{searchTasks: func(*proto.SearchTasksRequest) (*proto.SearchTasksResponse, error) {
+		return nil, status.Error(codes.InvalidArgument, "search query must contain at least one term that is not excluded")
+	}}
+	tests := []struct {
+		name       string
+		client     proto.ChecklistServiceClient
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"without q", unreachable, "/v1/search?limit=5", http.StatusBadRequest, "q is required"},
+		{"with an empty q", unreachable, "/v1/search?q=", http.StatusBadRequest, "q is required"},
+		{"with a bad limit", unreachable, "/v1/search?q=x&limit=all", http.StatusBadRequest, "limit must be an integer"},
+		{"rejected query", invalid, "/v1/search?q=-release", http.StatusBadRequest, "search query must contain at least one term that is not excluded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := search(t, tt.client, tt.target)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Body.String(); got != tt.wantBody+"\n" {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
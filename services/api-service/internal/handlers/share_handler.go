@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"embed"
+	"encoding/json"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:embed templates/share.html
+var templatesFS embed.FS
+
+var shareTemplate = template.Must(template.ParseFS(templatesFS, "templates/share.html"))
+
+// sharePasswordHeader lets API clients pass the share link password without
+// putting it into the URL.
+const sharePasswordHeader = "X-Share-Password"
+
+type ShareHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	timeout    time.Duration
+}
+
+func NewShareHandler(grpcClient proto.ChecklistServiceClient, timeout time.Duration) *ShareHandler {
+	return &ShareHandler{
+		grpcClient: grpcClient,
+		timeout:    timeout,
+	}
+}
+
+func (h *ShareHandler) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+	var req api.CreateShareLinkRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Failed to decode request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	grpcReq := &proto.CreateShareLinkRequest{
+		ChecklistId: chi.URLParam(r, "id"),
+		Password:    req.Password,
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			http.Error(w, "expires_at must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		grpcReq.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.CreateShareLink(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toShareLinkResponse(grpcRes))
+}
+
+func (h *ShareHandler) ListShareLinks(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.ListShareLinks(ctx, &proto.ListShareLinksRequest{ChecklistId: chi.URLParam(r, "id")})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	links := make([]*api.ShareLinkResponse, 0, len(grpcRes.ShareLinks))
+	for _, link := range grpcRes.ShareLinks {
+		links = append(links, toShareLinkResponse(link))
+	}
+
+	writeJSON(w, http.StatusOK, links)
+}
+
+// ViewSharedChecklist serves GET and POST /share/{token} without
+// authentication. The checklist is rendered as HTML for browsers (or with
+// ?format=html) and as JSON otherwise. The password of a protected link is
+// read from the X-Share-Password header or, for the HTML form, from the POST body.
+func (h *ShareHandler) ViewSharedChecklist(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+
+	password := r.Header.Get(sharePasswordHeader)
+	if password == "" && r.Method == http.MethodPost {
+		password = r.PostFormValue("password")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	grpcRes, err := h.grpcClient.ResolveShareLink(ctx, &proto.ResolveShareLinkRequest{
+		Token:    chi.URLParam(r, "token"),
+		Password: password,
+	})
+
+	if !wantsHTML(r) {
+		if err != nil {
+			handleGRPCError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, toSharedChecklistResponse(grpcRes))
+		return
+	}
+
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Unauthenticated {
+			view := shareView{}
+			if password != "" {
+				view.Error = "Invalid password."
+			}
+			renderShare(w, r, http.StatusUnauthorized, view)
+			return
+		}
+		handleGRPCError(w, err)
+		return
+	}
+
+	shared := toSharedChecklistResponse(grpcRes)
+	renderShare(w, r, http.StatusOK, shareView{Checklist: shared.Checklist, Tasks: shared.Tasks})
+}
+
+type shareView struct {
+	Checklist *api.ChecklistResponse
+	Tasks     []*api.TaskResponse
+	Error     string
+}
+
+func renderShare(w http.ResponseWriter, r *http.Request, status int, view shareView) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := shareTemplate.Execute(w, view); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to render shared checklist", "error", err)
+	}
+}
+
+func wantsHTML(r *http.Request) bool {
+	switch r.URL.Query().Get("format") {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+func toShareLinkResponse(link *proto.ShareLink) *api.ShareLinkResponse {
+	res := &api.ShareLinkResponse{
+		ID:                link.Id,
+		ChecklistID:       link.ChecklistId,
+		Token:             link.Token,
+		PasswordProtected: link.PasswordProtected,
+		ExpiresAt:         formatOptionalTimestamp(link.ExpiresAt),
+		RevokedAt:         formatOptionalTimestamp(link.RevokedAt),
+		CreatedAt:         link.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+	if link.Token != "" {
+		res.URL = "/share/" + link.Token
+	}
+	return res
+}
+
+func toSharedChecklistResponse(shared *proto.SharedChecklist) *api.SharedChecklistResponse {
+	tasks := make([]*api.TaskResponse, 0, len(shared.Tasks))
+	for _, task := range shared.Tasks {
+		tasks = append(tasks, toTaskResponse(task))
+	}
+	return &api.SharedChecklistResponse{
+		Checklist: toChecklistResponse(shared.Checklist),
+		Tasks:     tasks,
+	}
+}
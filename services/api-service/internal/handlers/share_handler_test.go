@@ -0,0 +1,249 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	shareChecklistID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	shareLinkID      = "4e5f6a7b-8c9d-4e0f-a1b2-c3d4e5f6a7b8"
+	shareToken       = "q8JX1s2LrVb3nK0dYw5Tz7uPmHc9GfEaRt4Si6Uo"
+)
+
+var expires = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+// shareClient answers the share link RPCs with the functions it is given.
+type shareClient struct {
+	proto.ChecklistServiceClient
+	createShareLink  func(*proto.CreateShareLinkRequest) (*proto.ShareLink, error)
+	listShareLinks   func(*proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error)
+	revokeShareLink  func(*proto.RevokeShareLinkRequest) (*proto.ShareLink, error)
+	resolveShareLink func(*proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error)
+}
+
+func (c *shareClient) CreateShareLink(_ context.Context, req *proto.CreateShareLinkRequest, _ ...grpc.CallOption) (*proto.ShareLink, error) {
+	return c.createShareLink(req)
+}
+
+func (c *shareClient) ListShareLinks(_ context.Context, req *proto.ListShareLinksRequest, _ ...grpc.CallOption) (*proto.ListShareLinksResponse, error) {
+	return c.listShareLinks(req)
+}
+
+func (c *shareClient) RevokeShareLink(_ context.Context, req *proto.RevokeShareLinkRequest, _ ...grpc.CallOption) (*proto.ShareLink, error) {
+	return c.revokeShareLink(req)
+}
+
+func (c *shareClient) ResolveShareLink(_ context.Context, req *proto.ResolveShareLinkRequest, _ ...grpc.CallOption) (*proto.SharedChecklist, error) {
+	return c.resolveShareLink(req)
+}
+
+// serveShare routes the request to the share handler like the api-service.
+func serveShare(t *testing.T, client proto.ChecklistServiceClient, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	h := handlers.NewShareHandler(client, time.Second)
+	r := chi.NewRouter()
+	r.Post("/v1/checklists/{id}/shares", h.CreateShareLink)
+	r.Get("/v1/checklists/{id}/shares", h.ListShareLinks)
+	r.Get("/share/{token}", h.ViewSharedChecklist)
+	r.Post("/share/{token}", h.ViewSharedChecklist)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func shareLink() *proto.ShareLink {
+	return &proto.ShareLink{
+		Id:                shareLinkID,
+		ChecklistId:       shareChecklistID,
+		PasswordProtected: true,
+		ExpiresAt:         timestamppb.New(expires),
+		CreatedAt:         timestamppb.New(created),
+	}
+}
+
+func TestCreateShareLink(t *testing.T) {
+	var got *proto.CreateShareLinkRequest
+	client := &shareClient{createShareLink: func(req *proto.CreateShareLinkRequest) (*proto.ShareLink, error) {
+		got = req
+		link := shareLink()
+		link.Token = shareToken
+		return link, nil
+	}}
+
+	rec := serveShare(t, client, httptest.NewRequest(http.MethodPost, "/v1/checklists/"+shareChecklistID+"/shares",
+		strings.NewReader(`{"expires_at":"2025-04-01T02:00:00+02:00","password":"hunter2"}`)))
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if got.ChecklistId != shareChecklistID || got.Password != "hunter2" || !got.ExpiresAt.AsTime().Equal(expires) {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "create_share_link.json", rec.Body.Bytes())
+
+	// A link without options needs no body at all.
+	rec = serveShare(t, client, httptest.NewRequest(http.MethodPost, "/v1/checklists/"+shareChecklistID+"/shares", nil))
+	if rec.Code != http.StatusCreated || got.Password != "" || got.ExpiresAt != nil {
+		t.Errorf("without a body: status %d, forwarded request %v", rec.Code, got)
+	}
+}
+
+func TestListShareLinks(t *testing.T) {
+	client := &shareClient{listShareLinks: func(req *proto.ListShareLinksRequest) (*proto.ListShareLinksResponse, error) {
+		if req.ChecklistId != shareChecklistID {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		revoked := shareLink()
+		revoked.Id, revoked.PasswordProtected, revoked.ExpiresAt = "5f6a7b8c-9d0e-4f1a-b2c3-d4e5f6a7b8c9", false, nil
+		revoked.RevokedAt = timestamppb.New(updated)
+		return &proto.ListShareLinksResponse{ShareLinks: []*proto.ShareLink{shareLink(), revoked}}, nil
+	}}
+
+	rec := serveShare(t, client, httptest.NewRequest(http.MethodGet, "/v1/checklists/"+shareChecklistID+"/shares", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	checkGolden(t, "list_share_links.json", rec.Body.Bytes())
+
+	rec = serveShare(t, client, httptest.NewRequest(http.MethodGet, "/v1/checklists/"+shareLinkID+"/shares", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown checklist: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestRevokeShareLink(t *testing.T) {
+	var revoked string
+	client := &shareClient{revokeShareLink: func(req *proto.RevokeShareLinkRequest) (*proto.ShareLink, error) {
+		if req.Id != shareLinkID {
+			return nil, status.Error(codes.NotFound, "share link not found")
+		}
+		revoked = req.Id
+		link := shareLink()
+		link.RevokedAt = timestamppb.New(updated)
+		return link, nil
+	}}
+
+	rec := serve(t, client, http.MethodDelete, "/v1/shares/"+shareLinkID, "")
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 || revoked != shareLinkID {
+		t.Errorf("status %d, body %q, revoked %q, want 204 for %s", rec.Code, rec.Body, revoked, shareLinkID)
+	}
+	rec = serve(t, client, http.MethodDelete, "/v1/shares/"+shareChecklistID, "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown link: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestViewSharedChecklist(t *testing.T) {
+	// The db-service reports unknown, revoked and expired tokens alike as
+	// NotFound, and a missing or wrong password as Unauthenticated.
+	client := &shareClient{resolveShareLink: func(req *proto.ResolveShareLinkRequest) (*proto.SharedChecklist, error) {
+		switch {
+		case req.Token != shareToken:
+			return nil, status.Error(codes.NotFound, "share link not found")
+		case req.Password != "hunter2":
+			return nil, status.Error(codes.Unauthenticated, "password required")
+		}
+		return &proto.SharedChecklist{
+			Checklist: &proto.Checklist{Id: shareChecklistID, Title: "Release 1.5", CreatedAt: timestamppb.New(created), UpdatedAt: timestamppb.New(updated)},
+			Tasks:     []*proto.Task{openTask(), doneTask()},
+		}, nil
+	}}
+	request := func(method, target, password, accept string, form string) *http.Request {
+		req := httptest.NewRequest(method, target, strings.NewReader(form))
+		if password != "" {
+			req.Header.Set("X-Share-Password", password)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if form != "" {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		return req
+	}
+	const html = "text/html,application/xhtml+xml"
+
+	rec := serveShare(t, client, request(http.MethodGet, "/share/"+shareToken, "hunter2", "", ""))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	checkGolden(t, "shared_checklist.json", rec.Body.Bytes())
+	for name, want := range map[string]string{"Cache-Control": "no-store", "Referrer-Policy": "no-referrer", "X-Robots-Tag": "noindex, nofollow"} {
+		if got := rec.Header().Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		req         *http.Request
+		wantStatus  int
+		wantType    string
+		wantContain string
+	}{
+		{"expired or revoked", request(http.MethodGet, "/share/expired", "hunter2", "", ""), http.StatusNotFound, "text/plain", "share link not found"},
+		{"expired or revoked as HTML", request(http.MethodGet, "/share/expired", "", html, ""), http.StatusNotFound, "text/plain", "share link not found"},
+		{"no password", request(http.MethodGet, "/share/"+shareToken, "", "", ""), http.StatusUnauthorized, "text/plain", "password required"},
+		{"password form", request(http.MethodGet, "/share/"+shareToken, "", html, ""), http.StatusUnauthorized, "text/html", `<input type="password"`},
+		{"wrong password in the form", request(http.MethodPost, "/share/"+shareToken+"?format=html", "", "", "password=guess"), http.StatusUnauthorized, "text/html", "Invalid password."},
+		{"password in the form", request(http.MethodPost, "/share/"+shareToken, "", html, "password=hunter2"), http.StatusOK, "text/html", "<h1>Release 1.5</h1>"},
+		{"JSON asked for explicitly", request(http.MethodGet, "/share/"+shareToken+"?format=json", "hunter2", html, ""), http.StatusOK, "application/json", `"title":"Release 1.5"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveShare(t, client, tt.req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.wantType) {
+				t.Errorf("Content-Type = %q, want %s", ct, tt.wantType)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContain) {
+				t.Errorf("body does not contain %q:\n%s", tt.wantContain, rec.Body)
+			}
+		})
+	}
+}
+
+func TestCreateShareLinkErrors(t *testing.T) {
+	unreachable := &shareClient{createShareLink: func(*proto.CreateShareLinkRequest) (*proto.ShareLink, error) {
+		t.Error("the handler must not call the db-service")
+		return nil, nil
+	}}
+	for name, body := range map[string]string{
+		"malformed JSON":  `{"password":`,
+		"bad expires_at":  `{"expires_at":"next week"}`,
+		"mistyped fields": `{"password":1}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := serveShare(t, unreachable, httptest.NewRequest(http.MethodPost, "/v1/checklists/"+shareChecklistID+"/shares", strings.NewReader(body)))
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+
+	past := &shareClient{createShareLink: func(*proto.CreateShareLinkRequest) (*proto.ShareLink, error) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}}
+	rec := serveShare(t, past, httptest.NewRequest(http.MethodPost, "/v1/checklists/"+shareChecklistID+"/shares",
+		strings.NewReader(`{"expires_at":"2020-01-01T00:00:00Z"}`)))
+	if rec.Code != http.StatusBadRequest || strings.TrimSpace(rec.Body.String()) != "expires_at must be in the future" {
+		t.Errorf("expiry in the past: status %d, body %q", rec.Code, rec.Body)
+	}
+}
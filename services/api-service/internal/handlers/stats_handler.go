@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type StatsHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	timeout    time.Duration
+}
+
+func NewStatsHandler(grpcClient proto.ChecklistServiceClient, timeout time.Duration) *StatsHandler {
+	return &StatsHandler{
+		grpcClient: grpcClient,
+		timeout:    timeout,
+	}
+}
+
+// GetStats handles GET /v1/stats?from=&to=&checklist_id=&group_by=&tz=.
+// from and to accept RFC 3339 timestamps or YYYY-MM-DD dates in tz; a date
+// in to includes the whole day. The range defaults to the last 30 days.
+func (h *StatsHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	loc := time.UTC
+	if q.Has("tz") {
+		var err error
+		loc, err = loadStatsLocation(q.Get("tz"))
+		if err != nil {
+			http.Error(w, "Invalid tz", http.StatusBadRequest)
+			return
+		}
+	}
+
+	grpcReq := &proto.GetStatsRequest{
+		ChecklistId: q.Get("checklist_id"),
+		GroupBy:     q.Get("group_by"),
+		Timezone:    loc.String(),
+	}
+	if v := q.Get("from"); v != "" {
+		from, err := parseStatsTime(v, loc, false)
+		if err != nil {
+			http.Error(w, "Invalid from: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		grpcReq.From = timestamppb.New(from)
+	}
+	if v := q.Get("to"); v != "" {
+		to, err := parseStatsTime(v, loc, true)
+		if err != nil {
+			http.Error(w, "Invalid to: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		grpcReq.To = timestamppb.New(to)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	stats, err := h.grpcClient.GetStats(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	res := &api.StatsResponse{
+		From:       stats.From.AsTime().Format(time.RFC3339),
+		To:         stats.To.AsTime().Format(time.RFC3339),
+		GroupBy:    stats.GroupBy,
+		Timezone:   stats.Timezone,
+		Totals:     toChecklistStatsResponse(stats.Totals),
+		Checklists: make([]*api.ChecklistStatsResponse, 0, len(stats.Checklists)),
+		Series:     make([]*api.StatsBucketResponse, 0, len(stats.Series)),
+	}
+	for _, cs := range stats.Checklists {
+		res.Checklists = append(res.Checklists, toChecklistStatsResponse(cs))
+	}
+	for _, bucket := range stats.Series {
+		res.Series = append(res.Series, &api.StatsBucketResponse{
+			Start:     bucket.Start.AsTime().In(loc).Format(time.RFC3339),
+			Created:   bucket.Created,
+			Completed: bucket.Completed,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// loadStatsLocation loads an IANA time zone. time.LoadLocation also accepts
+// "" for UTC and "Local" for the zone of this host, neither of which the
+// db-service can use, so they are rejected.
+func loadStatsLocation(tz string) (*time.Location, error) {
+	if tz == "" || tz == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", tz)
+	}
+	return time.LoadLocation(tz)
+}
+
+// parseStatsTime parses an RFC 3339 timestamp or a date in loc. With endOfDay
+// a date stands for the end of that day, i.e. the start of the next one.
+func parseStatsTime(v string, loc *time.Location, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, v, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected RFC 3339 timestamp or YYYY-MM-DD")
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+func toChecklistStatsResponse(cs *proto.ChecklistStats) *api.ChecklistStatsResponse {
+	res := &api.ChecklistStatsResponse{
+		ChecklistID:     cs.GetChecklistId(),
+		ChecklistTitle:  cs.GetChecklistTitle(),
+		Total:           cs.GetTotal(),
+		Completed:       cs.GetCompleted(),
+		CompletionRatio: cs.GetCompletionRatio(),
+		Overdue:         cs.GetOverdue(),
+	}
+	if cs.GetMedianTimeToComplete() != nil {
+		seconds := cs.MedianTimeToComplete.AsDuration().Seconds()
+		res.MedianTimeToCompleteSeconds = &seconds
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/handlers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statsClient answers GetStats with the function it is given.
+type statsClient struct {
+	proto.ChecklistServiceClient
+	getStats func(*proto.GetStatsRequest) (*proto.Stats, error)
+}
+
+func (c *statsClient) GetStats(_ context.Context, req *proto.GetStatsRequest, _ ...grpc.CallOption) (*proto.Stats, error) {
+	return c.getStats(req)
+}
+
+func getStats(t *testing.T, client proto.ChecklistServiceClient, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handlers.NewStatsHandler(client, time.Second).GetStats(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestGetStats(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *proto.GetStatsRequest
+	client := &statsClient{getStats: func(req *proto.GetStatsRequest) (*proto.Stats, error) {
+		got = req
+		release := &proto.ChecklistStats{
+			ChecklistId:          "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			ChecklistTitle:       "Release 1.5",
+			Total:                4,
+			Completed:            1,
+			CompletionRatio:      0.25,
+			MedianTimeToComplete: durationpb.New(90 * time.Minute),
+			Overdue:              2,
+		}
+		return &proto.Stats{
+			From:       req.From,
+			To:         req.To,
+			GroupBy:    req.GroupBy,
+			Timezone:   req.Timezone,
+			Totals:     &proto.ChecklistStats{Total: 5, Completed: 1, CompletionRatio: 0.2, MedianTimeToComplete: durationpb.New(90 * time.Minute), Overdue: 2},
+			Checklists: []*proto.ChecklistStats{release, {Total: 1}},
+			Series: []*proto.StatsBucket{
+				{Start: timestamppb.New(time.Date(2025, 3, 29, 0, 0, 0, 0, berlin)), Created: 5},
+				{Start: timestamppb.New(time.Date(2025, 3, 30, 0, 0, 0, 0, berlin)), Completed: 1},
+			},
+		}, nil
+	}}
+
+	rec := getStats(t, client, "/v1/stats?from=2025-03-29&to=2025-03-30&group_by=day&tz=Europe/Berlin&checklist_id=9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	// Dates are local midnights in tz, and to includes its whole day, which
+	// is an hour short as daylight saving time starts.
+	wantFrom := time.Date(2025, 3, 28, 23, 0, 0, 0, time.UTC)
+	wantTo := time.Date(2025, 3, 30, 22, 0, 0, 0, time.UTC)
+	if !got.From.AsTime().Equal(wantFrom) || !got.To.AsTime().Equal(wantTo) || got.GroupBy != "day" ||
+		got.Timezone != "Europe/Berlin" || got.ChecklistId != "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d" {
+		t.Errorf("forwarded request = %v, want [%v, %v) by day in Europe/Berlin", got, wantFrom, wantTo)
+	}
+	// Bucket starts are given in tz.
+	checkGolden(t, "stats.json", rec.Body.Bytes())
+
+	rec = getStats(t, client, "/v1/stats?from=2025-03-01T00:00:00Z")
+	if rec.Code != http.StatusOK || got.Timezone != "UTC" || got.To != nil || got.GroupBy != "" {
+		t.Errorf("defaults: status %d, forwarded request %v, want UTC with the range end and grouping left to the db-service", rec.Code, got)
+	}
+}
+
+func TestGetStatsErrors(t *testing.T) {
+	unreachable := &statsClient{getStats: func(*proto.GetStatsRequest) (*proto.Stats, error) {
+		t.Error("the handler must not call the db-service")
+		return nil, nil
+	}}
+	// The db-service validates group_by and the range, and the time zone
+	// once more.
+	rejecting := &statsClient{getStats: func(req *proto.GetStatsRequest) (*proto.Stats, error) {
+		return nil, status.Error(codes.InvalidArgument, "group_by must be day, week or month")
+	}}
+	tests := []struct {
+		name       string
+		client     proto.ChecklistServiceClient
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"empty tz", unreachable, "/v1/stats?tz=", http.StatusBadRequest, "Invalid tz"},
+		{"Local tz", unreachable, "/v1/stats?tz=Local", http.StatusBadRequest, "Invalid tz"},
+		{"unknown tz", unreachable, "/v1/stats?tz=Mars/Olympus_Mons", http.StatusBadRequest, "Invalid tz"},
+		{"tz as an offset", unreachable, "/v1/stats?tz=%2B02:00", http.StatusBadRequest, "Invalid tz"},
+		{"bad from", unreachable, "/v1/stats?from=yesterday", http.StatusBadRequest, "Invalid from: expected RFC 3339 timestamp or YYYY-MM-DD"},
+		{"bad to", unreachable, "/v1/stats?to=2025-02-30", http.StatusBadRequest, "Invalid to: expected RFC 3339 timestamp or YYYY-MM-DD"},
+		{"bad group_by", rejecting, "/v1/stats?group_by=year", http.StatusBadRequest, "group_by must be day, week or month"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := getStats(t, tt.client, tt.target)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Body.String(); got != tt.wantBody+"\n" {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
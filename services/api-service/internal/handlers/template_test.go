@@ -0,0 +1,189 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	proto "checklist-go/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const templateID = "7c8d9e0f-1a2b-4c3d-9e4f-5a6b7c8d9e0f"
+
+// templateClient answers the template RPCs with the functions it is given.
+type templateClient struct {
+	proto.ChecklistServiceClient
+	createTemplate      func(*proto.CreateTemplateFromChecklistRequest) (*proto.Template, error)
+	listTemplates       func(*proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error)
+	getTemplate         func(*proto.TemplateActionRequest) (*proto.Template, error)
+	instantiateTemplate func(*proto.InstantiateTemplateRequest) (*proto.Checklist, error)
+}
+
+func (c *templateClient) CreateTemplateFromChecklist(_ context.Context, req *proto.CreateTemplateFromChecklistRequest, _ ...grpc.CallOption) (*proto.Template, error) {
+	return c.createTemplate(req)
+}
+
+func (c *templateClient) ListTemplates(_ context.Context, req *proto.ListTemplatesRequest, _ ...grpc.CallOption) (*proto.ListTemplatesResponse, error) {
+	return c.listTemplates(req)
+}
+
+func (c *templateClient) GetTemplate(_ context.Context, req *proto.TemplateActionRequest, _ ...grpc.CallOption) (*proto.Template, error) {
+	return c.getTemplate(req)
+}
+
+func (c *templateClient) InstantiateTemplate(_ context.Context, req *proto.InstantiateTemplateRequest, _ ...grpc.CallOption) (*proto.Checklist, error) {
+	return c.instantiateTemplate(req)
+}
+
+// releaseTemplate covers every field of api.TemplateResponse, with one
+// blueprint that has a due offset and one that has none.
+func releaseTemplate() *proto.Template {
+	return &proto.Template{
+		Id:             templateID,
+		Name:           "Release",
+		Description:    "Steps of a minor release",
+		ChecklistTitle: "Release {{version}}",
+		Tasks: []*proto.TaskBlueprint{
+			{Title: "Write release notes for {{version}}", Description: "Summarize the changes", DueOffset: durationpb.New(48 * time.Hour), Tags: []string{"docs"}},
+			{Title: "Tag {{version}}"},
+		},
+		CreatedAt: timestamppb.New(created),
+		UpdatedAt: timestamppb.New(updated),
+	}
+}
+
+func TestCreateTemplate(t *testing.T) {
+	var got *proto.CreateTemplateFromChecklistRequest
+	client := &templateClient{createTemplate: func(req *proto.CreateTemplateFromChecklistRequest) (*proto.Template, error) {
+		got = req
+		return releaseTemplate(), nil
+	}}
+
+	rec := serve(t, client, http.MethodPost, "/v1/templates",
+		`{"checklist_id":"9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d","name":"Release","checklist_title":"Release {{version}}"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if got.ChecklistId != "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d" || got.Name != "Release" || got.ChecklistTitle != "Release {{version}}" {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "create_template.json", rec.Body.Bytes())
+}
+
+func TestListAndGetTemplates(t *testing.T) {
+	var templates []*proto.Template
+	client := &templateClient{
+		listTemplates: func(*proto.ListTemplatesRequest) (*proto.ListTemplatesResponse, error) {
+			return &proto.ListTemplatesResponse{Templates: templates}, nil
+		},
+		getTemplate: func(req *proto.TemplateActionRequest) (*proto.Template, error) {
+			if req.Id != templateID {
+				return nil, status.Error(codes.NotFound, "template not found")
+			}
+			return releaseTemplate(), nil
+		},
+	}
+
+	rec := serve(t, client, http.MethodGet, "/v1/templates", "")
+	if rec.Code != http.StatusOK || rec.Body.String() != "[]\n" {
+		t.Errorf("no templates: status %d, body %q, want 200 []", rec.Code, rec.Body)
+	}
+
+	templates = []*proto.Template{releaseTemplate()}
+	rec = serve(t, client, http.MethodGet, "/v1/templates", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	checkGolden(t, "list_templates.json", rec.Body.Bytes())
+
+	rec = serve(t, client, http.MethodGet, "/v1/templates/"+templateID, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	checkGolden(t, "create_template.json", rec.Body.Bytes())
+
+	rec = serve(t, client, http.MethodGet, "/v1/templates/unknown", "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown template: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestInstantiateTemplate(t *testing.T) {
+	var got *proto.InstantiateTemplateRequest
+	client := &templateClient{instantiateTemplate: func(req *proto.InstantiateTemplateRequest) (*proto.Checklist, error) {
+		got = req
+		if req.TemplateId != templateID {
+			return nil, status.Error(codes.NotFound, "template not found")
+		}
+		return &proto.Checklist{
+			Id:        "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			Title:     "Release 1.5",
+			CreatedAt: timestamppb.New(created),
+			UpdatedAt: timestamppb.New(created),
+		}, nil
+	}}
+
+	rec := serve(t, client, http.MethodPost, "/v1/templates/"+templateID+"/instantiate",
+		`{"variables":{"version":"1.5"},"start_at":"2025-03-01T10:30:00+01:00"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if got.TemplateId != templateID || got.Variables["version"] != "1.5" || !got.StartAt.AsTime().Equal(created) {
+		t.Errorf("forwarded request = %v", got)
+	}
+	checkGolden(t, "instantiate_template.json", rec.Body.Bytes())
+
+	// The body is optional: no variables, starting now.
+	rec = serve(t, client, http.MethodPost, "/v1/templates/"+templateID+"/instantiate", "")
+	if rec.Code != http.StatusCreated || len(got.Variables) != 0 || got.StartAt != nil {
+		t.Errorf("without a body: status %d, forwarded request %v", rec.Code, got)
+	}
+
+	rec = serve(t, client, http.MethodPost, "/v1/templates/unknown/instantiate", "{}")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown template: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTemplateErrors(t *testing.T) {
+	unreachable := &templateClient{
+		createTemplate: func(*proto.CreateTemplateFromChecklistRequest) (*proto.Template, error) {
+			t.Error("the handler must not call the db-service")
+			return nil, nil
+		},
+		instantiateTemplate: func(*proto.InstantiateTemplateRequest) (*proto.Checklist, error) {
+			t.Error("the handler must not call the db-service")
+			return nil, nil
+		},
+	}
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		wantBody string
+	}{
+		{"create without a name", "/v1/templates", `{"checklist_id":"x"}`, "checklist_id and name are required"},
+		{"create without a checklist", "/v1/templates", `{"name":"x"}`, "checklist_id and name are required"},
+		{"create malformed JSON", "/v1/templates", `{"name":`, "Failed to decode request body"},
+		{"instantiate with bad start_at", "/v1/templates/" + templateID + "/instantiate", `{"start_at":"monday"}`, "start_at must be an RFC 3339 timestamp"},
+		{"instantiate with mistyped variables", "/v1/templates/" + templateID + "/instantiate", `{"variables":{"version":1.5}}`, "Failed to decode request body"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, unreachable, http.MethodPost, tt.target, tt.body)
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+			if got := rec.Body.String(); got != tt.wantBody+"\n" {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
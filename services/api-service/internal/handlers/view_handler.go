@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	proto "checklist-go/proto"
+	"checklist-go/services/api-service/internal/api"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ViewHandler struct {
+	grpcClient proto.ChecklistServiceClient
+	timeout    time.Duration
+}
+
+func NewViewHandler(grpcClient proto.ChecklistServiceClient, timeout time.Duration) *ViewHandler {
+	return &ViewHandler{
+		grpcClient: grpcClient,
+		timeout:    timeout,
+	}
+}
+
+// ListViewTasks handles GET /v1/views/{id}/tasks, listing the tasks that
+// match the view's filter.
+func (h *ViewHandler) ListViewTasks(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	view, err := h.grpcClient.GetSavedView(ctx, &proto.SavedViewActionRequest{Id: chi.URLParam(r, "id")})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	grpcRes, err := h.grpcClient.ListTasks(ctx, &proto.ListTasksRequest{Filter: view.Filter})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	tasks := make([]*api.TaskResponse, 0, len(grpcRes.Tasks))
+	for _, task := range grpcRes.Tasks {
+		tasks = append(tasks, toTaskResponse(task))
+	}
+
+	writeJSON(w, http.StatusOK, tasks)
+}
+
+func toSavedViewResponse(view *proto.SavedView) *api.SavedViewResponse {
+	return &api.SavedViewResponse{
+		ID:        view.Id,
+		Name:      view.Name,
+		Filter:    view.Filter,
+		CreatedAt: view.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt: view.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
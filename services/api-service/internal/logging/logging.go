@@ -0,0 +1,163 @@
+// Package logging configures JSON logging for the api-service and tags every
+// log line of a request with its request ID, method and task ID.
+package logging
+
+import (
+	baselog "checklist-go/internal/logging"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDHeader is accepted from clients and returned on every response.
+	RequestIDHeader = "X-Request-ID"
+	// requestIDKey is the gRPC metadata key the db-service reads.
+	requestIDKey = "x-request-id"
+	// maxRequestIDLength bounds client supplied request IDs.
+	maxRequestIDLength = 128
+)
+
+// Setup sets up JSON logging at the given level with the fields of the
+// request in every log line.
+func Setup(service string, level slog.Level) {
+	baselog.Setup(service, level, requestAttrs)
+}
+
+type contextKey struct{}
+
+// requestFields is shared by everything that handles one request, so the
+// task ID can be filled in once it is known.
+type requestFields struct {
+	mu        sync.Mutex
+	requestID string
+	method    string
+	taskID    string
+}
+
+func fieldsFrom(ctx context.Context) *requestFields {
+	f, _ := ctx.Value(contextKey{}).(*requestFields)
+	return f
+}
+
+// RequestID returns the ID of the request ctx belongs to, if any.
+func RequestID(ctx context.Context) string {
+	if f := fieldsFrom(ctx); f != nil {
+		return f.requestID
+	}
+	return ""
+}
+
+// SetTaskID records the task the request is about.
+func SetTaskID(ctx context.Context, id string) {
+	if f := fieldsFrom(ctx); f != nil && id != "" {
+		f.mu.Lock()
+		f.taskID = id
+		f.mu.Unlock()
+	}
+}
+
+// requestAttrs returns the request ID, method and task ID of the request.
+func requestAttrs(ctx context.Context) []slog.Attr {
+	f := fieldsFrom(ctx)
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	attrs := []slog.Attr{slog.String("request_id", f.requestID), slog.String("method", f.method)}
+	taskID := f.taskID
+	f.mu.Unlock()
+	if taskID == "" {
+		taskID = routeTaskID(ctx)
+	}
+	if taskID != "" {
+		attrs = append(attrs, slog.String("task_id", taskID))
+	}
+	return attrs
+}
+
+// routeTaskID returns the {id} of a /tasks/{id} route once chi has routed the
+// request.
+func routeTaskID(ctx context.Context) string {
+	rctx := chi.RouteContext(ctx)
+	if rctx == nil || !strings.Contains(rctx.RoutePattern(), "/tasks/{id}") {
+		return ""
+	}
+	return rctx.URLParam("id")
+}
+
+// Middleware assigns every request an ID, reusing a well-formed X-Request-ID
+// sent by the client, returns it in the response and logs the request once it
+// has been served.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		fields := &requestFields{requestID: requestID, method: r.Method}
+		ctx := context.WithValue(r.Context(), contextKey{}, fields)
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		start := time.Now()
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		route := ""
+		if rctx := chi.RouteContext(ctx); rctx != nil {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status < http.StatusBadRequest && (route == "/healthz" || route == "/readyz"):
+			// Probes arrive every few seconds.
+			level = slog.LevelDebug
+		}
+		slog.Log(ctx, level, "Served HTTP request",
+			"path", r.URL.Path,
+			"route", route,
+			"status", status,
+			"bytes", ww.BytesWritten(),
+			"duration_ms", time.Since(start).Milliseconds(),
+			"remote_addr", r.RemoteAddr,
+		)
+	})
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("-_.:", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryClientInterceptor sends the request ID to the db-service in the
+// x-request-id metadata and records the task a call is about.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if id := RequestID(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestIDKey, id)
+	}
+	SetTaskID(ctx, baselog.RequestTaskID(req))
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,115 @@
+// Package openapi describes the HTTP API of the api-service as an OpenAPI 3.1
+// document. The routes are listed in operations.go; the schemas are reflected
+// from the types in package api, so they follow the JSON the handlers write.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/swaggest/jsonschema-go"
+	"github.com/swaggest/openapi-go"
+	"github.com/swaggest/openapi-go/openapi31"
+	"github.com/swaggest/swgui/v5emb"
+)
+
+// operation documents one route. params is a struct whose fields carry
+// path, query, header or formData tags; body and response are JSON.
+type operation struct {
+	method   string
+	path     string
+	tag      string
+	summary  string
+	params   any
+	body     any
+	status   int
+	response any
+	// contentType overrides application/json for the success response.
+	contentType string
+	// errors are the statuses of the plain text errors the route answers
+	// with besides those of the db-service call.
+	errors []int
+	// upstream marks routes that call the db-service and so can also fail
+	// with 500, 503 and 504.
+	upstream bool
+}
+
+// Document builds the OpenAPI document as JSON.
+func Document() ([]byte, error) {
+	r := openapi31.NewReflector()
+	r.Spec.Info.
+		WithTitle("checklist-go API").
+		WithVersion("1.0.0").
+		WithDescription("HTTP API of the checklist service. Errors are plain text. " +
+			"Routes acting for a user read the user ID from the X-User-ID header.")
+	// Name schemas after their Go types rather than package and type.
+	r.JSONSchemaReflector().DefaultOptions = append(r.JSONSchemaReflector().DefaultOptions,
+		jsonschema.InterceptDefName(func(t reflect.Type, _ string) string { return t.Name() }))
+
+	for _, op := range operations {
+		oc, err := r.NewOperationContext(op.method, op.path)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", op.method, op.path, err)
+		}
+		oc.SetTags(op.tag)
+		oc.SetSummary(op.summary)
+
+		if op.params != nil {
+			oc.AddReqStructure(op.params)
+		}
+		if op.body != nil {
+			oc.AddReqStructure(op.body, openapi.WithContentType("application/json"))
+		}
+
+		var opts []openapi.ContentOption
+		opts = append(opts, openapi.WithHTTPStatus(op.status))
+		if op.contentType != "" {
+			opts = append(opts, openapi.WithContentType(op.contentType))
+		}
+		oc.AddRespStructure(op.response, opts...)
+
+		errors := op.errors
+		if op.upstream {
+			errors = append(errors[:len(errors):len(errors)],
+				http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+		}
+		for _, status := range errors {
+			oc.AddRespStructure(nil, openapi.WithHTTPStatus(status), openapi.WithContentType("text/plain"))
+		}
+
+		if err := r.AddOperation(oc); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", op.method, op.path, err)
+		}
+	}
+
+	doc, err := r.Spec.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenAPI document: %w", err)
+	}
+	return doc, nil
+}
+
+// Routes lists the method and path of every documented route, for checking
+// the document against the router.
+func Routes() []string {
+	routes := make([]string, 0, len(operations))
+	for _, op := range operations {
+		routes = append(routes, op.method+" "+op.path)
+	}
+	return routes
+}
+
+// Handler serves doc, the output of Document.
+func Handler(doc []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(doc)
+	}
+}
+
+// DocsHandler serves Swagger UI for the document at docPath. The UI assets
+// are embedded in the binary; basePath is where the handler is mounted.
+func DocsHandler(basePath, docPath string) http.Handler {
+	return v5emb.New("checklist-go API", docPath, basePath)
+}
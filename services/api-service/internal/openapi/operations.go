@@ -0,0 +1,265 @@
+package openapi
+
+import (
+	"mime/multipart"
+	"net/http"
+
+	"checklist-go/services/api-service/internal/api"
+)
+
+// Parameters of the routes, described by struct tags.
+type (
+	taskPath struct {
+		ID string `path:"id" description:"Task ID." format:"uuid"`
+	}
+	checklistPath struct {
+		ID string `path:"id" description:"Checklist ID." format:"uuid"`
+	}
+	sharePath struct {
+		ID string `path:"id" description:"Share link ID." format:"uuid"`
+	}
+	recurrencePath struct {
+		ID string `path:"id" description:"Recurrence ID." format:"uuid"`
+	}
+	templatePath struct {
+		ID string `path:"id" description:"Template ID." format:"uuid"`
+	}
+	viewPath struct {
+		ID string `path:"id" description:"Saved view ID." format:"uuid"`
+	}
+	attachmentPath struct {
+		ID string `path:"id" description:"Attachment ID." format:"uuid"`
+	}
+	dependencyPath struct {
+		ID          string `path:"id" description:"Task ID." format:"uuid"`
+		DependsOnID string `path:"dependsOnID" description:"ID of the prerequisite task." format:"uuid"`
+	}
+	memberPath struct {
+		ID     string `path:"id" description:"Checklist ID." format:"uuid"`
+		UserID string `path:"userID" description:"User ID of the member."`
+	}
+	assigneePath struct {
+		ID     string `path:"id" description:"Task ID." format:"uuid"`
+		UserID string `path:"userID" description:"User ID of the assignee."`
+	}
+	caller struct {
+		UserID string `header:"X-User-ID" required:"true" description:"ID of the calling user."`
+	}
+	commentPath struct {
+		ID        string `path:"id" description:"Task ID." format:"uuid"`
+		CommentID string `path:"commentID" description:"Comment ID." format:"uuid"`
+		UserID    string `header:"X-User-ID" required:"true" description:"ID of the calling user; only the author may change a comment."`
+	}
+	addComment struct {
+		ID     string `path:"id" description:"Task ID." format:"uuid"`
+		UserID string `header:"X-User-ID" required:"true" description:"ID of the calling user, who becomes the author."`
+	}
+	listTasksQuery struct {
+		ChecklistID string `query:"checklist_id" description:"Only tasks of this checklist." format:"uuid"`
+		AssigneeID  string `query:"assignee_id" description:"Only tasks assigned to this user."`
+		Filter      string `query:"filter" description:"Filter expression, for example: tag:backend AND NOT done AND due<7d."`
+	}
+	listComments struct {
+		ID        string `path:"id" description:"Task ID." format:"uuid"`
+		PageSize  int32  `query:"page_size" description:"Maximum number of comments to return."`
+		PageToken string `query:"page_token" description:"next_page_token of the previous page."`
+	}
+	uploadAttachment struct {
+		ID         string                `path:"id" description:"Task ID." format:"uuid"`
+		UserID     string                `header:"X-User-ID" description:"ID of the uploading user."`
+		Checksum   string                `header:"X-Checksum-SHA256" description:"Hex SHA-256 of the file; the upload fails with 422 on a mismatch."`
+		ChecksumIn string                `formData:"sha256" description:"Hex SHA-256 of the file, as an alternative to the header. Must precede the file part."`
+		File       *multipart.FileHeader `formData:"file" required:"true" description:"The file."`
+	}
+	downloadAttachment struct {
+		ID          string `path:"id" description:"Attachment ID." format:"uuid"`
+		IfNoneMatch string `header:"If-None-Match" description:"ETag of a cached copy."`
+	}
+	searchQuery struct {
+		Q           string `query:"q" required:"true" description:"Search query: words, \"phrases\", OR and -negation."`
+		ChecklistID string `query:"checklist_id" description:"Only tasks of this checklist." format:"uuid"`
+		Limit       int32  `query:"limit" description:"Maximum number of results."`
+	}
+	statsQuery struct {
+		From        string `query:"from" description:"Start of the range: RFC 3339 timestamp or YYYY-MM-DD in tz. Defaults to 30 days before to."`
+		To          string `query:"to" description:"End of the range: RFC 3339 timestamp or YYYY-MM-DD in tz, which includes the whole day. Defaults to now."`
+		ChecklistID string `query:"checklist_id" description:"Only tasks of this checklist." format:"uuid"`
+		GroupBy     string `query:"group_by" enum:"day,week,month" description:"Bucket size of the series. Defaults to day."`
+		Timezone    string `query:"tz" description:"IANA time zone for dates and buckets. Defaults to UTC."`
+	}
+	sharedChecklist struct {
+		Token    string `path:"token" description:"Token of the share link."`
+		Password string `header:"X-Share-Password" description:"Password of a protected link."`
+		Format   string `query:"format" enum:"json,html" description:"Response format; by default HTML is served when Accept contains text/html."`
+	}
+)
+
+// rawBody is a response body that is not JSON: Prometheus metrics or a file.
+type rawBody string
+
+var operations = []operation{
+	{method: http.MethodGet, path: "/healthz", tag: "Health", summary: "Liveness probe",
+		status: http.StatusOK, response: new(api.HealthResponse)},
+	{method: http.MethodGet, path: "/readyz", tag: "Health", summary: "Readiness probe; 503 with the failing checks while the db-service is unavailable or the service drains",
+		status: http.StatusOK, response: new(api.HealthResponse)},
+	{method: http.MethodGet, path: "/metrics", tag: "Health", summary: "Prometheus metrics",
+		status: http.StatusOK, response: new(rawBody), contentType: "text/plain"},
+	{method: http.MethodGet, path: "/openapi.json", tag: "Health", summary: "This document",
+		status: http.StatusOK, response: new(map[string]any)},
+
+	{method: http.MethodPost, path: "/create", tag: "Tasks", summary: "Create a task",
+		body: new(api.CreateTaskRequest), status: http.StatusCreated, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/list", tag: "Tasks", summary: "List tasks, newest first; null when there are none",
+		params: new(listTasksQuery), status: http.StatusOK, response: new([]*api.TaskResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodDelete, path: "/delete", tag: "Tasks", summary: "Delete a task",
+		body: new(api.TaskActionRequest), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPut, path: "/done", tag: "Tasks", summary: "Complete a task",
+		body: new(api.TaskActionRequest), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/checklists", tag: "Checklists", summary: "Create a checklist",
+		body: new(api.CreateChecklistRequest), status: http.StatusCreated, response: new(api.ChecklistResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists", tag: "Checklists", summary: "List checklists, newest first",
+		status: http.StatusOK, response: new([]*api.ChecklistResponse), upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists/{id}", tag: "Checklists", summary: "Get a checklist",
+		params: new(checklistPath), status: http.StatusOK, response: new(api.ChecklistResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/checklists/{id}/shares", tag: "Sharing", summary: "Create a read-only share link; the token is only returned here",
+		params: new(checklistPath), body: new(api.CreateShareLinkRequest), status: http.StatusCreated, response: new(api.ShareLinkResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists/{id}/shares", tag: "Sharing", summary: "List the share links of a checklist",
+		params: new(checklistPath), status: http.StatusOK, response: new([]*api.ShareLinkResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/shares/{id}", tag: "Sharing", summary: "Revoke a share link",
+		params: new(sharePath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/share/{token}", tag: "Sharing", summary: "View a shared checklist as JSON or HTML",
+		params: new(sharedChecklist), status: http.StatusOK, response: new(api.SharedChecklistResponse),
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPost, path: "/share/{token}", tag: "Sharing", summary: "View a shared checklist, with the password from the HTML form",
+		params: new(sharedChecklist), status: http.StatusOK, response: new(api.SharedChecklistResponse),
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPut, path: "/v1/tasks/{id}/recurrence", tag: "Recurrences", summary: "Repeat a task by an RFC 5545 rule",
+		params: new(taskPath), body: new(api.SetRecurrenceRequest), status: http.StatusOK, response: new(api.RecurrenceResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPut, path: "/v1/checklists/{id}/recurrence", tag: "Recurrences", summary: "Repeat a checklist by an RFC 5545 rule",
+		params: new(checklistPath), body: new(api.SetRecurrenceRequest), status: http.StatusOK, response: new(api.RecurrenceResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/recurrences", tag: "Recurrences", summary: "List recurrences",
+		status: http.StatusOK, response: new([]*api.RecurrenceResponse), upstream: true},
+	{method: http.MethodDelete, path: "/v1/recurrences/{id}", tag: "Recurrences", summary: "Stop a recurrence",
+		params: new(recurrencePath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/templates", tag: "Templates", summary: "Save a checklist as a template",
+		body: new(api.CreateTemplateRequest), status: http.StatusCreated, response: new(api.TemplateResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/templates", tag: "Templates", summary: "List templates",
+		status: http.StatusOK, response: new([]*api.TemplateResponse), upstream: true},
+	{method: http.MethodGet, path: "/v1/templates/{id}", tag: "Templates", summary: "Get a template",
+		params: new(templatePath), status: http.StatusOK, response: new(api.TemplateResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPost, path: "/v1/templates/{id}/instantiate", tag: "Templates", summary: "Create a checklist from a template",
+		params: new(templatePath), body: new(api.InstantiateTemplateRequest), status: http.StatusCreated, response: new(api.ChecklistResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/tasks/{id}/dependencies", tag: "Dependencies", summary: "Make a task depend on another; 409 if that would create a cycle",
+		params: new(taskPath), body: new(api.AddDependencyRequest), status: http.StatusOK, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/tasks/{id}/dependencies/{dependsOnID}", tag: "Dependencies", summary: "Remove a dependency",
+		params: new(dependencyPath), status: http.StatusOK, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPut, path: "/v1/checklists/{id}/workflow", tag: "Workflows", summary: "Replace the workflow of a checklist; no states restores the default",
+		params: new(checklistPath), body: new(api.SetWorkflowRequest), status: http.StatusOK, response: new(api.WorkflowResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists/{id}/workflow", tag: "Workflows", summary: "Get the workflow of a checklist",
+		params: new(checklistPath), status: http.StatusOK, response: new(api.WorkflowResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists/{id}/board", tag: "Workflows", summary: "Get the tasks of a checklist grouped by workflow state",
+		params: new(checklistPath), status: http.StatusOK, response: new(api.BoardResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPost, path: "/v1/tasks/{id}/transition", tag: "Workflows", summary: "Move a task to another workflow state",
+		params: new(taskPath), body: new(api.TransitionTaskRequest), status: http.StatusOK, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/checklists/{id}/members", tag: "Members", summary: "Add a member to a checklist",
+		params: new(checklistPath), body: new(api.ChecklistMemberRequest), status: http.StatusCreated, response: new(api.ChecklistMemberResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/checklists/{id}/members", tag: "Members", summary: "List the members of a checklist",
+		params: new(checklistPath), status: http.StatusOK, response: new([]*api.ChecklistMemberResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/checklists/{id}/members/{userID}", tag: "Members", summary: "Remove a member and their assignments",
+		params: new(memberPath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPost, path: "/v1/tasks/{id}/assignees", tag: "Members", summary: "Assign a task to a member of its checklist",
+		params: new(taskPath), body: new(api.AssignTaskRequest), status: http.StatusOK, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/tasks/{id}/assignees/{userID}", tag: "Members", summary: "Unassign a task",
+		params: new(assigneePath), status: http.StatusOK, response: new(api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/me/tasks", tag: "Members", summary: "List the open tasks assigned to the caller, soonest due first",
+		params: new(caller), status: http.StatusOK, response: new([]*api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/tasks/{id}/comments", tag: "Comments", summary: "Comment on a task",
+		params: new(addComment), body: new(api.CommentRequest), status: http.StatusCreated, response: new(api.CommentResponse),
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/tasks/{id}/comments", tag: "Comments", summary: "List the comments of a task, oldest first",
+		params: new(listComments), status: http.StatusOK, response: new(api.CommentListResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPatch, path: "/v1/tasks/{id}/comments/{commentID}", tag: "Comments", summary: "Edit a comment",
+		params: new(commentPath), body: new(api.CommentRequest), status: http.StatusOK, response: new(api.CommentResponse),
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/tasks/{id}/comments/{commentID}", tag: "Comments", summary: "Delete a comment",
+		params: new(commentPath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/tasks/{id}/attachments", tag: "Attachments", summary: "Upload a file to a task",
+		params: new(uploadAttachment), status: http.StatusCreated, response: new(api.AttachmentResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity}, upstream: true},
+	{method: http.MethodGet, path: "/v1/tasks/{id}/attachments", tag: "Attachments", summary: "List the attachments of a task",
+		params: new(taskPath), status: http.StatusOK, response: new([]*api.AttachmentResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodGet, path: "/v1/attachments/{id}", tag: "Attachments", summary: "Get attachment metadata",
+		params: new(attachmentPath), status: http.StatusOK, response: new(api.AttachmentResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/attachments/{id}/content", tag: "Attachments", summary: "Download an attachment; 304 if If-None-Match matches its ETag",
+		params: new(downloadAttachment), status: http.StatusOK, response: new(rawBody), contentType: "application/octet-stream",
+		errors: []int{http.StatusNotModified, http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/attachments/{id}", tag: "Attachments", summary: "Delete an attachment and its file",
+		params: new(attachmentPath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodGet, path: "/v1/search", tag: "Search", summary: "Full-text search over tasks and their comments",
+		params: new(searchQuery), status: http.StatusOK, response: new([]*api.SearchResultResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+
+	{method: http.MethodPost, path: "/v1/views", tag: "Views", summary: "Save a filter expression as a view",
+		body: new(api.SavedViewRequest), status: http.StatusCreated, response: new(api.SavedViewResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+	{method: http.MethodGet, path: "/v1/views", tag: "Views", summary: "List saved views by name",
+		status: http.StatusOK, response: new([]*api.SavedViewResponse), upstream: true},
+	{method: http.MethodGet, path: "/v1/views/{id}", tag: "Views", summary: "Get a saved view",
+		params: new(viewPath), status: http.StatusOK, response: new(api.SavedViewResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodPut, path: "/v1/views/{id}", tag: "Views", summary: "Replace a saved view",
+		params: new(viewPath), body: new(api.SavedViewRequest), status: http.StatusOK, response: new(api.SavedViewResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodDelete, path: "/v1/views/{id}", tag: "Views", summary: "Delete a saved view",
+		params: new(viewPath), status: http.StatusNoContent,
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+	{method: http.MethodGet, path: "/v1/views/{id}/tasks", tag: "Views", summary: "List the tasks matching a saved view",
+		params: new(viewPath), status: http.StatusOK, response: new([]*api.TaskResponse),
+		errors: []int{http.StatusBadRequest, http.StatusNotFound}, upstream: true},
+
+	{method: http.MethodGet, path: "/v1/stats", tag: "Stats", summary: "Completion statistics per checklist and over time",
+		params: new(statsQuery), status: http.StatusOK, response: new(api.StatsResponse),
+		errors: []int{http.StatusBadRequest}, upstream: true},
+}
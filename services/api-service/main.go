@@ -1,16 +1,36 @@
 package main
 
 import (
+	"checklist-go/internal/config"
 	"checklist-go/services/api-service/internal/app"
-	"log"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	// Timezone database for the alpine runtime image.
+	_ "time/tzdata"
 )
 
 
 
 func main() {
-	a, err := app.New()
+	cfg, err := config.LoadAPI(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatalf("failed to create app: %v", err)
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+
+	a, err := app.New(cfg)
+	if err != nil {
+		slog.Error("Failed to create app", "error", err)
+		os.Exit(1)
+	}
+	if err := a.Run(); err != nil {
+		slog.Error("api-service stopped with errors", "error", err)
+		os.Exit(1)
 	}
-	a.Run()
-}
\ No newline at end of file
+	slog.Info("api-service stopped")
+}
@@ -1,57 +1,240 @@
 package app
 
 import (
+	"checklist-go/internal/config"
+	"checklist-go/internal/lifecycle"
+	basetrace "checklist-go/internal/tracing"
+	"checklist-go/services/db-service/internal/health"
+	"checklist-go/services/db-service/internal/logging"
+	"checklist-go/services/db-service/internal/metrics"
+	"checklist-go/services/db-service/internal/migrate"
+	"checklist-go/services/db-service/internal/recurrence"
 	"checklist-go/services/db-service/internal/server"
 	"checklist-go/services/db-service/internal/storage"
+	"checklist-go/services/db-service/internal/tracing"
+	"checklist-go/services/db-service/internal/web"
+	"checklist-go/services/db-service/migrations"
+	sqlitemigrations "checklist-go/services/db-service/migrations/sqlite"
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
-	"os"
+	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/multitracer"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
 	pb "checklist-go/proto"
 )
 
 type App struct {
+	cfg *config.DB
 	grpcServer *grpc.Server
-	storage *storage.Storage
+	storage storage.TaskRepository
+	scheduler *recurrence.Scheduler
+	healthChecker *health.Checker
+	metricsServer *http.Server
+	webServer *http.Server
+	shutdownTracing func(context.Context) error
 }
 
-func New() (*App, error) {
-	dbDSN := os.Getenv("DB_DSN")
-	if dbDSN == "" {
-		return nil, fmt.Errorf("DB_DSN environment variable is not set")
+func New(cfg *config.DB) (*App, error) {
+	logging.Setup("db-service", cfg.LogLevel)
+
+	shutdownTracing, err := basetrace.Setup(context.Background(), "db-service")
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tracing: %w", err)
+	}
+
+	if cfg.MigrateOnStart && storage.Backend(cfg.DSN) != "memory" {
+		if err := migrateUp(cfg.DSN); err != nil {
+			return nil, err
+		}
 	}
 
-	st, err := storage.NewStorage(dbDSN)
+	st, err := storage.NewStorage(storage.Config{
+		DSN:             cfg.DSN,
+		MaxConns:        cfg.MaxConns,
+		MinConns:        cfg.MinConns,
+		MaxConnLifetime: cfg.MaxConnLifetime,
+		MaxConnIdleTime: cfg.MaxConnIdleTime,
+		Tracer:          multitracer.New(metrics.QueryTracer{}, tracing.QueryTracer{}),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize storage: %w", err)
 	}
 
-	grpcSrv := grpc.NewServer()
-	checkListServer := server.NewGRPCServer(st)
+	if pg, ok := st.(*storage.Storage); ok {
+		metrics.RegisterPool(pg.PoolStat)
+	}
+	metrics.RegisterTasks(st)
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+
+	interceptors := []grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor, metrics.UnaryServerInterceptor}
+	grpcSrv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
+	checkListServer := server.NewGRPCServer(st, server.Options{
+		EnforceDependencies: cfg.EnforceDependencies,
+	})
+	commentServer := server.NewCommentServer(st)
 	pb.RegisterChecklistServiceServer(grpcSrv, checkListServer)
+	pb.RegisterCommentServiceServer(grpcSrv, commentServer)
+
+	var webServer *http.Server
+	if cfg.WebAddr != "" {
+		// gRPC clients may use this port as well; they need HTTP/2, which
+		// runs without TLS as on the gRPC port.
+		var protocols http.Protocols
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		webServer = &http.Server{
+			Addr:              cfg.WebAddr,
+			Handler:           web.NewHandler(checkListServer, commentServer, cfg.CORSOrigins(), interceptors...),
+			Protocols:         &protocols,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
+
+	healthChecker := health.NewChecker(st, cfg.HealthCheckInterval,
+		pb.ChecklistService_ServiceDesc.ServiceName,
+		pb.CommentService_ServiceDesc.ServiceName,
+	)
+	healthChecker.Register(grpcSrv)
 
 	return &App{
+		cfg: cfg,
 		grpcServer: grpcSrv,
 		storage: st,
+		scheduler: recurrence.NewScheduler(st, cfg.SchedulerInterval),
+		healthChecker: healthChecker,
+		metricsServer: &http.Server{Addr: cfg.MetricsAddr, Handler: metricsMux},
+		webServer: webServer,
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
-func (a *App) Run() {
-	port := os.Getenv("GRPC_PORT")
-	if port == "" {
-		port = "50051"
+// OpenMigrator connects a Migrator with the embedded migrations of the
+// backend dsn selects. The memory backend has no schema to migrate.
+func OpenMigrator(ctx context.Context, dsn string) (*migrate.Migrator, error) {
+	switch backend := storage.Backend(dsn); backend {
+	case "postgres":
+		return migrate.Connect(ctx, dsn, migrations.FS)
+	case "sqlite":
+		db, err := storage.OpenSQLite(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return migrate.ConnectSQLite(ctx, db, sqlitemigrations.FS)
+	default:
+		return nil, fmt.Errorf("the %s backend has no schema to migrate", backend)
+	}
+}
+
+// migrateUp applies the embedded migrations. Replicas starting together wait
+// for each other on the migration lock.
+func migrateUp(dsn string) error {
+	ctx := context.Background()
+	m, err := OpenMigrator(ctx, dsn)
+	if err != nil {
+		return err
 	}
+	defer m.Close(ctx)
 
-	lis, err := net.Listen("tcp", ":"+port)
+	applied, err := m.Up(ctx)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		return fmt.Errorf("failed to migrate database: %w", err)
 	}
+	slog.Info("Database schema is up to date", "applied", applied)
+	return nil
+}
 
-	log.Printf("gRPC server is listening on port %s", port)
-	if err := a.grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve gRPC: %v", err)
+// Run serves until SIGINT or SIGTERM and then tears the service down in
+// order: report NOT_SERVING, stop accepting calls and let in-flight RPCs
+// finish, stop the background workers, stop the metrics server, close the
+// connection pool and flush traces.
+func (a *App) Run() error {
+	lis, err := net.Listen("tcp", a.cfg.GRPCAddr())
+	if err != nil {
+		a.storage.Close()
+		return fmt.Errorf("failed to listen on %s: %w", a.cfg.GRPCAddr(), err)
 	}
-}
\ No newline at end of file
+
+	r := lifecycle.New(a.cfg.ShutdownTimeout)
+
+	r.Serve("gRPC server", func() error {
+		slog.Info("gRPC server is listening", "addr", lis.Addr().String())
+		return a.grpcServer.Serve(lis)
+	})
+	r.Serve("metrics server", func() error {
+		slog.Info("Metrics server is listening", "addr", a.metricsServer.Addr)
+		return a.metricsServer.ListenAndServe()
+	})
+	if a.webServer != nil {
+		r.Serve("web server", func() error {
+			slog.Info("Connect and gRPC-Web server is listening", "addr", a.webServer.Addr)
+			return a.webServer.ListenAndServe()
+		})
+	}
+	stopScheduler := r.Background("recurrence scheduler", a.scheduler.Run)
+	stopHealthChecker := r.Background("health checker", a.healthChecker.Run)
+
+	// The steps run in the reverse of this order.
+	r.OnShutdown("tracing", a.shutdownTracing)
+	r.OnShutdown("storage", func(context.Context) error {
+		a.storage.Close()
+		return nil
+	})
+	r.OnShutdown("metrics server", a.metricsServer.Shutdown)
+	r.OnShutdown("health checker", stopHealthChecker)
+	r.OnShutdown("recurrence scheduler", stopScheduler)
+	if a.webServer != nil {
+		r.OnShutdown("web server", a.stopWeb)
+	}
+	r.OnShutdown("gRPC server", a.stopGRPC)
+	r.OnShutdown("report NOT_SERVING", func(context.Context) error {
+		a.healthChecker.Shutdown()
+		return nil
+	})
+
+	return r.Run(context.Background())
+}
+
+// stopWeb stops accepting Connect and gRPC-Web calls and, like stopGRPC,
+// waits up to the drain timeout for in-flight ones.
+func (a *App) stopWeb(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, a.cfg.DrainTimeout)
+	defer cancel()
+	if err := a.webServer.Shutdown(ctx); err != nil {
+		a.webServer.Close()
+		return fmt.Errorf("in-flight web calls cancelled: %w", err)
+	}
+	return nil
+}
+
+// stopGRPC stops accepting calls and waits up to the drain timeout for
+// in-flight RPCs; after that the remaining ones are cancelled.
+func (a *App) stopGRPC(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, a.cfg.DrainTimeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		a.grpcServer.Stop()
+		<-done
+		return fmt.Errorf("in-flight RPCs cancelled: %w", ctx.Err())
+	}
+}
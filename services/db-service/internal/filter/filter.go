@@ -0,0 +1,447 @@
+// Package filter parses the task filter language used by saved views and the
+// filter= parameter of the task list, for example
+//
+//	tag:backend AND NOT done AND due<7d
+//
+// An expression is built from predicates combined with AND, OR, NOT and
+// parentheses; AND may be omitted between predicates, and NOT binds tighter
+// than AND, which binds tighter than OR. Keywords are case-insensitive.
+//
+// Predicates:
+//
+//	done, blocked                  the flag is set (also done:true, done:false)
+//	tag:<tag>                      the task has the tag
+//	status:<key>                   the task is in the workflow state
+//	assignee:<user>                the task is assigned to the user
+//	checklist:<uuid>               the task belongs to the checklist
+//	title:<text>, description:<text>
+//	                               case-insensitive substring match
+//	text:<words>                   full-text match over title and description
+//	due, created, updated, completed
+//	                               compared with <, <=, >, >= or = to a time;
+//	                               :none and :any test whether the time is set
+//
+// Times are relative to now (7d, -12h, 2w; units h, d, w), dates
+// (2025-03-01, which = and : treat as the whole day) or RFC 3339 timestamps.
+// Values containing spaces or parentheses are written in double quotes.
+//
+// The parser only builds an Expr tree; storage translates it into
+// parameterized SQL, so values never end up in the query text.
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLength bounds the size of an expression.
+	MaxLength = 2000
+	// maxDepth bounds the nesting of parentheses and NOTs.
+	maxDepth = 32
+)
+
+// Expr is a node of a parsed filter expression: And, Or, Not, Flag, Match,
+// TimeCompare or TimeSet.
+type Expr interface {
+	isExpr()
+}
+
+// And matches tasks matched by both sides.
+type And struct{ Left, Right Expr }
+
+// Or matches tasks matched by either side.
+type Or struct{ Left, Right Expr }
+
+// Not matches tasks not matched by X.
+type Not struct{ X Expr }
+
+// Flag tests a boolean field: "done" or "blocked".
+type Flag struct {
+	Field string
+	Value bool
+}
+
+// Match tests a text field: "tag", "status", "assignee", "checklist",
+// "title", "description" or "text".
+type Match struct {
+	Field string
+	Value string
+}
+
+// TimeCompare compares a time field ("due", "created", "updated" or
+// "completed") with a point in time. Op is one of <, <=, >, >= and =.
+// Tasks whose field is not set never match.
+type TimeCompare struct {
+	Field string
+	Op    string
+	Value TimeValue
+}
+
+// TimeSet tests whether a time field is set (due:any) or not (due:none).
+type TimeSet struct {
+	Field string
+	Set   bool
+}
+
+// TimeValue is an absolute time, a date or an offset from now.
+type TimeValue struct {
+	// Offset is added to now when Relative is set.
+	Offset   time.Duration
+	Relative bool
+	// At is the absolute time, or the start of the day (UTC) when Day is set.
+	At  time.Time
+	Day bool
+}
+
+// Resolve returns the point in time the value refers to.
+func (v TimeValue) Resolve(now time.Time) time.Time {
+	if v.Relative {
+		return now.Add(v.Offset)
+	}
+	return v.At
+}
+
+func (And) isExpr()         {}
+func (Or) isExpr()          {}
+func (Not) isExpr()         {}
+func (Flag) isExpr()        {}
+func (Match) isExpr()       {}
+func (TimeCompare) isExpr() {}
+func (TimeSet) isExpr()     {}
+
+var (
+	flagFields  = []string{"done", "blocked"}
+	matchFields = []string{"tag", "status", "assignee", "checklist", "title", "description", "text"}
+	timeFields  = []string{"due", "created", "updated", "completed"}
+)
+
+// SyntaxError describes why an expression could not be parsed.
+type SyntaxError struct {
+	// Pos is the byte offset in the input where the problem was found.
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: %s at position %d", e.Msg, e.Pos)
+}
+
+// Parse parses a filter expression.
+func Parse(input string) (Expr, error) {
+	if len(input) > MaxLength {
+		return nil, &SyntaxError{Pos: MaxLength, Msg: fmt.Sprintf("expression is longer than %d bytes", MaxLength)}
+	}
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, end: len(input)}
+	if len(tokens) == 0 {
+		return nil, &SyntaxError{Pos: 0, Msg: "empty expression"}
+	}
+	expr, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := p.peek(); ok {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokLParen tokenKind = iota
+	tokRParen
+	tokAnd
+	tokOr
+	tokNot
+	tokPredicate
+)
+
+type token struct {
+	kind tokenKind
+	pos  int
+	text string
+	// field, op and value are set for predicates; op is empty for a bare
+	// field such as "done".
+	field, op, value string
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, pos: i, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, pos: i, text: ")"})
+			i++
+		case isFieldByte(c):
+			start := i
+			for i < len(input) && isFieldByte(input[i]) {
+				i++
+			}
+			word := input[start:i]
+
+			op := ""
+			for _, candidate := range []string{"<=", ">=", ":", "<", ">", "="} {
+				if strings.HasPrefix(input[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				t := token{kind: tokPredicate, pos: start, text: word, field: strings.ToLower(word)}
+				switch strings.ToUpper(word) {
+				case "AND":
+					t.kind = tokAnd
+				case "OR":
+					t.kind = tokOr
+				case "NOT":
+					t.kind = tokNot
+				}
+				tokens = append(tokens, t)
+				continue
+			}
+			i += len(op)
+
+			value, n, err := lexValue(input, i)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+			tokens = append(tokens, token{
+				kind:  tokPredicate,
+				pos:   start,
+				text:  input[start:i],
+				field: strings.ToLower(word),
+				op:    op,
+				value: value,
+			})
+		default:
+			return nil, &SyntaxError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return tokens, nil
+}
+
+// lexValue reads a bare or double-quoted value starting at i and returns it
+// with the number of bytes consumed.
+func lexValue(input string, i int) (string, int, error) {
+	if i < len(input) && input[i] == '"' {
+		var b strings.Builder
+		for j := i + 1; j < len(input); j++ {
+			switch input[j] {
+			case '\\':
+				if j+1 < len(input) {
+					j++
+					b.WriteByte(input[j])
+				}
+			case '"':
+				return b.String(), j + 1 - i, nil
+			default:
+				b.WriteByte(input[j])
+			}
+		}
+		return "", 0, &SyntaxError{Pos: i, Msg: "unterminated quoted value"}
+	}
+
+	j := i
+	for j < len(input) && !strings.ContainsRune(" \t\r\n()", rune(input[j])) {
+		j++
+	}
+	if j == i {
+		return "", 0, &SyntaxError{Pos: i, Msg: "missing value"}
+	}
+	return input[i:j], j - i, nil
+}
+
+func isFieldByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+type parser struct {
+	tokens []token
+	i      int
+	end    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.i >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.i], true
+}
+
+func (p *parser) parseOr(depth int) (Expr, error) {
+	left, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || t.kind != tokOr {
+			return left, nil
+		}
+		p.i++
+		right, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseAnd(depth int) (Expr, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || t.kind == tokOr || t.kind == tokRParen {
+			return left, nil
+		}
+		if t.kind == tokAnd {
+			p.i++
+		}
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseUnary(depth int) (Expr, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, &SyntaxError{Pos: p.end, Msg: "unexpected end of expression"}
+	}
+	if depth >= maxDepth {
+		return nil, &SyntaxError{Pos: t.pos, Msg: "expression is nested too deeply"}
+	}
+
+	switch t.kind {
+	case tokNot:
+		p.i++
+		x, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return Not{X: x}, nil
+	case tokLParen:
+		p.i++
+		x, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing.kind != tokRParen {
+			return nil, &SyntaxError{Pos: t.pos, Msg: "unclosed parenthesis"}
+		}
+		p.i++
+		return x, nil
+	case tokPredicate:
+		p.i++
+		return parsePredicate(t)
+	default:
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+}
+
+func parsePredicate(t token) (Expr, error) {
+	errorf := func(format string, args ...any) error {
+		return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	switch {
+	case slices.Contains(flagFields, t.field):
+		switch t.op {
+		case "":
+			return Flag{Field: t.field, Value: true}, nil
+		case ":", "=":
+			value, err := strconv.ParseBool(t.value)
+			if err != nil {
+				return nil, errorf("%s expects true or false", t.field)
+			}
+			return Flag{Field: t.field, Value: value}, nil
+		}
+		return nil, errorf("%s cannot be compared with %s", t.field, t.op)
+
+	case slices.Contains(matchFields, t.field):
+		if t.op != ":" && t.op != "=" {
+			return nil, errorf("%s expects %s:<value>", t.field, t.field)
+		}
+		if strings.TrimSpace(t.value) == "" {
+			return nil, errorf("%s expects a value", t.field)
+		}
+		if t.field == "checklist" {
+			if _, err := uuid.Parse(t.value); err != nil {
+				return nil, errorf("checklist expects a checklist ID")
+			}
+		}
+		return Match{Field: t.field, Value: t.value}, nil
+
+	case slices.Contains(timeFields, t.field):
+		if t.op == "" {
+			return nil, errorf("%s must be compared with a time, e.g. %s<7d", t.field, t.field)
+		}
+		if t.op == ":" {
+			switch strings.ToLower(t.value) {
+			case "none":
+				return TimeSet{Field: t.field, Set: false}, nil
+			case "any":
+				return TimeSet{Field: t.field, Set: true}, nil
+			}
+		}
+		value, err := parseTime(t.value)
+		if err != nil {
+			return nil, errorf("%s: %v", t.field, err)
+		}
+		op := t.op
+		if op == ":" {
+			op = "="
+		}
+		if op == "=" && !value.Day {
+			return nil, errorf("%s can only be matched exactly against a date, use < or > with other times", t.field)
+		}
+		return TimeCompare{Field: t.field, Op: op, Value: value}, nil
+	}
+
+	if t.op == "" {
+		return nil, errorf("unknown flag %q", t.text)
+	}
+	return nil, errorf("unknown field %q", t.field)
+}
+
+func parseTime(s string) (TimeValue, error) {
+	if n := len(s); n >= 2 {
+		unit := map[byte]time.Duration{'h': time.Hour, 'd': 24 * time.Hour, 'w': 7 * 24 * time.Hour}[s[n-1]]
+		if count, err := strconv.Atoi(s[:n-1]); err == nil && unit != 0 {
+			if count > 100000 || count < -100000 {
+				return TimeValue{}, fmt.Errorf("offset %q is out of range", s)
+			}
+			return TimeValue{Offset: time.Duration(count) * unit, Relative: true}, nil
+		}
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return TimeValue{At: t, Day: true}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return TimeValue{At: t}, nil
+	}
+	return TimeValue{}, fmt.Errorf("%q is not a time; use an offset like 7d, a date or an RFC 3339 timestamp", s)
+}
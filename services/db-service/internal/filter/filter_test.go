@@ -0,0 +1,134 @@
+package filter_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"checklist-go/services/db-service/internal/filter"
+)
+
+func tag(v string) filter.Expr { return filter.Match{Field: "tag", Value: v} }
+
+var (
+	done    = filter.Flag{Field: "done", Value: true}
+	blocked = filter.Flag{Field: "blocked", Value: true}
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  filter.Expr
+	}{
+		{"done", done},
+		{"DONE:false", filter.Flag{Field: "done", Value: false}},
+		{"blocked=true", blocked},
+
+		// NOT binds tighter than AND, which binds tighter than OR; AND may
+		// be left out and both associate to the left.
+		{"tag:a tag:b OR tag:c", filter.Or{Left: filter.And{Left: tag("a"), Right: tag("b")}, Right: tag("c")}},
+		{"tag:a OR tag:b AND tag:c", filter.Or{Left: tag("a"), Right: filter.And{Left: tag("b"), Right: tag("c")}}},
+		{"tag:a and tag:b and tag:c", filter.And{Left: filter.And{Left: tag("a"), Right: tag("b")}, Right: tag("c")}},
+		{"tag:a or tag:b or tag:c", filter.Or{Left: filter.Or{Left: tag("a"), Right: tag("b")}, Right: tag("c")}},
+		{"NOT done AND blocked", filter.And{Left: filter.Not{X: done}, Right: blocked}},
+		{"not done or blocked", filter.Or{Left: filter.Not{X: done}, Right: blocked}},
+		{"NOT (done OR blocked)", filter.Not{X: filter.Or{Left: done, Right: blocked}}},
+		{"NOT NOT done", filter.Not{X: filter.Not{X: done}}},
+		{"(tag:a OR tag:b) tag:c", filter.And{Left: filter.Or{Left: tag("a"), Right: tag("b")}, Right: tag("c")}},
+		{"((done))", done},
+
+		// Quoting.
+		{`title:"two words"`, filter.Match{Field: "title", Value: "two words"}},
+		{`title:"a (b) OR c"`, filter.Match{Field: "title", Value: "a (b) OR c"}},
+		{`title:"say \"hi\" \\o/"`, filter.Match{Field: "title", Value: `say "hi" \o/`}},
+		{`tag:"x"done`, filter.And{Left: tag("x"), Right: done}},
+		{"tag:a(done)", filter.And{Left: tag("a"), Right: done}},
+		{`description:it's`, filter.Match{Field: "description", Value: "it's"}},
+		{"checklist:1B4E28BA-2FA1-11D2-883F-0016D3CCA427", filter.Match{Field: "checklist", Value: "1B4E28BA-2FA1-11D2-883F-0016D3CCA427"}},
+
+		// Times.
+		{"due<7d", filter.TimeCompare{Field: "due", Op: "<", Value: filter.TimeValue{Offset: 7 * 24 * time.Hour, Relative: true}}},
+		{"updated>=-12h", filter.TimeCompare{Field: "updated", Op: ">=", Value: filter.TimeValue{Offset: -12 * time.Hour, Relative: true}}},
+		{"due:2025-03-01", filter.TimeCompare{Field: "due", Op: "=", Value: filter.TimeValue{At: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Day: true}}},
+		{"created>2025-03-01T10:00:00Z", filter.TimeCompare{Field: "created", Op: ">", Value: filter.TimeValue{At: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)}}},
+		{"completed:none", filter.TimeSet{Field: "completed", Set: false}},
+		{"due:ANY", filter.TimeSet{Field: "due", Set: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := filter.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{"", 0, "empty expression"},
+		{"   ", 0, "empty expression"},
+		{"done AND", 8, "unexpected end of expression"},
+		{"done OR ", 8, "unexpected end of expression"},
+		{"NOT", 3, "unexpected end of expression"},
+		{"(done", 0, "unclosed parenthesis"},
+		{"tag:a (done OR (blocked)", 6, "unclosed parenthesis"},
+		{"done)", 4, `unexpected ")"`},
+		{"() done", 1, `unexpected ")"`},
+		{"done OR AND blocked", 8, `unexpected "AND"`},
+		{"done & blocked", 5, "unexpected character '&'"},
+		{`tag:a title:"open`, 12, "unterminated quoted value"},
+		{"done tag:", 9, "missing value"},
+		{"tag:a priority:high", 6, `unknown field "priority"`},
+		{"tag:a urgent", 6, `unknown flag "urgent"`},
+		{"done<1", 0, "done cannot be compared with <"},
+		{"done:maybe", 0, "done expects true or false"},
+		{"tag<a", 0, "tag expects tag:<value>"},
+		{`title:"  "`, 0, "title expects a value"},
+		{"checklist:abc", 0, "checklist expects a checklist ID"},
+		{"due", 0, "due must be compared with a time"},
+		{"tag:a AND due<soon", 10, `due: "soon" is not a time`},
+		{"due<1000000d", 0, `due: offset "1000000d" is out of range`},
+		{"due=7d", 0, "due can only be matched exactly against a date"},
+		{strings.Repeat("(", 40) + "done" + strings.Repeat(")", 40), 32, "expression is nested too deeply"},
+		{strings.Repeat("NOT ", 40) + "done", 128, "expression is nested too deeply"},
+		{strings.Repeat("done ", filter.MaxLength/5+1), filter.MaxLength, "expression is longer than 2000 bytes"},
+	}
+	for _, tt := range tests {
+		name := tt.input
+		if len(name) > 40 {
+			name = name[:40]
+		}
+		t.Run(name, func(t *testing.T) {
+			_, err := filter.Parse(tt.input)
+			var syntaxErr *filter.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse() error = %v, want a *SyntaxError", err)
+			}
+			if syntaxErr.Pos != tt.pos || !strings.Contains(syntaxErr.Msg, tt.msg) {
+				t.Errorf("Parse() error = %q at %d, want %q at %d", syntaxErr.Msg, syntaxErr.Pos, tt.msg, tt.pos)
+			}
+		})
+	}
+}
+
+func TestTimeValueResolve(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	at := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if got := (filter.TimeValue{Offset: -48 * time.Hour, Relative: true}).Resolve(now); !got.Equal(now.Add(-48 * time.Hour)) {
+		t.Errorf("relative Resolve() = %s", got)
+	}
+	if got := (filter.TimeValue{At: at, Day: true}).Resolve(now); !got.Equal(at) {
+		t.Errorf("absolute Resolve() = %s", got)
+	}
+}
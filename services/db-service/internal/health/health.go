@@ -0,0 +1,111 @@
+// Package health serves the standard grpc.health.v1 service of the db-service
+// and keeps it in line with the connectivity to Postgres.
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger checks that the database is reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Checker reports SERVING for the server as a whole ("") and for every
+// registered service while the database answers pings, and NOT_SERVING
+// otherwise.
+type Checker struct {
+	server   *health.Server
+	db       Pinger
+	interval time.Duration
+	services []string
+}
+
+// NewChecker starts in NOT_SERVING until the first successful ping.
+func NewChecker(db Pinger, interval time.Duration, services ...string) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		db:       db,
+		interval: interval,
+		services: append([]string{""}, services...),
+	}
+	c.set(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Register adds the health service to s.
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.server)
+}
+
+// Run pings the database every interval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	serving := false
+	for {
+		pingCtx, cancel := context.WithTimeout(ctx, c.interval)
+		err := c.db.Ping(pingCtx)
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil && serving:
+			slog.ErrorContext(ctx, "Database is unreachable, reporting NOT_SERVING", "error", err)
+		case err == nil && !serving:
+			slog.InfoContext(ctx, "Database is reachable, reporting SERVING")
+		}
+		if serving = err == nil; serving {
+			c.set(healthpb.HealthCheckResponse_SERVING)
+		} else {
+			c.set(healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING from now on, so that clients stop sending new
+// calls while the server drains.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) set(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Probe asks the health service at addr whether the server is SERVING. It is
+// used as the container health check.
+func Probe(ctx context.Context, addr string) error {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("server is %s", res.Status)
+	}
+	return nil
+}
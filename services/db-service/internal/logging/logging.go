@@ -0,0 +1,121 @@
+// Package logging configures JSON logging for the db-service and carries the
+// request ID, gRPC method and task ID of a call in its context, so that every
+// log line of the call includes them.
+package logging
+
+import (
+	baselog "checklist-go/internal/logging"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDKey is the gRPC metadata key the api-service sends the request ID in.
+const RequestIDKey = "x-request-id"
+
+// Setup sets up JSON logging at the given level with the fields stored in
+// the context in every log line.
+func Setup(service string, level slog.Level) {
+	baselog.Setup(service, level, callAttrs)
+}
+
+type contextKey struct{}
+
+type callFields struct {
+	requestID string
+	method    string
+	taskID    string
+}
+
+func fieldsFrom(ctx context.Context) callFields {
+	f, _ := ctx.Value(contextKey{}).(callFields)
+	return f
+}
+
+// WithRequestID returns a context whose log lines carry the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	f := fieldsFrom(ctx)
+	f.requestID = id
+	return context.WithValue(ctx, contextKey{}, f)
+}
+
+// RequestID returns the request ID stored in ctx, if any.
+func RequestID(ctx context.Context) string {
+	return fieldsFrom(ctx).requestID
+}
+
+// WithMethod returns a context whose log lines carry the gRPC method.
+func WithMethod(ctx context.Context, method string) context.Context {
+	f := fieldsFrom(ctx)
+	f.method = method
+	return context.WithValue(ctx, contextKey{}, f)
+}
+
+// WithTaskID returns a context whose log lines carry the task ID.
+func WithTaskID(ctx context.Context, id string) context.Context {
+	f := fieldsFrom(ctx)
+	f.taskID = id
+	return context.WithValue(ctx, contextKey{}, f)
+}
+
+// callAttrs returns the fields stored in ctx that are set.
+func callAttrs(ctx context.Context) []slog.Attr {
+	f := fieldsFrom(ctx)
+	var attrs []slog.Attr
+	if f.requestID != "" {
+		attrs = append(attrs, slog.String("request_id", f.requestID))
+	}
+	if f.method != "" {
+		attrs = append(attrs, slog.String("method", f.method))
+	}
+	if f.taskID != "" {
+		attrs = append(attrs, slog.String("task_id", f.taskID))
+	}
+	return attrs
+}
+
+// UnaryServerInterceptor takes the request ID from the incoming metadata, or
+// makes one up for callers that send none, stores it with the method and the
+// task ID of the request in the context and logs the outcome of the call.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDKey); len(ids) > 0 {
+			requestID = strings.TrimSpace(ids[0])
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, requestID))
+
+	ctx = WithMethod(WithRequestID(ctx, requestID), info.FullMethod)
+	if taskID := baselog.RequestTaskID(req); taskID != "" {
+		ctx = WithTaskID(ctx, taskID)
+	}
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	if code == codes.OK && strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+		// Health checks arrive every few seconds.
+		level = slog.LevelDebug
+	}
+	switch code {
+	case codes.OK, codes.NotFound, codes.InvalidArgument, codes.AlreadyExists,
+		codes.FailedPrecondition, codes.PermissionDenied, codes.Unauthenticated:
+	default:
+		level = slog.LevelError
+	}
+	slog.Log(ctx, level, "Finished gRPC call", "code", code.String(), "duration_ms", time.Since(start).Milliseconds())
+	return resp, err
+}
@@ -0,0 +1,187 @@
+// Package metrics exposes Prometheus metrics of the db-service: gRPC calls,
+// database queries, the connection pool and task counts.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Number of gRPC calls completed by the server, by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcHandlingSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time the server spent handling gRPC calls, by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Duration of database queries, by SQL command and outcome.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "status"})
+)
+
+// Handler serves the metrics of the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// UnaryServerInterceptor counts and times every unary call.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	code := status.Code(err).String()
+	grpcHandled.WithLabelValues(info.FullMethod, code).Inc()
+	grpcHandlingSeconds.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// QueryTracer records the duration of every query run through a pgx
+// connection. Queries are labelled by their leading SQL keyword only, to keep
+// the number of series small.
+type QueryTracer struct{}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	at        time.Time
+	operation string
+}
+
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{at: time.Now(), operation: SQLOperation(data.SQL)})
+}
+
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	outcome := "ok"
+	if data.Err != nil {
+		outcome = "error"
+	}
+	queryDuration.WithLabelValues(start.operation, outcome).Observe(time.Since(start.at).Seconds())
+}
+
+// SQLOperation returns the lowercased first keyword of a statement, or
+// "other" for a keyword outside the few common ones, which keeps the number
+// of metric series and span names small.
+func SQLOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+	switch op := strings.ToLower(fields[0]); op {
+	case "select", "insert", "update", "delete", "with", "begin", "commit", "rollback":
+		return op
+	default:
+		return "other"
+	}
+}
+
+// poolCollector reports the state of a pgx connection pool at scrape time.
+type poolCollector struct {
+	stat func() *pgxpool.Stat
+
+	acquired        *prometheus.Desc
+	idle            *prometheus.Desc
+	constructing    *prometheus.Desc
+	total           *prometheus.Desc
+	max             *prometheus.Desc
+	acquires        *prometheus.Desc
+	emptyAcquires   *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+	acquireSeconds  *prometheus.Desc
+}
+
+// RegisterPool registers gauges and counters for the pool whose statistics
+// stat returns.
+func RegisterPool(stat func() *pgxpool.Stat) {
+	prometheus.MustRegister(&poolCollector{
+		stat:            stat,
+		acquired:        prometheus.NewDesc("db_pool_acquired_connections", "Connections currently in use.", nil, nil),
+		idle:            prometheus.NewDesc("db_pool_idle_connections", "Idle connections in the pool.", nil, nil),
+		constructing:    prometheus.NewDesc("db_pool_constructing_connections", "Connections being established.", nil, nil),
+		total:           prometheus.NewDesc("db_pool_total_connections", "All connections in the pool.", nil, nil),
+		max:             prometheus.NewDesc("db_pool_max_connections", "Maximum size of the pool.", nil, nil),
+		acquires:        prometheus.NewDesc("db_pool_acquires_total", "Successful connection acquisitions.", nil, nil),
+		emptyAcquires:   prometheus.NewDesc("db_pool_empty_acquires_total", "Acquisitions that had to wait for a connection.", nil, nil),
+		canceledAcquire: prometheus.NewDesc("db_pool_canceled_acquires_total", "Acquisitions canceled by their context.", nil, nil),
+		acquireSeconds:  prometheus.NewDesc("db_pool_acquire_seconds_total", "Total time spent acquiring connections.", nil, nil),
+	})
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.stat()
+	ch <- prometheus.MustNewConstMetric(c.acquired, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructing, prometheus.GaugeValue, float64(s.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.max, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireSeconds, prometheus.CounterValue, s.AcquireDuration().Seconds())
+}
+
+// TaskCounter counts the open and the overdue open tasks.
+type TaskCounter interface {
+	CountOpenTasks(ctx context.Context) (open int64, overdue int64, err error)
+}
+
+// taskCollector queries the task counts at scrape time, so the gauges are
+// never stale and cost nothing between scrapes.
+type taskCollector struct {
+	counter TaskCounter
+	open    *prometheus.Desc
+	overdue *prometheus.Desc
+}
+
+// RegisterTasks registers the open and overdue task gauges.
+func RegisterTasks(counter TaskCounter) {
+	prometheus.MustRegister(&taskCollector{
+		counter: counter,
+		open:    prometheus.NewDesc("checklist_open_tasks", "Tasks that are not done.", nil, nil),
+		overdue: prometheus.NewDesc("checklist_overdue_tasks", "Open tasks whose due date has passed.", nil, nil),
+	})
+}
+
+func (c *taskCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.open
+	ch <- c.overdue
+}
+
+func (c *taskCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	open, overdue, err := c.counter.CountOpenTasks(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error counting tasks for metrics", "error", err)
+		ch <- prometheus.NewInvalidMetric(c.open, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(open))
+	ch <- prometheus.MustNewConstMetric(c.overdue, prometheus.GaugeValue, float64(overdue))
+}
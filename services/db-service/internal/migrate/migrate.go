@@ -0,0 +1,221 @@
+// Package migrate applies the embedded schema migrations of the db-service.
+//
+// Versions are tracked in the schema_migrations table in the same layout the
+// golang-migrate CLI uses, so databases migrated by the CLI keep working.
+// Each migration runs in its own transaction together with the version
+// update. On Postgres a session advisory lock keeps concurrent replicas from
+// migrating at the same time; on SQLite the database write lock does.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is one schema change with its up and down SQL.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status describes the schema version of a database.
+type Status struct {
+	// Version is the last applied migration, 0 when none is.
+	Version uint64
+	// Dirty is set when a migration failed half-way outside a transaction,
+	// which only the golang-migrate CLI can leave behind.
+	Dirty   bool
+	Pending []Migration
+}
+
+// Migrator applies migrations over a single connection, because advisory
+// locks belong to a session.
+type Migrator struct {
+	conn       conn
+	migrations []Migration
+}
+
+// conn is the database a Migrator works on.
+type conn interface {
+	// lock keeps other migrators out until unlock.
+	lock(ctx context.Context) error
+	unlock(ctx context.Context) error
+	ensureTable(ctx context.Context) error
+	version(ctx context.Context) (version uint64, dirty bool, err error)
+	// apply runs sql and records version as current atomically; version 0
+	// means no migration is applied.
+	apply(ctx context.Context, sql string, version uint64) error
+	close(ctx context.Context) error
+}
+
+// Load reads the migrations in fsys, sorted by version. Every version needs
+// both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, e := range entries {
+		m := fileName.FindStringSubmatch(e.Name())
+		if e.IsDir() || m == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(m[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", e.Name())
+		}
+		body, err := fs.ReadFile(fsys, path.Join(".", e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", e.Name(), err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs non-empty up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Close closes the connection.
+func (m *Migrator) Close(ctx context.Context) error {
+	return m.conn.close(ctx)
+}
+
+// Up applies every pending migration and returns how many it applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.locked(ctx, func(current uint64) error {
+		if !m.known(current) {
+			// A newer release migrated already; during a rolling update the
+			// old replicas keep running against the newer schema.
+			slog.WarnContext(ctx, "Database is ahead of this binary", "version", current)
+			return nil
+		}
+		for _, mig := range m.migrations {
+			if mig.Version <= current {
+				continue
+			}
+			if err := m.conn.apply(ctx, mig.Up, mig.Version); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			slog.InfoContext(ctx, "Applied migration", "version", mig.Version, "name", mig.Name)
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts up to steps applied migrations, newest first, and returns how
+// many it reverted. steps < 0 reverts all of them.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+	err := m.locked(ctx, func(current uint64) error {
+		if !m.known(current) {
+			return fmt.Errorf("database is at version %d, which this binary has no down migration for", current)
+		}
+		for i := len(m.migrations) - 1; i >= 0 && reverted != steps; i-- {
+			mig := m.migrations[i]
+			if mig.Version > current {
+				continue
+			}
+			var previous uint64
+			if i > 0 {
+				previous = m.migrations[i-1].Version
+			}
+			if err := m.conn.apply(ctx, mig.Down, previous); err != nil {
+				return fmt.Errorf("failed to revert migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			slog.InfoContext(ctx, "Reverted migration", "version", mig.Version, "name", mig.Name)
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status reports the current version and the migrations not yet applied.
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	if err := m.conn.ensureTable(ctx); err != nil {
+		return Status{}, err
+	}
+	version, dirty, err := m.conn.version(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+	st := Status{Version: version, Dirty: dirty}
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			st.Pending = append(st.Pending, mig)
+		}
+	}
+	return st, nil
+}
+
+// locked runs fn with the migration lock held and the current version read
+// after acquiring it, so a replica that waited sees the work of the one that
+// migrated first.
+func (m *Migrator) locked(ctx context.Context, fn func(current uint64) error) error {
+	if err := m.conn.ensureTable(ctx); err != nil {
+		return err
+	}
+	if err := m.conn.lock(ctx); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if err := m.conn.unlock(context.WithoutCancel(ctx)); err != nil {
+			slog.ErrorContext(ctx, "Failed to release migration lock", "error", err)
+		}
+	}()
+
+	current, dirty, err := m.conn.version(ctx)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("database is dirty at version %d: fix the schema by hand and reset schema_migrations", current)
+	}
+	return fn(current)
+}
+
+func (m *Migrator) known(version uint64) bool {
+	if version == 0 {
+		return true
+	}
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return true
+		}
+	}
+	return false
+}
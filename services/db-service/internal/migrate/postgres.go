@@ -0,0 +1,91 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockID is the pg_advisory_lock key held while migrating.
+const lockID int64 = 0x636865636b6c6973 // "checklis"
+
+// Connect opens a dedicated connection to the Postgres database at dsn and
+// loads the migrations in fsys. Close releases the connection.
+func Connect(ctx context.Context, dsn string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect for migrations: %w", err)
+	}
+	return &Migrator{conn: pgConn{conn}, migrations: migrations}, nil
+}
+
+type pgConn struct {
+	conn *pgx.Conn
+}
+
+func (c pgConn) lock(ctx context.Context) error {
+	_, err := c.conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID)
+	return err
+}
+
+// unlock releases the advisory lock; it goes away with the session anyway
+// if this fails.
+func (c pgConn) unlock(ctx context.Context) error {
+	_, err := c.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", lockID)
+	return err
+}
+
+func (c pgConn) apply(ctx context.Context, sql string, version uint64) error {
+	return pgx.BeginFunc(ctx, c.conn, func(tx pgx.Tx) error {
+		// Without arguments pgx uses the simple protocol, which accepts the
+		// several statements a migration file usually holds.
+		if _, err := tx.Exec(ctx, sql); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM schema_migrations"); err != nil {
+			return fmt.Errorf("failed to clear schema version: %w", err)
+		}
+		if version == 0 {
+			return nil
+		}
+		if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, FALSE)", int64(version)); err != nil {
+			return fmt.Errorf("failed to record schema version: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c pgConn) ensureTable(ctx context.Context) error {
+	_, err := c.conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT NOT NULL PRIMARY KEY,
+		dirty BOOLEAN NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func (c pgConn) version(ctx context.Context) (uint64, bool, error) {
+	var version int64
+	var dirty bool
+	err := c.conn.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return uint64(version), dirty, nil
+}
+
+func (c pgConn) close(ctx context.Context) error {
+	return c.conn.Close(ctx)
+}
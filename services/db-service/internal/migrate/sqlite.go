@@ -0,0 +1,104 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+// ConnectSQLite takes a connection from db, an open SQLite database, and
+// loads the migrations in fsys. Close releases the connection and closes db.
+func ConnectSQLite(ctx context.Context, db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect for migrations: %w", err)
+	}
+	return &Migrator{conn: sqliteConn{db: db, conn: conn}, migrations: migrations}, nil
+}
+
+// sqliteConn holds a write transaction from lock to unlock: SQLite has no
+// advisory locks, and its DDL is transactional, so each migration runs in a
+// savepoint of that transaction instead of a transaction of its own.
+type sqliteConn struct {
+	db   *sql.DB
+	conn *sql.Conn
+}
+
+func (c sqliteConn) lock(ctx context.Context) error {
+	_, err := c.conn.ExecContext(ctx, "BEGIN IMMEDIATE")
+	return err
+}
+
+// unlock commits the migrations that were applied before any failure.
+func (c sqliteConn) unlock(ctx context.Context) error {
+	_, err := c.conn.ExecContext(ctx, "COMMIT")
+	return err
+}
+
+func (c sqliteConn) apply(ctx context.Context, query string, version uint64) error {
+	if _, err := c.conn.ExecContext(ctx, "SAVEPOINT migration"); err != nil {
+		return err
+	}
+	err := c.applyInSavepoint(ctx, query, version)
+	if err != nil {
+		if _, rbErr := c.conn.ExecContext(ctx, "ROLLBACK TO migration"); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+	}
+	if _, relErr := c.conn.ExecContext(ctx, "RELEASE migration"); relErr != nil {
+		return errors.Join(err, relErr)
+	}
+	return err
+}
+
+func (c sqliteConn) applyInSavepoint(ctx context.Context, query string, version uint64) error {
+	if _, err := c.conn.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	if _, err := c.conn.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return fmt.Errorf("failed to clear schema version: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+	if _, err := c.conn.ExecContext(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, FALSE)", int64(version)); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	return nil
+}
+
+func (c sqliteConn) ensureTable(ctx context.Context) error {
+	_, err := c.conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT NOT NULL PRIMARY KEY,
+		dirty BOOLEAN NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func (c sqliteConn) version(ctx context.Context) (uint64, bool, error) {
+	var version int64
+	var dirty bool
+	err := c.conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return uint64(version), dirty, nil
+}
+
+func (c sqliteConn) close(ctx context.Context) error {
+	return errors.Join(c.conn.Close(), c.db.Close())
+}
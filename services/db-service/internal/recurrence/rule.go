@@ -0,0 +1,63 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// ErrTooFrequent is returned for rules that would create tasks more often
+// than once an hour.
+var ErrTooFrequent = errors.New("rules more frequent than HOURLY are not supported")
+
+// Rule is a parsed iCalendar RRULE anchored at a DTSTART in a time zone.
+type Rule struct {
+	rrule *rrule.RRule
+}
+
+// Parse parses an RRULE such as "FREQ=WEEKLY;BYDAY=MO;BYHOUR=10" (an
+// "RRULE:" prefix is accepted). The start of the schedule is given by
+// dtstart; occurrences are computed in the named IANA time zone so that
+// BYDAY/BYHOUR follow local wall-clock time.
+func Parse(expr string, dtstart time.Time, timezone string) (*Rule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.ContainsAny(expr, "\r\n") || strings.Contains(expr, "DTSTART") {
+		return nil, errors.New("rrule must be a single RRULE line without DTSTART")
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", timezone)
+	}
+
+	opt, err := rrule.StrToROptionInLocation(expr, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rrule: %w", err)
+	}
+	if opt.Freq == rrule.MINUTELY || opt.Freq == rrule.SECONDLY {
+		return nil, ErrTooFrequent
+	}
+	opt.Dtstart = dtstart.In(loc)
+
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rrule: %w", err)
+	}
+	return &Rule{rrule: r}, nil
+}
+
+// After returns the first occurrence strictly after t, or false if the rule
+// has no more occurrences.
+func (r *Rule) After(t time.Time) (time.Time, bool) {
+	next := r.rrule.After(t, false)
+	return next, !next.IsZero()
+}
+
+// Latest returns the last occurrence at or before t, or false if there is none.
+func (r *Rule) Latest(t time.Time) (time.Time, bool) {
+	prev := r.rrule.Before(t, true)
+	return prev, !prev.IsZero()
+}
@@ -0,0 +1,61 @@
+package recurrence_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"checklist-go/services/db-service/internal/recurrence"
+)
+
+func TestParse(t *testing.T) {
+	dtstart := time.Date(2025, 3, 1, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rrule    string
+		timezone string
+		// wantIs, when set, is checked with errors.Is.
+		wantErr bool
+		wantIs  error
+		// first is the first occurrence after dtstart of a valid rule.
+		first time.Time
+	}{
+		{name: "weekly", rrule: "FREQ=WEEKLY;BYDAY=MO;BYHOUR=10", timezone: "UTC",
+			first: time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)},
+		{name: "RRULE prefix and spaces", rrule: "  RRULE:FREQ=DAILY ", timezone: "UTC",
+			first: time.Date(2025, 3, 2, 14, 0, 0, 0, time.UTC)},
+		{name: "empty timezone is UTC", rrule: "FREQ=HOURLY", timezone: "",
+			first: time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC)},
+		{name: "BYHOUR in the time zone", rrule: "FREQ=DAILY;BYHOUR=9", timezone: "America/New_York",
+			first: time.Date(2025, 3, 2, 14, 0, 0, 0, time.UTC)},
+		{name: "unknown frequency", rrule: "FREQ=SOMETIMES", timezone: "UTC", wantErr: true},
+		{name: "unknown part", rrule: "FREQ=DAILY;EVERY=2", timezone: "UTC", wantErr: true},
+		{name: "empty", rrule: "", timezone: "UTC", wantErr: true},
+		{name: "minutely", rrule: "FREQ=MINUTELY", timezone: "UTC", wantErr: true, wantIs: recurrence.ErrTooFrequent},
+		{name: "secondly", rrule: "FREQ=SECONDLY;INTERVAL=3600", timezone: "UTC", wantErr: true, wantIs: recurrence.ErrTooFrequent},
+		{name: "DTSTART line", rrule: "DTSTART:20250301T000000Z\nRRULE:FREQ=DAILY", timezone: "UTC", wantErr: true},
+		{name: "DTSTART part", rrule: "FREQ=DAILY;DTSTART=20250301T000000Z", timezone: "UTC", wantErr: true},
+		{name: "unknown timezone", rrule: "FREQ=DAILY", timezone: "Mars/Olympus_Mons", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := recurrence.Parse(tt.rrule, dtstart, tt.timezone)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Parse() error = nil, want an error")
+				}
+				if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+					t.Errorf("Parse() error = %v, want %v", err, tt.wantIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if first, ok := rule.After(dtstart); !ok || !first.Equal(tt.first) {
+				t.Errorf("After(dtstart) = %v, %t, want %v", first, ok, tt.first)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package recurrence
+
+import (
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// batchSize is the number of recurrences handled in a single transaction.
+const batchSize = 100
+
+// Scheduler periodically materializes the next occurrence of recurring tasks
+// and checklists. It is safe to run one Scheduler in every db-service
+// replica; see storage.ProcessDueRecurrences.
+type Scheduler struct {
+	storage  storage.TaskRepository
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewScheduler(storage storage.TaskRepository, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		storage:  storage,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run processes due recurrences every interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "Recurrence scheduler started", "interval", s.interval.String())
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Recurrence scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	// The storage disables a rule that fails to plan and records the error on
+	// it; the failure is logged here, where the rule ID is known.
+	plan := func(r storage.DueRecurrence) (time.Time, *time.Time, error) {
+		occurrence, next, err := s.plan(r)
+		if err != nil {
+			slog.WarnContext(ctx, "Disabled recurrence that cannot be planned", "recurrence_id", r.ID, "error", err)
+		}
+		return occurrence, next, err
+	}
+
+	for ctx.Err() == nil {
+		n, err := s.storage.ProcessDueRecurrences(ctx, s.now(), batchSize, plan)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error processing due recurrences", "error", err)
+		}
+		if n > 0 {
+			slog.InfoContext(ctx, "Materialized recurring occurrences", "count", n)
+		}
+		// n counts only the occurrences materialized, so a batch in which
+		// rules failed leaves the rest to the next tick.
+		if n < batchSize {
+			return
+		}
+	}
+}
+
+// plan picks the occurrence to create for r. When the rule is due, missed
+// occurrences (e.g. while the service was down) are skipped and only the
+// latest one is created; when it was picked up early because the previous
+// occurrence was completed, the upcoming occurrence is created ahead of time.
+func (s *Scheduler) plan(r storage.DueRecurrence) (time.Time, *time.Time, error) {
+	rule, err := Parse(r.RRule, r.DTStart, r.Timezone)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to parse rule: %w", err)
+	}
+
+	occurrence := r.NextOccurrenceAt
+	if now := s.now(); !occurrence.After(now) {
+		if latest, ok := rule.Latest(now); ok && latest.After(occurrence) {
+			occurrence = latest
+		}
+	}
+
+	next, ok := rule.After(occurrence)
+	if !ok {
+		return occurrence, nil, nil
+	}
+	return occurrence, &next, nil
+}
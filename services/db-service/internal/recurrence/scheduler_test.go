@@ -0,0 +1,144 @@
+package recurrence
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPlan(t *testing.T) {
+	utc := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2025, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		rrule    string
+		timezone string
+		dtstart  time.Time
+		// due is the stored next occurrence, now the time of the tick.
+		due, now time.Time
+		// wantNext is nil when the rule is exhausted.
+		wantOccurrence time.Time
+		wantNext       *time.Time
+	}{
+		{
+			name: "on time", rrule: "FREQ=DAILY", timezone: "UTC", dtstart: utc(3, 1, 9),
+			due: utc(3, 2, 9), now: utc(3, 2, 9),
+			wantOccurrence: utc(3, 2, 9), wantNext: ptr(utc(3, 3, 9)),
+		},
+		{
+			name: "missed occurrences are skipped", rrule: "FREQ=DAILY", timezone: "UTC", dtstart: utc(3, 1, 9),
+			due: utc(3, 2, 9), now: utc(3, 5, 12),
+			wantOccurrence: utc(3, 5, 9), wantNext: ptr(utc(3, 6, 9)),
+		},
+		{
+			name: "early after completion", rrule: "FREQ=DAILY", timezone: "UTC", dtstart: utc(3, 1, 9),
+			due: utc(3, 6, 9), now: utc(3, 5, 12),
+			wantOccurrence: utc(3, 6, 9), wantNext: ptr(utc(3, 7, 9)),
+		},
+		{
+			// Clocks in Berlin go forward on March 30: 09:00 is 08:00 UTC
+			// before and 07:00 UTC after.
+			name: "BYHOUR across the start of DST", rrule: "FREQ=DAILY;BYHOUR=9", timezone: "Europe/Berlin", dtstart: utc(3, 28, 8),
+			due: utc(3, 29, 8), now: utc(3, 29, 10),
+			wantOccurrence: utc(3, 29, 8), wantNext: ptr(utc(3, 30, 7)),
+		},
+		{
+			name: "BYHOUR across the end of DST", rrule: "FREQ=DAILY;BYHOUR=9", timezone: "Europe/Berlin", dtstart: utc(10, 24, 7),
+			due: utc(10, 25, 7), now: utc(10, 25, 7),
+			wantOccurrence: utc(10, 25, 7), wantNext: ptr(utc(10, 26, 8)),
+		},
+		{
+			name: "COUNT exhausted", rrule: "FREQ=DAILY;COUNT=3", timezone: "UTC", dtstart: utc(3, 1, 9),
+			due: utc(3, 3, 9), now: utc(3, 3, 10),
+			wantOccurrence: utc(3, 3, 9),
+		},
+		{
+			name: "UNTIL passed while down", rrule: "FREQ=WEEKLY;UNTIL=20250315T000000Z", timezone: "UTC", dtstart: utc(3, 1, 9),
+			due: utc(3, 8, 9), now: utc(3, 20, 9),
+			wantOccurrence: utc(3, 8, 9),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{now: func() time.Time { return tt.now }}
+			occurrence, next, err := s.plan(storage.DueRecurrence{
+				ID: "r", RRule: tt.rrule, Timezone: tt.timezone, DTStart: tt.dtstart, NextOccurrenceAt: tt.due,
+			})
+			if err != nil {
+				t.Fatalf("plan() error = %v", err)
+			}
+			if !occurrence.Equal(tt.wantOccurrence) {
+				t.Errorf("occurrence = %v, want %v", occurrence, tt.wantOccurrence)
+			}
+			switch {
+			case tt.wantNext == nil && next != nil:
+				t.Errorf("next = %v, want the rule exhausted", *next)
+			case tt.wantNext != nil && (next == nil || !next.Equal(*tt.wantNext)):
+				t.Errorf("next = %v, want %v", next, *tt.wantNext)
+			}
+		})
+	}
+}
+
+// TestTickDisablesBrokenRule checks that a rule that cannot be planned is
+// disabled with its error and logged once as a warning with its ID.
+func TestTickDisablesBrokenRule(t *testing.T) {
+	var logs bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+
+	ctx := context.Background()
+	st := storage.NewMemoryStorage()
+	task, err := st.CreateTask(ctx, &pb.Task{Title: "water plants"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	now := time.Now()
+	rule, err := st.UpsertRecurrence(ctx, &pb.Recurrence{
+		TaskId:           task.Id,
+		Rrule:            "FREQ=SOMETIMES",
+		Dtstart:          timestamppb.New(now.Add(-time.Hour)),
+		Timezone:         "UTC",
+		NextOccurrenceAt: timestamppb.New(now.Add(-time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("UpsertRecurrence: %v", err)
+	}
+
+	s := NewScheduler(st, time.Minute)
+	s.tick(ctx)
+	s.tick(ctx)
+
+	recurrences, err := st.ListRecurrences(ctx)
+	if err != nil || len(recurrences) != 1 {
+		t.Fatalf("ListRecurrences = %v, %v", recurrences, err)
+	}
+	if rec := recurrences[0]; rec.NextOccurrenceAt != nil || !strings.Contains(rec.LastError, "invalid rrule") {
+		t.Errorf("recurrence = %v, want it disabled with the parse error", rec)
+	}
+
+	var warnings []string
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		if strings.Contains(line, `"level":"WARN"`) {
+			warnings = append(warnings, line)
+		}
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"recurrence_id":"`+rule.Id+`"`) {
+		t.Errorf("warnings = %q, want one naming recurrence %s", warnings, rule.Id)
+	}
+	if strings.Contains(logs.String(), `"level":"ERROR"`) {
+		t.Errorf("the failure is also logged as an error:\n%s", logs.String())
+	}
+}
+
+func ptr(t time.Time) *time.Time { return &t }
@@ -0,0 +1,419 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Query is a tsquery produced by ParseQuery, evaluated in Go. It lets storage
+// backends without Postgres text search run the same queries. Words are
+// compared case-insensitively after stemming like the russian configuration
+// does, so "run" finds "running" and "задача" finds "задачи".
+type Query struct {
+	root node
+	// terms are the lexemes that are not negated; they are highlighted.
+	terms []lexemeNode
+}
+
+// CompileQuery parses the output of ParseQuery.
+func CompileQuery(tsquery string) (*Query, error) {
+	p := &tsParser{input: []rune(tsquery)}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at %d in tsquery", string(p.input[p.pos]), p.pos)
+	}
+	q := &Query{root: root}
+	collectTerms(root, false, &q.terms)
+	return q, nil
+}
+
+// PlainQuery is the Query counterpart of plainto_tsquery: every word of text
+// must occur. Text without words yields a query that matches nothing.
+func PlainQuery(text string) *Query {
+	words := tokenize(text)
+	if len(words) == 0 {
+		return &Query{root: orNode{}}
+	}
+	and := make(andNode, len(words))
+	q := &Query{root: and}
+	for i, w := range words {
+		l := lexemeNode{word: w.word}
+		and[i] = l
+		q.terms = append(q.terms, l)
+	}
+	return q
+}
+
+// Match reports whether the texts, taken as one document, match the query.
+func (q *Query) Match(texts ...string) bool {
+	var words []string
+	for _, text := range texts {
+		for _, w := range tokenize(text) {
+			words = append(words, w.word)
+		}
+	}
+	return q.root.match(words)
+}
+
+// Rank counts the matches of the query terms in text, weighted by weight. It
+// only orders results, the values are not comparable to ts_rank_cd.
+func (q *Query) Rank(text string, weight float64) float64 {
+	n := 0
+	for _, w := range tokenize(text) {
+		if q.matches(w.word) {
+			n++
+		}
+	}
+	return float64(n) * weight
+}
+
+// Highlight wraps every match in text in HighlightStart and HighlightStop,
+// like ts_headline with HighlightAll.
+func (q *Query) Highlight(text string) string {
+	return q.headline(text, tokenize(text), 0, len(text))
+}
+
+// Snippet is Highlight for a fragment of at most maxWords words around the
+// first match, with " … " marking cut text.
+func (q *Query) Snippet(text string, maxWords int) string {
+	words := tokenize(text)
+	if len(words) <= maxWords {
+		return q.Highlight(text)
+	}
+
+	first := 0
+	for i, w := range words {
+		if q.matches(w.word) {
+			first = i
+			break
+		}
+	}
+	start := max(0, first-maxWords/3)
+	end := min(len(words), start+maxWords)
+	start = max(0, end-maxWords)
+
+	from, to := words[start].start, words[end-1].end
+	snippet := q.headline(text, words[start:end], from, to)
+	if start > 0 {
+		snippet = "… " + snippet
+	}
+	if end < len(words) {
+		snippet += " …"
+	}
+	return snippet
+}
+
+func (q *Query) headline(text string, words []word, from, to int) string {
+	var b strings.Builder
+	pos := from
+	for _, w := range words {
+		if !q.matches(w.word) {
+			continue
+		}
+		b.WriteString(text[pos:w.start])
+		b.WriteString(HighlightStart)
+		b.WriteString(text[w.start:w.end])
+		b.WriteString(HighlightStop)
+		pos = w.end
+	}
+	b.WriteString(text[pos:to])
+	return b.String()
+}
+
+func (q *Query) matches(w string) bool {
+	for _, t := range q.terms {
+		if t.matchWord(w) {
+			return true
+		}
+	}
+	return false
+}
+
+type word struct {
+	word       string
+	start, end int
+}
+
+// tokenize splits text into lower-cased and stemmed runs of letters and
+// digits.
+func tokenize(text string) []word {
+	var words []word
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			words = append(words, word{word: stem(strings.ToLower(text[start:i])), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{word: stem(strings.ToLower(text[start:])), start: start, end: len(text)})
+	}
+	return words
+}
+
+type node interface {
+	match(words []string) bool
+}
+
+type andNode []node
+type orNode []node
+type notNode struct{ x node }
+
+// phraseNode matches its words at consecutive positions.
+type phraseNode []lexemeNode
+
+type lexemeNode struct {
+	word   string
+	prefix bool
+}
+
+func (n andNode) match(words []string) bool {
+	for _, x := range n {
+		if !x.match(words) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n orNode) match(words []string) bool {
+	for _, x := range n {
+		if x.match(words) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n notNode) match(words []string) bool { return !n.x.match(words) }
+
+func (n lexemeNode) match(words []string) bool {
+	for _, w := range words {
+		if n.matchWord(w) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n lexemeNode) matchWord(w string) bool {
+	if n.prefix {
+		return strings.HasPrefix(w, n.word)
+	}
+	return w == n.word
+}
+
+func (n phraseNode) match(words []string) bool {
+	for i := 0; i+len(n) <= len(words); i++ {
+		ok := true
+		for j, l := range n {
+			if !l.matchWord(words[i+j]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func collectTerms(n node, negated bool, terms *[]lexemeNode) {
+	switch n := n.(type) {
+	case andNode:
+		for _, x := range n {
+			collectTerms(x, negated, terms)
+		}
+	case orNode:
+		for _, x := range n {
+			collectTerms(x, negated, terms)
+		}
+	case notNode:
+		collectTerms(n.x, !negated, terms)
+	case phraseNode:
+		if !negated {
+			*terms = append(*terms, n...)
+		}
+	case lexemeNode:
+		if !negated {
+			*terms = append(*terms, n)
+		}
+	}
+}
+
+// tsParser reads the subset of the tsquery syntax ParseQuery emits: quoted
+// lexemes with an optional :* suffix, !, &, | and <->, and parentheses.
+type tsParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *tsParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *tsParser) consume(op string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(string(p.input[p.pos:]), op) {
+		p.pos += len([]rune(op))
+		return true
+	}
+	return false
+}
+
+func (p *tsParser) parseOr() (node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	or := orNode{x}
+	for p.consume("|") {
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, y)
+	}
+	if len(or) == 1 {
+		return x, nil
+	}
+	return or, nil
+}
+
+func (p *tsParser) parseAnd() (node, error) {
+	x, err := p.parsePhrase()
+	if err != nil {
+		return nil, err
+	}
+	and := andNode{x}
+	for p.consume("&") {
+		y, err := p.parsePhrase()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, y)
+	}
+	if len(and) == 1 {
+		return x, nil
+	}
+	return and, nil
+}
+
+func (p *tsParser) parsePhrase() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume("<->") {
+		return x, nil
+	}
+	phrase, ok := asPhrase(x)
+	for ok {
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		var more phraseNode
+		if more, ok = asPhrase(y); ok {
+			phrase = append(phrase, more...)
+		}
+		if !p.consume("<->") {
+			break
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("only words can form a phrase in tsquery at %d", p.pos)
+	}
+	return phrase, nil
+}
+
+func asPhrase(n node) (phraseNode, bool) {
+	switch n := n.(type) {
+	case lexemeNode:
+		return phraseNode{n}, true
+	case phraseNode:
+		return n, true
+	}
+	return nil, false
+}
+
+func (p *tsParser) parseUnary() (node, error) {
+	if p.consume("!") {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{x}, nil
+	}
+	if p.consume("(") {
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, fmt.Errorf("missing ) at %d in tsquery", p.pos)
+		}
+		return x, nil
+	}
+	return p.parseLexeme()
+}
+
+func (p *tsParser) parseLexeme() (node, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) || p.input[p.pos] != '\'' {
+		return nil, fmt.Errorf("expected a quoted word at %d in tsquery", p.pos)
+	}
+	p.pos++
+
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("unterminated word in tsquery")
+		}
+		r := p.input[p.pos]
+		p.pos++
+		if r == '\\' && p.pos < len(p.input) {
+			b.WriteRune(p.input[p.pos])
+			p.pos++
+			continue
+		}
+		if r == '\'' {
+			if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+				b.WriteRune('\'')
+				p.pos++
+				continue
+			}
+			break
+		}
+		b.WriteRune(r)
+	}
+	prefix := p.consume(":*")
+
+	// Like to_tsquery, a quoted word with punctuation in it becomes the
+	// phrase of its parts.
+	words := tokenize(b.String())
+	if len(words) == 0 {
+		return nil, fmt.Errorf("empty word in tsquery")
+	}
+	phrase := make(phraseNode, len(words))
+	for i, w := range words {
+		phrase[i] = lexemeNode{word: w.word}
+	}
+	phrase[len(phrase)-1].prefix = prefix
+	if len(phrase) == 1 {
+		return phrase[0], nil
+	}
+	return phrase, nil
+}
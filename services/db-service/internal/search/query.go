@@ -0,0 +1,158 @@
+// Package search turns user search input into Postgres tsquery syntax.
+package search
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Config is the text search configuration used for both indexing and
+// querying. The built-in russian configuration stems Cyrillic words with the
+// Russian Snowball stemmer and ASCII words with the English one, which covers
+// checklists written in either language or a mix of both.
+const Config = "russian"
+
+// Highlight markers that storage asks ts_headline to put around matches.
+// Private use code points cannot collide with task text, so HTML can be
+// rendered safely by escaping the snippet first and replacing the markers
+// afterwards.
+const (
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+// ErrEmptyQuery is returned when the input has no searchable terms.
+var ErrEmptyQuery = errors.New("search query has no terms")
+
+// ErrOnlyNegated is returned when every term of the input is excluded with
+// "-", which would match nearly every task.
+var ErrOnlyNegated = errors.New("search query must contain at least one term that is not excluded")
+
+// ParseQuery converts a search box query into text for to_tsquery. The input
+// language is:
+//
+//	word       tasks containing the word (stemmed)
+//	word*      tasks containing a word starting with the prefix
+//	"a b c"    the words as an adjacent phrase
+//	-word      tasks not containing the word (also -"a phrase")
+//	a OR b     either term; OR binds tighter than the implicit AND
+//
+// Every word is passed to to_tsquery as a quoted literal, so operators typed
+// by the user are never interpreted by Postgres.
+func ParseQuery(input string) (string, error) {
+	// groups are ANDed together; the terms of a group are ORed.
+	var groups [][]string
+	positive := false
+	or := false
+
+	rs := []rune(input)
+	for i := 0; i < len(rs); {
+		if unicode.IsSpace(rs[i]) {
+			i++
+			continue
+		}
+
+		negate := false
+		if rs[i] == '-' && i+1 < len(rs) && !unicode.IsSpace(rs[i+1]) {
+			negate = true
+			i++
+		}
+
+		var term string
+		if rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+			term = phrase(strings.Fields(string(rs[i+1 : end])))
+			i = end + 1
+		} else {
+			end := i
+			for end < len(rs) && !unicode.IsSpace(rs[end]) {
+				end++
+			}
+			word := string(rs[i:end])
+			i = end
+			if word == "OR" && !negate {
+				or = len(groups) > 0
+				continue
+			}
+			term = lexeme(word)
+		}
+		if term == "" {
+			continue
+		}
+
+		if negate {
+			term = "!" + term
+		} else {
+			positive = true
+		}
+		if or {
+			groups[len(groups)-1] = append(groups[len(groups)-1], term)
+		} else {
+			groups = append(groups, []string{term})
+		}
+		or = false
+	}
+
+	if len(groups) == 0 {
+		return "", ErrEmptyQuery
+	}
+	if !positive {
+		return "", ErrOnlyNegated
+	}
+
+	parts := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if len(g) == 1 {
+			parts = append(parts, g[0])
+			continue
+		}
+		parts = append(parts, "("+strings.Join(g, " | ")+")")
+	}
+	return strings.Join(parts, " & "), nil
+}
+
+// phrase joins the words with the followed-by operator.
+func phrase(words []string) string {
+	var parts []string
+	for _, w := range words {
+		if l := lexeme(w); l != "" {
+			parts = append(parts, l)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, " <-> ") + ")"
+}
+
+// lexeme quotes a single word, turning a trailing "*" into a prefix match.
+func lexeme(word string) string {
+	prefix := false
+	if strings.HasSuffix(word, "*") {
+		word = strings.TrimRight(word, "*")
+		prefix = true
+	}
+	if !strings.ContainsFunc(word, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) {
+		return ""
+	}
+
+	quoted := "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(word) + "'"
+	if prefix {
+		quoted += ":*"
+	}
+	return quoted
+}
+
+// HighlightHTML escapes a snippet produced with the highlight markers and
+// wraps every match in <mark>.
+func HighlightHTML(snippet string) string {
+	return strings.NewReplacer(HighlightStart, "<mark>", HighlightStop, "</mark>").Replace(html.EscapeString(snippet))
+}
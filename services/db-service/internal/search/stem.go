@@ -0,0 +1,255 @@
+package search
+
+import (
+	"bytes"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// stem reduces a lower-case word to its stem the way the russian
+// configuration does: words of ASCII letters with the Snowball English
+// (Porter2) stemmer and other words of letters, such as Cyrillic ones, with
+// the Snowball Russian stemmer (see stemRussian). Words with digits are
+// returned unchanged.
+func stem(word string) string {
+	switch {
+	case !strings.ContainsFunc(word, func(r rune) bool { return r < 'a' || r > 'z' }):
+		return stemEnglish(word)
+	case !strings.ContainsFunc(word, func(r rune) bool { return !unicode.IsLetter(r) }):
+		return stemRussian(word)
+	}
+	return word
+}
+
+// stemEnglish stems a word of ASCII letters with the Snowball English
+// (Porter2) algorithm. The steps follow
+// https://snowballstem.org/algorithms/english/stemmer.html.
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	if s, ok := stemExceptions[word]; ok {
+		return s
+	}
+
+	s := &stemmer{b: []byte(word)}
+	s.markY()
+	s.findRegions()
+	s.step1a()
+	if slices.Contains(stemInvariants, string(s.b)) {
+		return string(s.b)
+	}
+	s.step1b()
+	s.step1c()
+	s.replaceInR1(step2Suffixes, s.step2Allowed)
+	s.replaceInR1(step3Suffixes, s.step3Allowed)
+	s.step4()
+	s.step5()
+	return strings.ReplaceAll(string(s.b), "Y", "y")
+}
+
+// stemExceptions are words the algorithm would get wrong.
+var stemExceptions = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli", "singly": "singl",
+	"sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas", "cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+// stemInvariants are left alone once step 1a has run.
+var stemInvariants = []string{"inning", "outing", "canning", "herring", "earring", "proceed", "exceed", "succeed"}
+
+var step2Suffixes = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+	"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+	"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous", "ousness": "ous",
+	"iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble", "ogi": "og",
+	"fulli": "ful", "lessli": "less", "li": "",
+}
+
+var step3Suffixes = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic", "ical": "ic",
+	"ful": "", "ness": "", "ative": "",
+}
+
+var step4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent",
+	"ism", "ate", "iti", "ous", "ive", "ize", "ion",
+}
+
+type stemmer struct {
+	b []byte
+	// r1 and r2 are the offsets where the R1 and R2 regions start.
+	r1, r2 int
+}
+
+func isStemVowel(c byte) bool {
+	return strings.IndexByte("aeiouy", c) >= 0
+}
+
+// markY marks a y at the start of the word or after a vowel as a consonant.
+func (s *stemmer) markY() {
+	for i, c := range s.b {
+		if c == 'y' && (i == 0 || isStemVowel(s.b[i-1])) {
+			s.b[i] = 'Y'
+		}
+	}
+}
+
+func (s *stemmer) findRegions() {
+	s.r1 = -1
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if bytes.HasPrefix(s.b, []byte(prefix)) {
+			s.r1 = len(prefix)
+			break
+		}
+	}
+	if s.r1 < 0 {
+		s.r1 = s.regionAfter(0)
+	}
+	s.r2 = s.regionAfter(s.r1)
+}
+
+// regionAfter returns the offset after the first non-vowel following a vowel
+// at or after start, or the length of the word.
+func (s *stemmer) regionAfter(start int) int {
+	for i := start + 1; i < len(s.b); i++ {
+		if isStemVowel(s.b[i-1]) && !isStemVowel(s.b[i]) {
+			return i + 1
+		}
+	}
+	return len(s.b)
+}
+
+// longest returns the longest of the suffixes the word ends with.
+func (s *stemmer) longest(suffixes []string) string {
+	found := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(found) && bytes.HasSuffix(s.b, []byte(suffix)) {
+			found = suffix
+		}
+	}
+	return found
+}
+
+func (s *stemmer) replace(suffix, with string) {
+	s.b = append(s.b[:len(s.b)-len(suffix)], with...)
+}
+
+func (s *stemmer) inR1(suffix string) bool { return len(s.b)-len(suffix) >= s.r1 }
+func (s *stemmer) inR2(suffix string) bool { return len(s.b)-len(suffix) >= s.r2 }
+
+func (s *stemmer) hasVowel(end int) bool {
+	return slices.ContainsFunc(s.b[:end], isStemVowel)
+}
+
+// endsShortSyllable reports whether b ends with a non-vowel, a vowel and a
+// non-vowel other than w, x and Y, or is a vowel followed by a non-vowel.
+func endsShortSyllable(b []byte) bool {
+	n := len(b)
+	if n == 2 {
+		return isStemVowel(b[0]) && !isStemVowel(b[1])
+	}
+	return n >= 3 && !isStemVowel(b[n-3]) && isStemVowel(b[n-2]) &&
+		!isStemVowel(b[n-1]) && strings.IndexByte("wxY", b[n-1]) < 0
+}
+
+func (s *stemmer) step1a() {
+	switch suffix := s.longest([]string{"sses", "ied", "ies", "us", "ss", "s"}); suffix {
+	case "sses":
+		s.replace(suffix, "ss")
+	case "ied", "ies":
+		if len(s.b) > 4 {
+			s.replace(suffix, "i")
+		} else {
+			s.replace(suffix, "ie")
+		}
+	case "s":
+		// Not when the letter before the s is the only vowel, as in "gas".
+		if s.hasVowel(len(s.b) - 2) {
+			s.replace(suffix, "")
+		}
+	}
+}
+
+func (s *stemmer) step1b() {
+	switch suffix := s.longest([]string{"eed", "eedly", "ed", "edly", "ing", "ingly"}); suffix {
+	case "eed", "eedly":
+		if s.inR1(suffix) {
+			s.replace(suffix, "ee")
+		}
+	case "ed", "edly", "ing", "ingly":
+		if !s.hasVowel(len(s.b) - len(suffix)) {
+			return
+		}
+		s.replace(suffix, "")
+		n := len(s.b)
+		switch {
+		case s.longest([]string{"at", "bl", "iz"}) != "":
+			s.b = append(s.b, 'e')
+		case n >= 2 && s.b[n-1] == s.b[n-2] && strings.IndexByte("bdfgmnprt", s.b[n-1]) >= 0:
+			s.b = s.b[:n-1]
+		case s.r1 >= n && endsShortSyllable(s.b):
+			s.b = append(s.b, 'e')
+		}
+	}
+}
+
+func (s *stemmer) step1c() {
+	n := len(s.b)
+	if n > 2 && (s.b[n-1] == 'y' || s.b[n-1] == 'Y') && !isStemVowel(s.b[n-2]) {
+		s.b[n-1] = 'i'
+	}
+}
+
+// replaceInR1 replaces the longest of the suffixes the word ends with if it
+// is in R1 and allowed.
+func (s *stemmer) replaceInR1(suffixes map[string]string, allowed func(suffix string) bool) {
+	suffix := s.longest(slices.Collect(maps.Keys(suffixes)))
+	if suffix != "" && s.inR1(suffix) && allowed(suffix) {
+		s.replace(suffix, suffixes[suffix])
+	}
+}
+
+func (s *stemmer) step2Allowed(suffix string) bool {
+	before := s.b[len(s.b)-len(suffix)-1]
+	switch suffix {
+	case "ogi":
+		return before == 'l'
+	case "li":
+		return strings.IndexByte("cdeghkmnrt", before) >= 0
+	}
+	return true
+}
+
+func (s *stemmer) step3Allowed(suffix string) bool {
+	return suffix != "ative" || s.inR2(suffix)
+}
+
+func (s *stemmer) step4() {
+	suffix := s.longest(step4Suffixes)
+	if suffix == "" || !s.inR2(suffix) {
+		return
+	}
+	if suffix == "ion" {
+		if c := s.b[len(s.b)-4]; c != 's' && c != 't' {
+			return
+		}
+	}
+	s.replace(suffix, "")
+}
+
+func (s *stemmer) step5() {
+	n := len(s.b)
+	switch s.b[n-1] {
+	case 'e':
+		if s.inR2("e") || s.inR1("e") && !endsShortSyllable(s.b[:n-1]) {
+			s.b = s.b[:n-1]
+		}
+	case 'l':
+		if s.inR2("l") && s.b[n-2] == 'l' {
+			s.b = s.b[:n-1]
+		}
+	}
+}
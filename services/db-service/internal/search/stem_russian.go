@@ -0,0 +1,143 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// stemRussian stems a lower-case word with the Snowball Russian algorithm,
+// which treats ё as е. Every ending it removes lies in RV, the part of the
+// word after its first vowel. The steps follow
+// https://snowballstem.org/algorithms/russian/stemmer.html.
+func stemRussian(word string) string {
+	s := &russianStemmer{w: strings.ReplaceAll(word, "ё", "е")}
+	s.findRegions()
+
+	// Step 1.
+	if !s.removeEnding(perfectiveGerund1, perfectiveGerund2) {
+		s.removeEnding(nil, reflexiveEndings)
+		if !s.removeAdjectival() && !s.removeEnding(verbEndings1, verbEndings2) {
+			s.removeEnding(nil, nounEndings)
+		}
+	}
+	// Step 2.
+	s.removeEnding(nil, []string{"и"})
+	// Step 3: a derivational ending in R2.
+	if ending := s.longest([]string{"ост", "ость"}); ending != "" && len(s.w)-len(ending) >= s.r2 {
+		s.w = s.w[:len(s.w)-len(ending)]
+	}
+	// Step 4.
+	switch ending := s.longest([]string{"ейш", "ейше", "н", "ь"}); ending {
+	case "ейш", "ейше":
+		s.w = s.w[:len(s.w)-len(ending)]
+		s.undoubleN()
+	case "н":
+		s.undoubleN()
+	case "ь":
+		s.w = s.w[:len(s.w)-len(ending)]
+	}
+	return s.w
+}
+
+// The endings in the first group of a pair only count after а or я, which
+// stays part of the stem.
+var (
+	perfectiveGerund1 = []string{"в", "вши", "вшись"}
+	perfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+	adjectiveEndings  = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	participleEndings1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	participleEndings2 = []string{"ивш", "ывш", "ующ"}
+	reflexiveEndings   = []string{"ся", "сь"}
+	verbEndings1       = []string{"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно"}
+	verbEndings2       = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+	nounEndings = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия", "ья", "я",
+	}
+)
+
+type russianStemmer struct {
+	w string
+	// rv and r2 are the byte offsets where the RV and R2 regions start.
+	rv, r2 int
+}
+
+func isRussianVowel(r rune) bool {
+	return strings.ContainsRune("аеиоуыэюя", r)
+}
+
+func (s *russianStemmer) findRegions() {
+	s.rv, s.r2 = len(s.w), len(s.w)
+	pos := 0
+	// skipPast moves pos past the next vowel or non-vowel.
+	skipPast := func(vowel bool) bool {
+		for pos < len(s.w) {
+			r, size := utf8.DecodeRuneInString(s.w[pos:])
+			pos += size
+			if isRussianVowel(r) == vowel {
+				return true
+			}
+		}
+		return false
+	}
+	if !skipPast(true) {
+		return
+	}
+	s.rv = pos
+	// R1 starts after the following non-vowel, R2 after the next vowel and
+	// non-vowel.
+	if skipPast(false) && skipPast(true) && skipPast(false) {
+		s.r2 = pos
+	}
+}
+
+// longest returns the longest of the endings the word ends with in RV.
+func (s *russianStemmer) longest(endings []string) string {
+	found := ""
+	for _, ending := range endings {
+		if len(ending) > len(found) && strings.HasSuffix(s.w, ending) && len(s.w)-len(ending) >= s.rv {
+			found = ending
+		}
+	}
+	return found
+}
+
+// removeEnding removes the longest of the endings in either group and
+// reports whether it did. Like a Snowball among, it gives up rather than try
+// a shorter ending when the longest one does not follow а or я as required.
+func (s *russianStemmer) removeEnding(afterA, other []string) bool {
+	ending := s.longest(slices.Concat(afterA, other))
+	if ending == "" {
+		return false
+	}
+	rest := s.w[:len(s.w)-len(ending)]
+	if slices.Contains(afterA, ending) &&
+		!((strings.HasSuffix(rest, "а") || strings.HasSuffix(rest, "я")) && len(rest)-len("а") >= s.rv) {
+		return false
+	}
+	s.w = rest
+	return true
+}
+
+// removeAdjectival removes an adjective ending together with a participle
+// ending before it.
+func (s *russianStemmer) removeAdjectival() bool {
+	if !s.removeEnding(nil, adjectiveEndings) {
+		return false
+	}
+	s.removeEnding(participleEndings1, participleEndings2)
+	return true
+}
+
+func (s *russianStemmer) undoubleN() {
+	if strings.HasSuffix(s.w, "нн") && len(s.w)-len("нн") >= s.rv {
+		s.w = s.w[:len(s.w)-len("н")]
+	}
+}
@@ -0,0 +1,50 @@
+package search
+
+import "testing"
+
+func TestStem(t *testing.T) {
+	// From the Snowball English sample vocabulary.
+	tests := map[string]string{
+		"consign": "consign", "consigned": "consign", "consignment": "consign",
+		"consistency": "consist", "consistently": "consist", "consists": "consist",
+		"consolation": "consol", "consolatory": "consolatori", "consoled": "consol",
+		"consolidating": "consolid", "consolingly": "consol", "consonant": "conson",
+		"conspicuously": "conspicu", "conspiracy": "conspiraci", "conspirators": "conspir",
+		"constable": "constabl", "constancy": "constanc", "constant": "constant",
+		"running": "run", "runs": "run", "tasks": "task", "connection": "connect",
+		"connected": "connect", "generously": "generous", "cries": "cri", "ties": "tie",
+		"gas": "gas", "gaps": "gap", "happy": "happi", "hopping": "hop", "hoped": "hope",
+		"invoices": "invoic", "feed": "feed", "agreed": "agre", "dying": "die",
+		"news": "news", "proceed": "proceed", "skies": "sky", "yelling": "yell",
+		"sayings": "say", "controlling": "control", "rationalization": "ration",
+		"hopeful": "hope", "goodness": "good", "electrical": "electr", "formative": "format",
+		// Left alone: short or with digits.
+		"go": "go", "v2": "v2", "задача2": "задача2",
+	}
+	for word, want := range tests {
+		if got := stem(word); got != want {
+			t.Errorf("stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestStemRussian(t *testing.T) {
+	// From the Snowball Russian sample vocabulary.
+	tests := map[string]string{
+		"в": "в", "вавиловка": "вавиловк", "вагон": "вагон", "вагона": "вагон", "вагоне": "вагон",
+		"вагонов": "вагон", "вагоном": "вагон", "вагоны": "вагон", "важная": "важн", "важнее": "важн",
+		"важнейшие": "важн", "важнейшими": "важн", "важничал": "важнича", "важно": "важн",
+		"важного": "важн", "важное": "важн", "важной": "важн", "важном": "важн", "важному": "важн",
+		"важную": "важн", "важны": "важн", "важных": "важн", "вазах": "ваз", "вазы": "ваз",
+		"вакса": "вакс", "вал": "вал", "валандался": "валанда", "валентина": "валентин",
+		"валерьян": "валерья", "валерьяны": "валерья",
+		// ё is read as е; -ость is only removed in R2.
+		"задача": "задач", "задачи": "задач", "задачами": "задач", "ёлка": "елк",
+		"радость": "радост", "прочитав": "прочита", "прочитавшись": "прочита",
+	}
+	for word, want := range tests {
+		if got := stem(word); got != want {
+			t.Errorf("stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
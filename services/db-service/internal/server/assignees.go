@@ -0,0 +1,145 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxUserIDLength matches the user_id columns of checklist_members and task_assignees.
+const maxUserIDLength = 255
+
+func (s *GRPCServer) AddChecklistMember(ctx context.Context, req *pb.ChecklistMemberRequest) (*pb.ChecklistMember, error) {
+	slog.InfoContext(ctx, "Received AddChecklistMember request", "checklist_id", req.ChecklistId, "user_id", req.UserId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+	userID, err := normalizeUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.storage.AddChecklistMember(ctx, req.ChecklistId, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error adding member to checklist", "user_id", userID, "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to add checklist member")
+	}
+
+	slog.InfoContext(ctx, "Successfully added member to checklist", "user_id", userID, "checklist_id", req.ChecklistId)
+	return member, nil
+}
+
+func (s *GRPCServer) RemoveChecklistMember(ctx context.Context, req *pb.ChecklistMemberRequest) (*pb.RemoveChecklistMemberResponse, error) {
+	slog.InfoContext(ctx, "Received RemoveChecklistMember request", "checklist_id", req.ChecklistId, "user_id", req.UserId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+	userID, err := normalizeUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.RemoveChecklistMember(ctx, req.ChecklistId, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist member not found")
+		}
+		slog.ErrorContext(ctx, "Error removing member from checklist", "user_id", userID, "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to remove checklist member")
+	}
+
+	slog.InfoContext(ctx, "Successfully removed member from checklist", "user_id", userID, "checklist_id", req.ChecklistId)
+	return &pb.RemoveChecklistMemberResponse{Success: true}, nil
+}
+
+func (s *GRPCServer) ListChecklistMembers(ctx context.Context, req *pb.ListChecklistMembersRequest) (*pb.ListChecklistMembersResponse, error) {
+	slog.InfoContext(ctx, "Received ListChecklistMembers request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	members, err := s.storage.ListChecklistMembers(ctx, req.ChecklistId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing members of checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to list checklist members")
+	}
+
+	return &pb.ListChecklistMembersResponse{Members: members}, nil
+}
+
+func (s *GRPCServer) AssignTask(ctx context.Context, req *pb.TaskAssigneeRequest) (*pb.Task, error) {
+	slog.InfoContext(ctx, "Received AssignTask request", "task_id", req.TaskId, "user_id", req.UserId)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	userID, err := normalizeUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.AssignTask(ctx, req.TaskId, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		if errors.Is(err, storage.ErrNotMember) {
+			return nil, status.Error(codes.FailedPrecondition, "user is not a member of the task's checklist")
+		}
+		slog.ErrorContext(ctx, "Error assigning task", "task_id", req.TaskId, "user_id", userID, "error", err)
+		return nil, status.Error(codes.Internal, "failed to assign task")
+	}
+
+	slog.InfoContext(ctx, "Successfully assigned task", "task_id", req.TaskId, "user_id", userID)
+	return s.getUpdatedTask(ctx, req.TaskId)
+}
+
+func (s *GRPCServer) UnassignTask(ctx context.Context, req *pb.TaskAssigneeRequest) (*pb.Task, error) {
+	slog.InfoContext(ctx, "Received UnassignTask request", "task_id", req.TaskId, "user_id", req.UserId)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	userID, err := normalizeUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.UnassignTask(ctx, req.TaskId, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "assignment not found")
+		}
+		slog.ErrorContext(ctx, "Error unassigning task", "task_id", req.TaskId, "user_id", userID, "error", err)
+		return nil, status.Error(codes.Internal, "failed to unassign task")
+	}
+
+	slog.InfoContext(ctx, "Successfully unassigned task", "task_id", req.TaskId, "user_id", userID)
+	return s.getUpdatedTask(ctx, req.TaskId)
+}
+
+// normalizeUserID trims the user ID and checks that it fits the database
+// columns. User IDs are opaque: they come from the caller's identity provider.
+func normalizeUserID(userID string) (string, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return "", status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if utf8.RuneCountInString(userID) > maxUserIDLength {
+		return "", status.Errorf(codes.InvalidArgument, "user ID must be at most %d characters", maxUserIDLength)
+	}
+	return userID, nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxFilenameLength   = 255
+	maxStorageKeyLength = 512
+)
+
+func (s *GRPCServer) CreateAttachment(ctx context.Context, req *pb.CreateAttachmentRequest) (*pb.Attachment, error) {
+	slog.InfoContext(ctx, "Received CreateAttachment request", "task_id", req.TaskId, "filename", req.Filename, "size_bytes", req.SizeBytes)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	if err := validateAttachment(req); err != nil {
+		return nil, err
+	}
+	if req.UploadedBy != "" {
+		uploadedBy, err := normalizeUserID(req.UploadedBy)
+		if err != nil {
+			return nil, err
+		}
+		req.UploadedBy = uploadedBy
+	}
+
+	attachment, err := s.storage.CreateAttachment(ctx, &pb.Attachment{
+		TaskId:      req.TaskId,
+		Filename:    req.Filename,
+		ContentType: req.ContentType,
+		SizeBytes:   req.SizeBytes,
+		Sha256:      strings.ToLower(req.Sha256),
+		StorageKey:  req.StorageKey,
+		UploadedBy:  req.UploadedBy,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		slog.ErrorContext(ctx, "Error creating attachment for task", "task_id", req.TaskId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to create attachment")
+	}
+
+	slog.InfoContext(ctx, "Successfully created attachment for task", "attachment_id", attachment.Id, "task_id", req.TaskId)
+	return attachment, nil
+}
+
+func (s *GRPCServer) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	slog.InfoContext(ctx, "Received ListAttachments request", "task_id", req.TaskId)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.storage.ListAttachments(ctx, req.TaskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing attachments of task", "task_id", req.TaskId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to list attachments")
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: attachments}, nil
+}
+
+func (s *GRPCServer) GetAttachment(ctx context.Context, req *pb.AttachmentActionRequest) (*pb.Attachment, error) {
+	slog.InfoContext(ctx, "Received GetAttachment request", "attachment_id", req.Id)
+
+	if err := validateID("attachment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.storage.GetAttachment(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "attachment not found")
+		}
+		slog.ErrorContext(ctx, "Error getting attachment", "attachment_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get attachment")
+	}
+
+	return attachment, nil
+}
+
+func (s *GRPCServer) DeleteAttachment(ctx context.Context, req *pb.AttachmentActionRequest) (*pb.Attachment, error) {
+	slog.InfoContext(ctx, "Received DeleteAttachment request", "attachment_id", req.Id)
+
+	if err := validateID("attachment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.storage.DeleteAttachment(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "attachment not found")
+		}
+		slog.ErrorContext(ctx, "Error deleting attachment", "attachment_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete attachment")
+	}
+
+	slog.InfoContext(ctx, "Successfully deleted attachment", "attachment_id", req.Id)
+	return attachment, nil
+}
+
+func validateAttachment(req *pb.CreateAttachmentRequest) error {
+	if req.Filename == "" || utf8.RuneCountInString(req.Filename) > maxFilenameLength {
+		return status.Errorf(codes.InvalidArgument, "filename must be 1 to %d characters", maxFilenameLength)
+	}
+	if req.ContentType == "" {
+		return status.Error(codes.InvalidArgument, "content type is required")
+	}
+	if req.SizeBytes < 0 {
+		return status.Error(codes.InvalidArgument, "size must not be negative")
+	}
+	if sum, err := hex.DecodeString(req.Sha256); err != nil || len(sum) != 32 {
+		return status.Error(codes.InvalidArgument, "sha256 must be a hex-encoded SHA-256 digest")
+	}
+	if req.StorageKey == "" || len(req.StorageKey) > maxStorageKeyLength {
+		return status.Errorf(codes.InvalidArgument, "storage key must be 1 to %d bytes", maxStorageKeyLength)
+	}
+	return nil
+}
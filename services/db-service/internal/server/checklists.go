@@ -0,0 +1,74 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) CreateChecklist(ctx context.Context, req *pb.CreateChecklistRequest) (*pb.Checklist, error) {
+	slog.InfoContext(ctx, "Received CreateChecklist request", "title", req.Title)
+
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	checklist, err := s.storage.CreateChecklist(ctx, req.Title, req.Description)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error creating checklist", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create checklist")
+	}
+
+	slog.InfoContext(ctx, "Successfully created checklist", "checklist_id", checklist.Id)
+	return checklist, nil
+}
+
+func (s *GRPCServer) ListChecklists(ctx context.Context, req *pb.ListChecklistsRequest) (*pb.ListChecklistsResponse, error) {
+	slog.InfoContext(ctx, "Received ListChecklists request")
+
+	checklists, err := s.storage.ListChecklists(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing checklists", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list checklists")
+	}
+
+	slog.InfoContext(ctx, "Successfully listed checklists", "count", len(checklists))
+	return &pb.ListChecklistsResponse{Checklists: checklists}, nil
+}
+
+func (s *GRPCServer) GetChecklist(ctx context.Context, req *pb.ChecklistActionRequest) (*pb.Checklist, error) {
+	slog.InfoContext(ctx, "Received GetChecklist request", "checklist_id", req.Id)
+
+	if err := validateID("checklist ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	checklist, err := s.storage.GetChecklist(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error getting checklist", "checklist_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get checklist")
+	}
+
+	return checklist, nil
+}
+
+// validateID checks that id is a well-formed UUID so malformed input is
+// reported as InvalidArgument instead of surfacing as a database error.
+func validateID(name, id string) error {
+	if id == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", name)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s must be a valid UUID", name)
+	}
+	return nil
+}
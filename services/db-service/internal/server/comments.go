@@ -0,0 +1,198 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCommentPageSize = 50
+	maxCommentPageSize     = 200
+	maxCommentLength       = 10000
+)
+
+// CommentServer implements CommentService on top of the same storage as
+// GRPCServer.
+type CommentServer struct {
+	pb.UnimplementedCommentServiceServer
+	storage storage.TaskRepository
+}
+
+func NewCommentServer(storage storage.TaskRepository) *CommentServer {
+	return &CommentServer{storage: storage}
+}
+
+func (s *CommentServer) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*pb.Comment, error) {
+	slog.InfoContext(ctx, "Received AddComment request", "task_id", req.TaskId, "author_id", req.AuthorId)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	authorID, err := normalizeUserID(req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCommentBody(req.Body); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.storage.AddComment(ctx, req.TaskId, authorID, req.Body)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		slog.ErrorContext(ctx, "Error adding comment to task", "task_id", req.TaskId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to add comment")
+	}
+
+	slog.InfoContext(ctx, "Successfully added comment to task", "comment_id", comment.Id, "task_id", req.TaskId)
+	return comment, nil
+}
+
+func (s *CommentServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	slog.InfoContext(ctx, "Received ListComments request", "task_id", req.TaskId)
+
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultCommentPageSize
+	case pageSize > maxCommentPageSize:
+		pageSize = maxCommentPageSize
+	}
+
+	var after *storage.CommentCursor
+	if req.PageToken != "" {
+		cursor, err := decodeCommentPageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		after = cursor
+	}
+
+	// Fetch one extra comment to find out whether there is a next page.
+	comments, err := s.storage.ListComments(ctx, req.TaskId, after, pageSize+1)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing comments of task", "task_id", req.TaskId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to list comments")
+	}
+
+	res := &pb.ListCommentsResponse{Comments: comments}
+	if len(comments) > pageSize {
+		res.Comments = comments[:pageSize]
+		last := res.Comments[pageSize-1]
+		res.NextPageToken = encodeCommentPageToken(last.CreatedAt.AsTime(), last.Id)
+	}
+	return res, nil
+}
+
+func (s *CommentServer) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.Comment, error) {
+	slog.InfoContext(ctx, "Received EditComment request", "comment_id", req.Id, "author_id", req.AuthorId)
+
+	if err := validateID("comment ID", req.Id); err != nil {
+		return nil, err
+	}
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	authorID, err := normalizeUserID(req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCommentBody(req.Body); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.storage.EditComment(ctx, req.Id, req.TaskId, authorID, req.Body)
+	if err != nil {
+		return nil, commentError(ctx, "edit", req.Id, err)
+	}
+
+	slog.InfoContext(ctx, "Successfully edited comment", "comment_id", req.Id)
+	return comment, nil
+}
+
+func (s *CommentServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	slog.InfoContext(ctx, "Received DeleteComment request", "comment_id", req.Id, "author_id", req.AuthorId)
+
+	if err := validateID("comment ID", req.Id); err != nil {
+		return nil, err
+	}
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return nil, err
+	}
+	authorID, err := normalizeUserID(req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.DeleteComment(ctx, req.Id, req.TaskId, authorID); err != nil {
+		return nil, commentError(ctx, "delete", req.Id, err)
+	}
+
+	slog.InfoContext(ctx, "Successfully deleted comment", "comment_id", req.Id)
+	return &pb.DeleteCommentResponse{Success: true}, nil
+}
+
+func commentError(ctx context.Context, action string, id string, err error) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Error(codes.NotFound, "comment not found")
+	}
+	if errors.Is(err, storage.ErrNotAuthor) {
+		return status.Errorf(codes.PermissionDenied, "only the author can %s a comment", action)
+	}
+	slog.ErrorContext(ctx, "Error changing comment", "action", action, "comment_id", id, "error", err)
+	return status.Errorf(codes.Internal, "failed to %s comment", action)
+}
+
+func validateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return status.Error(codes.InvalidArgument, "body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return status.Errorf(codes.InvalidArgument, "body must be at most %d characters", maxCommentLength)
+	}
+	return nil
+}
+
+// encodeCommentPageToken makes an opaque token from the position of the last
+// comment on a page.
+func encodeCommentPageToken(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(createdAt.UnixNano(), 10) + "." + id))
+}
+
+func decodeCommentPageToken(token string) (*storage.CommentCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, errors.New("malformed page token")
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, err
+	}
+	return &storage.CommentCursor{CreatedAt: time.Unix(0, n), ID: id}, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) AddDependency(ctx context.Context, req *pb.TaskDependencyRequest) (*pb.Task, error) {
+	slog.InfoContext(ctx, "Received AddDependency request", "task_id", req.TaskId, "depends_on_id", req.DependsOnId)
+
+	if err := validateDependency(req); err != nil {
+		return nil, err
+	}
+
+	err := s.storage.AddDependency(ctx, req.TaskId, req.DependsOnId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		if errors.Is(err, storage.ErrDependencyCycle) {
+			slog.WarnContext(ctx, "Rejected dependency: would create a cycle", "task_id", req.TaskId, "depends_on_id", req.DependsOnId)
+			return nil, status.Error(codes.FailedPrecondition, "dependency would create a cycle")
+		}
+		slog.ErrorContext(ctx, "Error adding dependency", "task_id", req.TaskId, "depends_on_id", req.DependsOnId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to add dependency")
+	}
+
+	slog.InfoContext(ctx, "Successfully added dependency", "task_id", req.TaskId, "depends_on_id", req.DependsOnId)
+	return s.getUpdatedTask(ctx, req.TaskId)
+}
+
+func (s *GRPCServer) RemoveDependency(ctx context.Context, req *pb.TaskDependencyRequest) (*pb.Task, error) {
+	slog.InfoContext(ctx, "Received RemoveDependency request", "task_id", req.TaskId, "depends_on_id", req.DependsOnId)
+
+	if err := validateDependency(req); err != nil {
+		return nil, err
+	}
+
+	err := s.storage.RemoveDependency(ctx, req.TaskId, req.DependsOnId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "dependency not found")
+		}
+		slog.ErrorContext(ctx, "Error removing dependency", "task_id", req.TaskId, "depends_on_id", req.DependsOnId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to remove dependency")
+	}
+
+	slog.InfoContext(ctx, "Successfully removed dependency", "task_id", req.TaskId, "depends_on_id", req.DependsOnId)
+	return s.getUpdatedTask(ctx, req.TaskId)
+}
+
+func validateDependency(req *pb.TaskDependencyRequest) error {
+	if err := validateID("task ID", req.TaskId); err != nil {
+		return err
+	}
+	if err := validateID("depends_on ID", req.DependsOnId); err != nil {
+		return err
+	}
+	if req.TaskId == req.DependsOnId {
+		return status.Error(codes.InvalidArgument, "a task cannot depend on itself")
+	}
+	return nil
+}
+
+// getUpdatedTask re-reads a task after a successful change so the response
+// reflects computed fields such as blocked.
+func (s *GRPCServer) getUpdatedTask(ctx context.Context, id string) (*pb.Task, error) {
+	task, err := s.storage.GetTask(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error fetching updated task", "task_id", id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve updated task")
+	}
+	return task, nil
+}
@@ -5,7 +5,9 @@ import (
 	"checklist-go/services/db-service/internal/storage"
 	"context"
 	"errors"
-	"log"
+	"log/slog"
+	"slices"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,45 +15,91 @@ import (
 
 type GRPCServer struct {
 	pb.UnimplementedChecklistServiceServer
-	storage *storage.Storage
+	storage storage.TaskRepository
+	opts    Options
 }
 
-func NewGRPCServer(storage *storage.Storage) *GRPCServer {
-	return &GRPCServer{storage: storage}
+// Options tune the behaviour of GRPCServer.
+type Options struct {
+	// EnforceDependencies makes MarkTaskDone refuse to complete a task whose
+	// prerequisites are still open.
+	EnforceDependencies bool
+}
+
+func NewGRPCServer(storage storage.TaskRepository, opts Options) *GRPCServer {
+	return &GRPCServer{storage: storage, opts: opts}
 }
 
 func (s *GRPCServer) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.Task, error) {
-	log.Printf("Received CreateTask request: title=%s", req.Title)
+	slog.InfoContext(ctx, "Received CreateTask request", "title", req.Title)
 
 	if req.Title == "" {
 		return nil, status.Error(codes.InvalidArgument, "title is required")
 	}
 
-	task, err := s.storage.CreateTask(ctx, req.Title, req.Description)
+	if req.ChecklistId != "" {
+		if err := validateID("checklist ID", req.ChecklistId); err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := s.storage.CreateTask(ctx, &pb.Task{
+		Title:       req.Title,
+		Description: req.Description,
+		ChecklistId: req.ChecklistId,
+		DueAt:       req.DueAt,
+		Tags:        normalizeTags(req.Tags),
+	})
 	if err != nil {
-		log.Printf("Error creating task: %v", err)
+		if errors.Is(err, storage.ErrNotFound) {
+			slog.WarnContext(ctx, "Checklist not found for new task", "checklist_id", req.ChecklistId)
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error creating task", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create task")
 	}
 
-	log.Printf("Successfully created task with ID: %s", task.Id)
+	slog.InfoContext(ctx, "Successfully created task", "task_id", task.Id)
 	return task, nil
 }
 
 func (s *GRPCServer) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
-	log.Println("Received ListTasks request")
+	slog.InfoContext(ctx, "Received ListTasks request")
 
-	tasks, err := s.storage.ListTasks(ctx)
+	if req.ChecklistId != "" {
+		if err := validateID("checklist ID", req.ChecklistId); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := storage.TaskFilter{ChecklistID: req.ChecklistId, OpenOnly: req.OpenOnly}
+	if req.Filter != "" {
+		expr, err := parseFilter(req.Filter)
+		if err != nil {
+			return nil, err
+		}
+		filter.Expression = expr
+	}
+	if req.AssigneeId != "" {
+		assigneeID, err := normalizeUserID(req.AssigneeId)
+		if err != nil {
+			return nil, err
+		}
+		filter.AssigneeID = assigneeID
+	}
+
+	tasks, err := s.storage.ListTasks(ctx, filter)
 	if err != nil {
-		log.Printf("Error listing tasks: %v", err)
+		slog.ErrorContext(ctx, "Error listing tasks", "error", err)
 		return nil, status.Error(codes.Internal, "failed to list tasks")
 	}
 
-	log.Printf("Successfully listed %d tasks", len(tasks))
+	slog.InfoContext(ctx, "Successfully listed tasks", "count", len(tasks))
 	return &pb.ListTasksResponse{Tasks: tasks}, nil
 }
 
 func (s *GRPCServer) DeleteTask(ctx context.Context, req *pb.TaskActionRequest) (*pb.DeleteTaskResponse, error) {
-	log.Printf("Received DeleteTask request for ID: %s", req.Id)
+	slog.InfoContext(ctx, "Received DeleteTask request", "task_id", req.Id)
 
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "task ID is required")
@@ -60,31 +108,35 @@ func (s *GRPCServer) DeleteTask(ctx context.Context, req *pb.TaskActionRequest)
 	err := s.storage.DeleteTask(ctx, req.Id)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			log.Printf("Task with ID %s not found for deletion", req.Id)
+			slog.WarnContext(ctx, "Task not found for deletion", "task_id", req.Id)
 			return nil, status.Error(codes.NotFound, "task not found")
 		}
-		log.Printf("Error deleting task %s: %v", req.Id, err)
+		slog.ErrorContext(ctx, "Error deleting task", "task_id", req.Id, "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete task")
 	}
 
-	log.Printf("Successfully deleted task with ID: %s", req.Id)
+	slog.InfoContext(ctx, "Successfully deleted task", "task_id", req.Id)
 	return &pb.DeleteTaskResponse{Success: true}, nil
 }
 
 func (s *GRPCServer) MarkTaskDone(ctx context.Context, req *pb.TaskActionRequest) (*pb.Task, error) {
-	log.Printf("Received MarkTaskDone request for ID: %s", req.Id)
+	slog.InfoContext(ctx, "Received MarkTaskDone request", "task_id", req.Id)
 
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "task ID is required")
 	}
 
-	err := s.storage.MarkTaskDone(ctx, req.Id)
+	err := s.storage.MarkTaskDone(ctx, req.Id, s.opts.EnforceDependencies)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			log.Printf("Task with ID %s not found for completion", req.Id)
+			slog.WarnContext(ctx, "Task not found for completion", "task_id", req.Id)
 			return nil, status.Error(codes.NotFound, "task not found")
 		}
-		log.Printf("Error marking task %s as done: %v", req.Id, err)
+		if errors.Is(err, storage.ErrTaskBlocked) {
+			slog.WarnContext(ctx, "Task has open prerequisites", "task_id", req.Id)
+			return nil, status.Error(codes.FailedPrecondition, "task has open prerequisites")
+		}
+		slog.ErrorContext(ctx, "Error marking task as done", "task_id", req.Id, "error", err)
 		return nil, status.Error(codes.Internal, "failed to mark task as done")
 	}
 
@@ -92,10 +144,23 @@ func (s *GRPCServer) MarkTaskDone(ctx context.Context, req *pb.TaskActionRequest
 	updatedTask, err := s.storage.GetTask(ctx, req.Id)
 	if err != nil {
 		// This should ideally not happen if the update succeeded
-		log.Printf("Error fetching updated task %s: %v", req.Id, err)
+		slog.ErrorContext(ctx, "Error fetching updated task", "task_id", req.Id, "error", err)
 		return nil, status.Error(codes.Internal, "failed to retrieve updated task")
 	}
 
-	log.Printf("Successfully marked task %s as done", req.Id)
+	slog.InfoContext(ctx, "Successfully marked task as done", "task_id", req.Id)
 	return updatedTask, nil
-}
\ No newline at end of file
+}
+
+// normalizeTags trims tags and drops empty and duplicate ones, keeping the
+// original order.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
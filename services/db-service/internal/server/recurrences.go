@@ -0,0 +1,106 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/recurrence"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *GRPCServer) SetRecurrence(ctx context.Context, req *pb.SetRecurrenceRequest) (*pb.Recurrence, error) {
+	slog.InfoContext(ctx, "Received SetRecurrence request", "task_id", req.TaskId, "checklist_id", req.ChecklistId, "rrule", req.Rrule)
+
+	if (req.TaskId == "") == (req.ChecklistId == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of task ID and checklist ID is required")
+	}
+	if req.TaskId != "" {
+		if err := validateID("task ID", req.TaskId); err != nil {
+			return nil, err
+		}
+	} else if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+	if req.Rrule == "" {
+		return nil, status.Error(codes.InvalidArgument, "rrule is required")
+	}
+
+	now := time.Now()
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	dtstart := now
+	if req.Dtstart != nil {
+		dtstart = req.Dtstart.AsTime()
+	}
+
+	rule, err := recurrence.Parse(req.Rrule, dtstart, timezone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	next, ok := rule.After(now)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "rrule has no future occurrences")
+	}
+
+	rec, err := s.storage.UpsertRecurrence(ctx, &pb.Recurrence{
+		TaskId:           req.TaskId,
+		ChecklistId:      req.ChecklistId,
+		Rrule:            req.Rrule,
+		Dtstart:          timestamppb.New(dtstart),
+		Timezone:         timezone,
+		NextOccurrenceAt: timestamppb.New(next),
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			if req.TaskId != "" {
+				return nil, status.Error(codes.NotFound, "task not found")
+			}
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error saving recurrence", "error", err)
+		return nil, status.Error(codes.Internal, "failed to save recurrence")
+	}
+
+	slog.InfoContext(ctx, "Successfully saved recurrence", "recurrence_id", rec.Id, "next_at", next)
+	return rec, nil
+}
+
+func (s *GRPCServer) ListRecurrences(ctx context.Context, req *pb.ListRecurrencesRequest) (*pb.ListRecurrencesResponse, error) {
+	slog.InfoContext(ctx, "Received ListRecurrences request")
+
+	recurrences, err := s.storage.ListRecurrences(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing recurrences", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list recurrences")
+	}
+
+	return &pb.ListRecurrencesResponse{Recurrences: recurrences}, nil
+}
+
+func (s *GRPCServer) DeleteRecurrence(ctx context.Context, req *pb.DeleteRecurrenceRequest) (*pb.DeleteRecurrenceResponse, error) {
+	slog.InfoContext(ctx, "Received DeleteRecurrence request", "recurrence_id", req.Id)
+
+	if err := validateID("recurrence ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	err := s.storage.DeleteRecurrence(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "recurrence not found")
+		}
+		slog.ErrorContext(ctx, "Error deleting recurrence", "recurrence_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete recurrence")
+	}
+
+	slog.InfoContext(ctx, "Successfully deleted recurrence", "recurrence_id", req.Id)
+	return &pb.DeleteRecurrenceResponse{Success: true}, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/search"
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *GRPCServer) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+	slog.InfoContext(ctx, "Received SearchTasks request", "query", req.Query)
+
+	tsquery, err := search.ParseQuery(req.Query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.ChecklistId != "" {
+		if err := validateID("checklist ID", req.ChecklistId); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	results, err := s.storage.SearchTasks(ctx, tsquery, req.ChecklistId, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error searching tasks", "query", req.Query, "error", err)
+		return nil, status.Error(codes.Internal, "failed to search tasks")
+	}
+
+	for _, result := range results {
+		result.TitleHighlight = search.HighlightHTML(result.TitleHighlight)
+		result.DescriptionSnippet = search.HighlightHTML(result.DescriptionSnippet)
+		result.CommentSnippet = search.HighlightHTML(result.CommentSnippet)
+	}
+
+	slog.InfoContext(ctx, "Search finished", "query", req.Query, "count", len(results))
+	return &pb.SearchTasksResponse{Results: results}, nil
+}
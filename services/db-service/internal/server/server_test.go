@@ -0,0 +1,264 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/server"
+	"checklist-go/services/db-service/internal/storage"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dial serves GRPCServer and CommentServer over an in-memory listener on a
+// fresh MemoryStorage and returns a connection to them.
+func dial(t *testing.T, opts server.Options) *grpc.ClientConn {
+	t.Helper()
+	return dialStorage(t, storage.NewMemoryStorage(), opts)
+}
+
+// dialStorage is dial on st.
+func dialStorage(t *testing.T, st storage.TaskRepository, opts server.Options) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+
+	srv := grpc.NewServer()
+	pb.RegisterChecklistServiceServer(srv, server.NewGRPCServer(st, opts))
+	pb.RegisterCommentServiceServer(srv, server.NewCommentServer(st))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func wantCode(t *testing.T, op string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("%s: got %v (%v), want %v", op, got, err, want)
+	}
+}
+
+func TestTaskLifecycle(t *testing.T) {
+	client := pb.NewChecklistServiceClient(dial(t, server.Options{}))
+	ctx := context.Background()
+
+	task, err := client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "write tests", Tags: []string{" go ", "go", ""}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if len(task.Tags) != 1 || task.Tags[0] != "go" {
+		t.Errorf("tags = %v, want [go]", task.Tags)
+	}
+
+	list, err := client.ListTasks(ctx, &pb.ListTasksRequest{Filter: "tag:go and not done"})
+	if err != nil || len(list.Tasks) != 1 {
+		t.Fatalf("ListTasks = %v, %v, want the task", list, err)
+	}
+
+	done, err := client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: task.Id})
+	if err != nil || !done.Done || done.CompletedAt == nil {
+		t.Fatalf("MarkTaskDone = %v, %v", done, err)
+	}
+
+	if _, err := client.DeleteTask(ctx, &pb.TaskActionRequest{Id: task.Id}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	_, err = client.DeleteTask(ctx, &pb.TaskActionRequest{Id: task.Id})
+	wantCode(t, "DeleteTask(deleted)", err, codes.NotFound)
+	_, err = client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: task.Id})
+	wantCode(t, "MarkTaskDone(deleted)", err, codes.NotFound)
+}
+
+func TestValidation(t *testing.T) {
+	client := pb.NewChecklistServiceClient(dial(t, server.Options{}))
+	ctx := context.Background()
+
+	_, err := client.CreateTask(ctx, &pb.CreateTaskRequest{})
+	wantCode(t, "CreateTask(no title)", err, codes.InvalidArgument)
+	_, err = client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "x", ChecklistId: "not-a-uuid"})
+	wantCode(t, "CreateTask(bad checklist ID)", err, codes.InvalidArgument)
+	_, err = client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "x", ChecklistId: uuid.NewString()})
+	wantCode(t, "CreateTask(missing checklist)", err, codes.NotFound)
+	_, err = client.GetChecklist(ctx, &pb.ChecklistActionRequest{Id: "not-a-uuid"})
+	wantCode(t, "GetChecklist(bad ID)", err, codes.InvalidArgument)
+	_, err = client.ListTasks(ctx, &pb.ListTasksRequest{Filter: "due<"})
+	wantCode(t, "ListTasks(bad filter)", err, codes.InvalidArgument)
+	_, err = client.DeleteTask(ctx, &pb.TaskActionRequest{})
+	wantCode(t, "DeleteTask(no ID)", err, codes.InvalidArgument)
+}
+
+func TestDependencies(t *testing.T) {
+	client := pb.NewChecklistServiceClient(dial(t, server.Options{EnforceDependencies: true}))
+	ctx := context.Background()
+
+	first, _ := client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "first"})
+	second, _ := client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "second"})
+
+	blocked, err := client.AddDependency(ctx, &pb.TaskDependencyRequest{TaskId: second.Id, DependsOnId: first.Id})
+	if err != nil || !blocked.Blocked {
+		t.Fatalf("AddDependency = %v, %v, want a blocked task", blocked, err)
+	}
+	_, err = client.AddDependency(ctx, &pb.TaskDependencyRequest{TaskId: first.Id, DependsOnId: second.Id})
+	wantCode(t, "AddDependency(cycle)", err, codes.FailedPrecondition)
+	_, err = client.AddDependency(ctx, &pb.TaskDependencyRequest{TaskId: first.Id, DependsOnId: first.Id})
+	wantCode(t, "AddDependency(self)", err, codes.InvalidArgument)
+	_, err = client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: second.Id})
+	wantCode(t, "MarkTaskDone(blocked)", err, codes.FailedPrecondition)
+
+	if _, err := client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: first.Id}); err != nil {
+		t.Fatalf("MarkTaskDone(first): %v", err)
+	}
+	if _, err := client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: second.Id}); err != nil {
+		t.Errorf("MarkTaskDone(second) after its prerequisite: %v", err)
+	}
+}
+
+func TestComments(t *testing.T) {
+	conn := dial(t, server.Options{})
+	tasks := pb.NewChecklistServiceClient(conn)
+	comments := pb.NewCommentServiceClient(conn)
+	ctx := context.Background()
+
+	task, _ := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Title: "discuss"})
+	comment, err := comments.AddComment(ctx, &pb.AddCommentRequest{TaskId: task.Id, AuthorId: "alice", Body: "hello"})
+	if err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+
+	_, err = comments.EditComment(ctx, &pb.EditCommentRequest{Id: comment.Id, TaskId: task.Id, AuthorId: "bob", Body: "mine now"})
+	wantCode(t, "EditComment(other author)", err, codes.PermissionDenied)
+	_, err = comments.AddComment(ctx, &pb.AddCommentRequest{TaskId: task.Id, AuthorId: "alice"})
+	wantCode(t, "AddComment(empty body)", err, codes.InvalidArgument)
+	_, err = comments.AddComment(ctx, &pb.AddCommentRequest{TaskId: uuid.NewString(), AuthorId: "alice", Body: "lost"})
+	wantCode(t, "AddComment(missing task)", err, codes.NotFound)
+
+	list, err := comments.ListComments(ctx, &pb.ListCommentsRequest{TaskId: task.Id})
+	if err != nil || len(list.Comments) != 1 || list.Comments[0].Body != "hello" {
+		t.Errorf("ListComments = %v, %v", list, err)
+	}
+}
+
+func TestStatsValidation(t *testing.T) {
+	client := pb.NewChecklistServiceClient(dial(t, server.Options{}))
+	ctx := context.Background()
+	now := time.Now()
+
+	stats, err := client.GetStats(ctx, &pb.GetStatsRequest{})
+	if err != nil {
+		t.Fatalf("GetStats(defaults): %v", err)
+	}
+	if stats.GroupBy != "day" || stats.Timezone != "UTC" || len(stats.Series) < 30 {
+		t.Errorf("GetStats(defaults) = group_by %q, timezone %q, %d buckets, want 30 days in UTC", stats.GroupBy, stats.Timezone, len(stats.Series))
+	}
+
+	for _, req := range []*pb.GetStatsRequest{
+		{GroupBy: "year"},
+		{GroupBy: "DAY"},
+		{Timezone: "Mars/Olympus_Mons"},
+		{Timezone: "Local"},
+		{From: timestamppb.New(now), To: timestamppb.New(now.Add(-time.Hour))},
+		{From: timestamppb.New(now), To: timestamppb.New(now)},
+		{From: timestamppb.New(now.AddDate(-3, 0, 0)), To: timestamppb.New(now)},
+		{ChecklistId: "not-a-uuid"},
+	} {
+		_, err := client.GetStats(ctx, req)
+		wantCode(t, fmt.Sprintf("GetStats(%v)", req), err, codes.InvalidArgument)
+	}
+	_, err = client.GetStats(ctx, &pb.GetStatsRequest{From: timestamppb.New(now.AddDate(-3, 0, 0)), To: timestamppb.New(now), GroupBy: "month"})
+	if err != nil {
+		t.Errorf("GetStats(3 years by month): %v", err)
+	}
+}
+
+// expiringLinks reports every share link as expired an hour ago once expire
+// is set, since CreateShareLink only accepts expiry times in the future.
+type expiringLinks struct {
+	storage.TaskRepository
+	expire bool
+}
+
+func (s *expiringLinks) GetShareLinkByTokenHash(ctx context.Context, tokenHash []byte) (*pb.ShareLink, string, error) {
+	link, passwordHash, err := s.TaskRepository.GetShareLinkByTokenHash(ctx, tokenHash)
+	if err == nil && s.expire {
+		link.ExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+	}
+	return link, passwordHash, err
+}
+
+func TestShareLinks(t *testing.T) {
+	st := &expiringLinks{TaskRepository: storage.NewMemoryStorage()}
+	client := pb.NewChecklistServiceClient(dialStorage(t, st, server.Options{}))
+	ctx := context.Background()
+
+	checklist, err := client.CreateChecklist(ctx, &pb.CreateChecklistRequest{Title: "release"})
+	if err != nil {
+		t.Fatalf("CreateChecklist: %v", err)
+	}
+	_, err = client.CreateShareLink(ctx, &pb.CreateShareLinkRequest{ChecklistId: checklist.Id, ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))})
+	wantCode(t, "CreateShareLink(expired)", err, codes.InvalidArgument)
+
+	link, err := client.CreateShareLink(ctx, &pb.CreateShareLinkRequest{ChecklistId: checklist.Id, Password: "hunter2",
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))})
+	if err != nil || link.Token == "" || !link.PasswordProtected {
+		t.Fatalf("CreateShareLink = %v, %v", link, err)
+	}
+
+	_, err = client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: link.Token})
+	wantCode(t, "ResolveShareLink(no password)", err, codes.Unauthenticated)
+	_, err = client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: link.Token, Password: "guess"})
+	wantCode(t, "ResolveShareLink(wrong password)", err, codes.Unauthenticated)
+	shared, err := client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: link.Token, Password: "hunter2"})
+	if err != nil || shared.Checklist.Id != checklist.Id {
+		t.Fatalf("ResolveShareLink = %v, %v, want the checklist", shared, err)
+	}
+	_, err = client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: link.Token + "x", Password: "hunter2"})
+	wantCode(t, "ResolveShareLink(unknown)", err, codes.NotFound)
+
+	// An expired link is not found, even with the password.
+	st.expire = true
+	_, err = client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: link.Token, Password: "hunter2"})
+	wantCode(t, "ResolveShareLink(expired)", err, codes.NotFound)
+	st.expire = false
+
+	open, err := client.CreateShareLink(ctx, &pb.CreateShareLinkRequest{ChecklistId: checklist.Id})
+	if err != nil {
+		t.Fatalf("CreateShareLink: %v", err)
+	}
+	revoked, err := client.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{Id: open.Id})
+	if err != nil || revoked.RevokedAt == nil {
+		t.Fatalf("RevokeShareLink = %v, %v", revoked, err)
+	}
+	_, err = client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: open.Token})
+	wantCode(t, "ResolveShareLink(revoked)", err, codes.NotFound)
+	_, err = client.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{Id: uuid.NewString()})
+	wantCode(t, "RevokeShareLink(unknown)", err, codes.NotFound)
+
+	links, err := client.ListShareLinks(ctx, &pb.ListShareLinksRequest{ChecklistId: checklist.Id})
+	if err != nil || len(links.ShareLinks) != 2 {
+		t.Fatalf("ListShareLinks = %v, %v, want both links", links, err)
+	}
+	for _, l := range links.ShareLinks {
+		if l.Token != "" {
+			t.Errorf("ListShareLinks returned the token of %s", l.Id)
+		}
+	}
+}
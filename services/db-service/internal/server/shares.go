@@ -0,0 +1,169 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shareTokenBytes is the amount of randomness in a share token (256 bits).
+const shareTokenBytes = 32
+
+func (s *GRPCServer) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.ShareLink, error) {
+	slog.InfoContext(ctx, "Received CreateShareLink request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	var passwordHash string
+	if req.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+				return nil, status.Error(codes.InvalidArgument, "password is too long")
+			}
+			slog.ErrorContext(ctx, "Error hashing share link password", "error", err)
+			return nil, status.Error(codes.Internal, "failed to create share link")
+		}
+		passwordHash = string(hash)
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating share token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create share link")
+	}
+
+	link, err := s.storage.CreateShareLink(ctx, req.ChecklistId, hashShareToken(token), passwordHash, expiresAt)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error creating share link for checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to create share link")
+	}
+	link.Token = token
+
+	slog.InfoContext(ctx, "Successfully created share link for checklist", "share_link_id", link.Id, "checklist_id", req.ChecklistId)
+	return link, nil
+}
+
+func (s *GRPCServer) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	slog.InfoContext(ctx, "Received ListShareLinks request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	links, err := s.storage.ListShareLinks(ctx, req.ChecklistId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing share links for checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to list share links")
+	}
+
+	return &pb.ListShareLinksResponse{ShareLinks: links}, nil
+}
+
+func (s *GRPCServer) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.ShareLink, error) {
+	slog.InfoContext(ctx, "Received RevokeShareLink request", "share_link_id", req.Id)
+
+	if err := validateID("share link ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	link, err := s.storage.RevokeShareLink(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "share link not found")
+		}
+		slog.ErrorContext(ctx, "Error revoking share link", "share_link_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to revoke share link")
+	}
+
+	slog.InfoContext(ctx, "Successfully revoked share link", "share_link_id", req.Id)
+	return link, nil
+}
+
+// ResolveShareLink returns the shared checklist for a valid token. Unknown,
+// revoked and expired tokens are all reported as NotFound so that callers
+// cannot tell them apart.
+func (s *GRPCServer) ResolveShareLink(ctx context.Context, req *pb.ResolveShareLinkRequest) (*pb.SharedChecklist, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	link, passwordHash, err := s.storage.GetShareLinkByTokenHash(ctx, hashShareToken(req.Token))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "share link not found")
+		}
+		slog.ErrorContext(ctx, "Error resolving share link", "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve share link")
+	}
+
+	if link.RevokedAt != nil || (link.ExpiresAt != nil && !link.ExpiresAt.AsTime().After(time.Now())) {
+		slog.WarnContext(ctx, "Share link is revoked or expired", "share_link_id", link.Id)
+		return nil, status.Error(codes.NotFound, "share link not found")
+	}
+
+	if passwordHash != "" {
+		if req.Password == "" {
+			return nil, status.Error(codes.Unauthenticated, "password required")
+		}
+		if bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Password)) != nil {
+			slog.WarnContext(ctx, "Invalid password for share link", "share_link_id", link.Id)
+			return nil, status.Error(codes.Unauthenticated, "invalid password")
+		}
+	}
+
+	checklist, err := s.storage.GetChecklist(ctx, link.ChecklistId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "share link not found")
+		}
+		slog.ErrorContext(ctx, "Error getting shared checklist", "checklist_id", link.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve share link")
+	}
+
+	tasks, err := s.storage.ListTasks(ctx, storage.TaskFilter{ChecklistID: link.ChecklistId})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing tasks of shared checklist", "checklist_id", link.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve share link")
+	}
+
+	slog.InfoContext(ctx, "Resolved share link for checklist", "share_link_id", link.Id, "checklist_id", link.ChecklistId)
+	return &pb.SharedChecklist{Checklist: checklist, Tasks: tasks}, nil
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashShareToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultStatsRange = 30 * 24 * time.Hour
+	// maxStatsBuckets bounds the length of the series.
+	maxStatsBuckets = 1000
+)
+
+// statsBucketSizes maps the accepted group_by values to an upper bound of
+// their length, used to limit the number of buckets.
+var statsBucketSizes = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 31 * 24 * time.Hour,
+}
+
+func (s *GRPCServer) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.Stats, error) {
+	slog.InfoContext(ctx, "Received GetStats request", "checklist_id", req.ChecklistId, "group_by", req.GroupBy)
+
+	now := time.Now()
+	q := storage.StatsQuery{
+		To:          now,
+		ChecklistID: req.ChecklistId,
+		GroupBy:     req.GroupBy,
+		Timezone:    req.Timezone,
+		Now:         now,
+	}
+	if req.To != nil {
+		q.To = req.To.AsTime()
+	}
+	q.From = q.To.Add(-defaultStatsRange)
+	if req.From != nil {
+		q.From = req.From.AsTime()
+	}
+	if !q.From.Before(q.To) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	if q.ChecklistID != "" {
+		if err := validateID("checklist ID", q.ChecklistID); err != nil {
+			return nil, err
+		}
+	}
+
+	if q.GroupBy == "" {
+		q.GroupBy = "day"
+	}
+	bucketSize, ok := statsBucketSizes[q.GroupBy]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "group_by must be day, week or month")
+	}
+	if q.To.Sub(q.From) > maxStatsBuckets*bucketSize {
+		return nil, status.Errorf(codes.InvalidArgument, "the range is too long for group_by=%s (at most %d buckets)", q.GroupBy, maxStatsBuckets)
+	}
+
+	if q.Timezone == "" {
+		q.Timezone = "UTC"
+	}
+	// time.LoadLocation accepts Local, which Postgres does not know.
+	if _, err := time.LoadLocation(q.Timezone); err != nil || q.Timezone == "Local" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown timezone %q", q.Timezone)
+	}
+
+	stats, err := s.storage.GetStats(ctx, q)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error computing stats", "error", err)
+		return nil, status.Error(codes.Internal, "failed to compute stats")
+	}
+
+	return stats, nil
+}
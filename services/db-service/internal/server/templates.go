@@ -0,0 +1,141 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// templateVariable matches placeholders like {{version}} or {{ release.name }}.
+var templateVariable = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+func (s *GRPCServer) CreateTemplateFromChecklist(ctx context.Context, req *pb.CreateTemplateFromChecklistRequest) (*pb.Template, error) {
+	slog.InfoContext(ctx, "Received CreateTemplateFromChecklist request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	template, err := s.storage.CreateTemplateFromChecklist(ctx, req.ChecklistId, req.Name, req.Description, req.ChecklistTitle)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error creating template from checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to create template")
+	}
+
+	slog.InfoContext(ctx, "Successfully created template", "template_id", template.Id, "task_count", len(template.Tasks))
+	return template, nil
+}
+
+func (s *GRPCServer) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	slog.InfoContext(ctx, "Received ListTemplates request")
+
+	templates, err := s.storage.ListTemplates(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing templates", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list templates")
+	}
+
+	return &pb.ListTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *GRPCServer) GetTemplate(ctx context.Context, req *pb.TemplateActionRequest) (*pb.Template, error) {
+	slog.InfoContext(ctx, "Received GetTemplate request", "template_id", req.Id)
+
+	if err := validateID("template ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	template, err := s.storage.GetTemplate(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "template not found")
+		}
+		slog.ErrorContext(ctx, "Error getting template", "template_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get template")
+	}
+
+	return template, nil
+}
+
+func (s *GRPCServer) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.Checklist, error) {
+	slog.InfoContext(ctx, "Received InstantiateTemplate request", "template_id", req.TemplateId)
+
+	if err := validateID("template ID", req.TemplateId); err != nil {
+		return nil, err
+	}
+
+	template, err := s.storage.GetTemplate(ctx, req.TemplateId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "template not found")
+		}
+		slog.ErrorContext(ctx, "Error getting template", "template_id", req.TemplateId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to instantiate template")
+	}
+
+	startAt := time.Now()
+	if req.StartAt != nil {
+		startAt = req.StartAt.AsTime()
+	}
+
+	var missing []string
+	substitute := func(s string) string {
+		return templateVariable.ReplaceAllStringFunc(s, func(m string) string {
+			name := templateVariable.FindStringSubmatch(m)[1]
+			value, ok := req.Variables[name]
+			if !ok {
+				if !slices.Contains(missing, name) {
+					missing = append(missing, name)
+				}
+				return m
+			}
+			return value
+		})
+	}
+
+	checklist := &pb.Checklist{
+		Title:       substitute(template.ChecklistTitle),
+		Description: template.Description,
+	}
+	tasks := make([]*pb.Task, 0, len(template.Tasks))
+	for _, blueprint := range template.Tasks {
+		task := &pb.Task{
+			Title:       substitute(blueprint.Title),
+			Description: blueprint.Description,
+			Tags:        blueprint.Tags,
+		}
+		if blueprint.DueOffset != nil {
+			task.DueAt = timestamppb.New(startAt.Add(blueprint.DueOffset.AsDuration()))
+		}
+		tasks = append(tasks, task)
+	}
+
+	if len(missing) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "missing template variables: %s", strings.Join(missing, ", "))
+	}
+
+	created, err := s.storage.CreateChecklistWithTasks(ctx, checklist, tasks)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error instantiating template", "template_id", req.TemplateId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to instantiate template")
+	}
+
+	slog.InfoContext(ctx, "Successfully instantiated template", "template_id", req.TemplateId, "checklist_id", created.Id)
+	return created, nil
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/filter"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxViewNameLength = 255
+
+func (s *GRPCServer) CreateSavedView(ctx context.Context, req *pb.CreateSavedViewRequest) (*pb.SavedView, error) {
+	slog.InfoContext(ctx, "Received CreateSavedView request", "name", req.Name)
+
+	name, err := validateSavedView(req.Name, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := s.storage.CreateSavedView(ctx, name, req.Filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error creating saved view", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create saved view")
+	}
+
+	slog.InfoContext(ctx, "Successfully created saved view", "view_id", view.Id)
+	return view, nil
+}
+
+func (s *GRPCServer) ListSavedViews(ctx context.Context, req *pb.ListSavedViewsRequest) (*pb.ListSavedViewsResponse, error) {
+	slog.InfoContext(ctx, "Received ListSavedViews request")
+
+	views, err := s.storage.ListSavedViews(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing saved views", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list saved views")
+	}
+
+	return &pb.ListSavedViewsResponse{Views: views}, nil
+}
+
+func (s *GRPCServer) GetSavedView(ctx context.Context, req *pb.SavedViewActionRequest) (*pb.SavedView, error) {
+	slog.InfoContext(ctx, "Received GetSavedView request", "view_id", req.Id)
+
+	if err := validateID("view ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	view, err := s.storage.GetSavedView(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "saved view not found")
+		}
+		slog.ErrorContext(ctx, "Error getting saved view", "view_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get saved view")
+	}
+
+	return view, nil
+}
+
+func (s *GRPCServer) UpdateSavedView(ctx context.Context, req *pb.UpdateSavedViewRequest) (*pb.SavedView, error) {
+	slog.InfoContext(ctx, "Received UpdateSavedView request", "view_id", req.Id)
+
+	if err := validateID("view ID", req.Id); err != nil {
+		return nil, err
+	}
+	name, err := validateSavedView(req.Name, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := s.storage.UpdateSavedView(ctx, req.Id, name, req.Filter)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "saved view not found")
+		}
+		slog.ErrorContext(ctx, "Error updating saved view", "view_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to update saved view")
+	}
+
+	slog.InfoContext(ctx, "Successfully updated saved view", "view_id", req.Id)
+	return view, nil
+}
+
+func (s *GRPCServer) DeleteSavedView(ctx context.Context, req *pb.SavedViewActionRequest) (*pb.DeleteSavedViewResponse, error) {
+	slog.InfoContext(ctx, "Received DeleteSavedView request", "view_id", req.Id)
+
+	if err := validateID("view ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.DeleteSavedView(ctx, req.Id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "saved view not found")
+		}
+		slog.ErrorContext(ctx, "Error deleting saved view", "view_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete saved view")
+	}
+
+	slog.InfoContext(ctx, "Successfully deleted saved view", "view_id", req.Id)
+	return &pb.DeleteSavedViewResponse{Success: true}, nil
+}
+
+// validateSavedView checks the name and makes sure the filter parses, so a
+// saved view can always be run. It returns the trimmed name.
+func validateSavedView(name string, expression string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "name is required")
+	}
+	if utf8.RuneCountInString(name) > maxViewNameLength {
+		return "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxViewNameLength)
+	}
+	if _, err := parseFilter(expression); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// parseFilter parses a filter language expression, reporting syntax errors
+// as InvalidArgument.
+func parseFilter(expression string) (filter.Expr, error) {
+	expr, err := filter.Parse(expression)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return expr, nil
+}
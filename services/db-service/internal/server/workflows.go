@@ -0,0 +1,170 @@
+package server
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/storage"
+	"checklist-go/services/db-service/internal/workflow"
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SetWorkflow(ctx context.Context, req *pb.SetWorkflowRequest) (*pb.Workflow, error) {
+	slog.InfoContext(ctx, "Received SetWorkflow request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	w := &pb.Workflow{
+		ChecklistId: req.ChecklistId,
+		States:      req.States,
+		Transitions: req.Transitions,
+	}
+	if err := workflow.Validate(w); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.storage.SetWorkflow(ctx, w); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error saving workflow for checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to save workflow")
+	}
+
+	slog.InfoContext(ctx, "Successfully saved workflow", "state_count", len(w.States), "checklist_id", req.ChecklistId)
+	return s.loadWorkflow(ctx, req.ChecklistId)
+}
+
+func (s *GRPCServer) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (*pb.Workflow, error) {
+	slog.InfoContext(ctx, "Received GetWorkflow request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	return s.loadWorkflow(ctx, req.ChecklistId)
+}
+
+func (s *GRPCServer) TransitionTask(ctx context.Context, req *pb.TransitionTaskRequest) (*pb.Task, error) {
+	slog.InfoContext(ctx, "Received TransitionTask request", "task_id", req.Id, "status", req.Status)
+
+	if err := validateID("task ID", req.Id); err != nil {
+		return nil, err
+	}
+	if req.Status == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	task, err := s.storage.GetTask(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		slog.ErrorContext(ctx, "Error getting task", "task_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to transition task")
+	}
+
+	w, err := s.loadWorkflow(ctx, task.ChecklistId)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := workflow.State(w, req.Status)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q", req.Status)
+	}
+	if task.Status == req.Status {
+		return task, nil
+	}
+	if !workflow.CanTransition(w, task.Status, req.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "transition from %s to %s is not allowed", task.Status, req.Status)
+	}
+
+	err = s.storage.TransitionTask(ctx, req.Id, task.Status, req.Status, target.IsDone, target.IsDone && s.opts.EnforceDependencies)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "task not found")
+		case errors.Is(err, storage.ErrConflict):
+			return nil, status.Error(codes.Aborted, "task was modified concurrently, retry")
+		case errors.Is(err, storage.ErrTaskBlocked):
+			return nil, status.Error(codes.FailedPrecondition, "task has open prerequisites")
+		}
+		slog.ErrorContext(ctx, "Error transitioning task", "task_id", req.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to transition task")
+	}
+
+	slog.InfoContext(ctx, "Successfully moved task", "task_id", req.Id, "from_status", task.Status, "status", req.Status)
+	return s.getUpdatedTask(ctx, req.Id)
+}
+
+func (s *GRPCServer) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.Board, error) {
+	slog.InfoContext(ctx, "Received GetBoard request", "checklist_id", req.ChecklistId)
+
+	if err := validateID("checklist ID", req.ChecklistId); err != nil {
+		return nil, err
+	}
+
+	checklist, err := s.storage.GetChecklist(ctx, req.ChecklistId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error getting checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get board")
+	}
+
+	w, err := s.loadWorkflow(ctx, req.ChecklistId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.storage.ListTasks(ctx, storage.TaskFilter{ChecklistID: req.ChecklistId})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing tasks of checklist", "checklist_id", req.ChecklistId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get board")
+	}
+
+	board := &pb.Board{Checklist: checklist}
+	columns := make(map[string]*pb.BoardColumn, len(w.States))
+	for _, state := range w.States {
+		column := &pb.BoardColumn{State: state}
+		columns[state.Key] = column
+		board.Columns = append(board.Columns, column)
+	}
+	for _, task := range tasks {
+		column, ok := columns[task.Status]
+		if !ok {
+			// Should not happen as SetWorkflow remaps statuses; keep the task visible.
+			column = board.Columns[0]
+		}
+		column.Tasks = append(column.Tasks, task)
+	}
+
+	return board, nil
+}
+
+// loadWorkflow returns the workflow that applies to tasks of the checklist:
+// its custom workflow or the default one.
+func (s *GRPCServer) loadWorkflow(ctx context.Context, checklistID string) (*pb.Workflow, error) {
+	if checklistID == "" {
+		return workflow.Default(""), nil
+	}
+
+	w, err := s.storage.GetWorkflow(ctx, checklistID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "checklist not found")
+		}
+		slog.ErrorContext(ctx, "Error getting workflow for checklist", "checklist_id", checklistID, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get workflow")
+	}
+	if len(w.States) == 0 {
+		return workflow.Default(checklistID), nil
+	}
+	return w, nil
+}
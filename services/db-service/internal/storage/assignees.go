@@ -0,0 +1,146 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddChecklistMember adds the user to the checklist. Adding an existing member
+// keeps the original membership.
+func (s *Storage) AddChecklistMember(ctx context.Context, checklistID string, userID string) (*pb.ChecklistMember, error) {
+	query := `INSERT INTO checklist_members (checklist_id, user_id) VALUES ($1, $2)
+		ON CONFLICT (checklist_id, user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+		RETURNING checklist_id, user_id, created_at`
+
+	member, err := scanChecklistMember(s.db.QueryRow(ctx, query, checklistID, userID))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to add checklist member: %w", err)
+	}
+	return member, nil
+}
+
+// RemoveChecklistMember removes the user from the checklist together with the
+// user's assignments to tasks of that checklist.
+func (s *Storage) RemoveChecklistMember(ctx context.Context, checklistID string, userID string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM checklist_members WHERE checklist_id = $1 AND user_id = $2`, checklistID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove checklist member: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM task_assignees a USING tasks t
+		WHERE a.task_id = t.id AND t.checklist_id = $1 AND a.user_id = $2`, checklistID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove assignments: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) ListChecklistMembers(ctx context.Context, checklistID string) ([]*pb.ChecklistMember, error) {
+	query := `SELECT checklist_id, user_id, created_at FROM checklist_members WHERE checklist_id = $1 ORDER BY created_at`
+	rows, err := s.db.Query(ctx, query, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklist members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*pb.ChecklistMember
+	for rows.Next() {
+		member, err := scanChecklistMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist member: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over checklist members: %w", err)
+	}
+	return members, nil
+}
+
+// AssignTask assigns the task to the user. It returns ErrNotMember if the
+// user is not a member of the task's checklist (tasks outside a checklist
+// cannot be assigned) and ErrNotFound if the task does not exist. Assigning
+// an existing assignee is a no-op.
+//
+// The membership row is locked with FOR SHARE so that a concurrent
+// RemoveChecklistMember either waits for the assignment and then deletes it,
+// or wins and makes the insert select nothing.
+func (s *Storage) AssignTask(ctx context.Context, taskID string, userID string) error {
+	cmdTag, err := s.db.Exec(ctx, `INSERT INTO task_assignees (task_id, user_id)
+		SELECT t.id, m.user_id FROM tasks t
+		JOIN checklist_members m ON m.checklist_id = t.checklist_id AND m.user_id = $2
+		WHERE t.id = $1
+		FOR SHARE OF m
+		ON CONFLICT (task_id, user_id) DO NOTHING`, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to assign task: %w", err)
+	}
+	if cmdTag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var taskExists, assigned bool
+	err = s.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1),
+		EXISTS (SELECT 1 FROM task_assignees WHERE task_id = $1 AND user_id = $2)`, taskID, userID).Scan(&taskExists, &assigned)
+	if err != nil {
+		return fmt.Errorf("failed to assign task: %w", err)
+	}
+	switch {
+	case !taskExists:
+		return ErrNotFound
+	case assigned:
+		return nil
+	default:
+		return ErrNotMember
+	}
+}
+
+func (s *Storage) UnassignTask(ctx context.Context, taskID string, userID string) error {
+	cmdTag, err := s.db.Exec(ctx, `DELETE FROM task_assignees WHERE task_id = $1 AND user_id = $2`, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to unassign task: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanChecklistMember(row pgx.Row) (*pb.ChecklistMember, error) {
+	var member pb.ChecklistMember
+	var checklistID uuid.UUID
+	var createdAt time.Time
+
+	if err := row.Scan(&checklistID, &member.UserId, &createdAt); err != nil {
+		return nil, err
+	}
+
+	member.ChecklistId = checklistID.String()
+	member.CreatedAt = timestamppb.New(createdAt)
+
+	return &member, nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const attachmentColumns = `id, task_id, filename, content_type, size_bytes, sha256, storage_key,
+	COALESCE(uploaded_by, ''), created_at`
+
+// CreateAttachment records the metadata of content that the api-service has
+// already written to its blob store.
+func (s *Storage) CreateAttachment(ctx context.Context, a *pb.Attachment) (*pb.Attachment, error) {
+	query := `INSERT INTO attachments (id, task_id, filename, content_type, size_bytes, sha256, storage_key, uploaded_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
+		RETURNING ` + attachmentColumns
+
+	created, err := scanAttachment(s.db.QueryRow(ctx, query, uuid.New(), a.TaskId, a.Filename, a.ContentType,
+		a.SizeBytes, a.Sha256, a.StorageKey, a.UploadedBy))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return created, nil
+}
+
+func (s *Storage) ListAttachments(ctx context.Context, taskID string) ([]*pb.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE task_id = $1 ORDER BY created_at`
+	rows, err := s.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*pb.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+func (s *Storage) GetAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE id = $1`
+
+	a, err := scanAttachment(s.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return a, nil
+}
+
+// DeleteAttachment deletes the metadata and returns it, so that the caller can
+// remove the content from the blob store.
+func (s *Storage) DeleteAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	query := `DELETE FROM attachments WHERE id = $1 RETURNING ` + attachmentColumns
+
+	a, err := scanAttachment(s.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return a, nil
+}
+
+func scanAttachment(row pgx.Row) (*pb.Attachment, error) {
+	var a pb.Attachment
+	var id, taskID uuid.UUID
+	var createdAt time.Time
+
+	err := row.Scan(&id, &taskID, &a.Filename, &a.ContentType, &a.SizeBytes, &a.Sha256, &a.StorageKey,
+		&a.UploadedBy, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Id = id.String()
+	a.TaskId = taskID.String()
+	a.CreatedAt = timestamppb.New(createdAt)
+
+	return &a, nil
+}
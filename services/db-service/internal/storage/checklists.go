@@ -0,0 +1,130 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const checklistColumns = `id, title, COALESCE(description, ''), created_at, updated_at,
+	COALESCE(recurrence_id::text, ''), occurrence_at`
+
+func (s *Storage) CreateChecklist(ctx context.Context, title string, description string) (*pb.Checklist, error) {
+	id := uuid.New()
+
+	query := `INSERT INTO checklists (id, title, description) VALUES ($1, $2, $3) RETURNING created_at, updated_at`
+
+	var createdAt, updatedAt time.Time
+
+	err := s.db.QueryRow(ctx, query, id, title, description).Scan(&createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checklist: %w", err)
+	}
+
+	return &pb.Checklist{
+		Id:          id.String(),
+		Title:       title,
+		Description: description,
+		CreatedAt:   timestamppb.New(createdAt),
+		UpdatedAt:   timestamppb.New(updatedAt),
+	}, nil
+}
+
+func (s *Storage) ListChecklists(ctx context.Context) ([]*pb.Checklist, error) {
+	query := `SELECT ` + checklistColumns + ` FROM checklists ORDER BY created_at desc`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklists: %w", err)
+	}
+	defer rows.Close()
+
+	var checklists []*pb.Checklist
+	for rows.Next() {
+		checklist, err := scanChecklist(rows)
+		if err != nil {
+			return nil, err
+		}
+		checklists = append(checklists, checklist)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over checklists: %w", err)
+	}
+	return checklists, nil
+}
+
+func (s *Storage) GetChecklist(ctx context.Context, id string) (*pb.Checklist, error) {
+	query := `SELECT ` + checklistColumns + ` FROM checklists WHERE id = $1`
+
+	checklist, err := scanChecklist(s.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return checklist, err
+}
+
+func scanChecklist(row pgx.Row) (*pb.Checklist, error) {
+	var checklist pb.Checklist
+	var id uuid.UUID
+	var createdAt, updatedAt time.Time
+	var occurrenceAt *time.Time
+
+	if err := row.Scan(&id, &checklist.Title, &checklist.Description, &createdAt, &updatedAt,
+		&checklist.RecurrenceId, &occurrenceAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan checklist: %w", err)
+	}
+
+	checklist.Id = id.String()
+	checklist.CreatedAt = timestamppb.New(createdAt)
+	checklist.UpdatedAt = timestamppb.New(updatedAt)
+	checklist.OccurrenceAt = optionalTimestamp(occurrenceAt)
+
+	return &checklist, nil
+}
+
+// CreateChecklistWithTasks inserts the checklist and its tasks in a single
+// transaction and returns the stored checklist.
+func (s *Storage) CreateChecklistWithTasks(ctx context.Context, checklist *pb.Checklist, tasks []*pb.Task) (*pb.Checklist, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO checklists (id, title, description) VALUES ($1, $2, $3) RETURNING ` + checklistColumns
+	created, err := scanChecklist(tx.QueryRow(ctx, query, uuid.New(), checklist.Title, checklist.Description))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checklist: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for _, task := range tasks {
+		var dueAt *time.Time
+		if task.DueAt != nil {
+			t := task.DueAt.AsTime()
+			dueAt = &t
+		}
+		tags := task.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		batch.Queue(`INSERT INTO tasks (id, title, description, checklist_id, due_at, tags) VALUES ($1, $2, $3, $4, $5, $6)`,
+			uuid.New(), task.Title, task.Description, created.Id, dueAt, tags)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, fmt.Errorf("failed to create checklist tasks: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return created, nil
+}
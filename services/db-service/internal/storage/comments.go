@@ -0,0 +1,132 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const commentColumns = `id, task_id, author_id, body, created_at, updated_at, edited_at`
+
+// CommentCursor points at the last comment of a page; the next page starts
+// right after it.
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func (s *Storage) AddComment(ctx context.Context, taskID string, authorID string, body string) (*pb.Comment, error) {
+	query := `INSERT INTO comments (id, task_id, author_id, body) VALUES ($1, $2, $3, $4) RETURNING ` + commentColumns
+
+	comment, err := scanComment(s.db.QueryRow(ctx, query, uuid.New(), taskID, authorID, body))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	return comment, nil
+}
+
+// ListComments returns up to limit comments of the task, oldest first,
+// starting after the cursor when one is given.
+func (s *Storage) ListComments(ctx context.Context, taskID string, after *CommentCursor, limit int) ([]*pb.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments
+		WHERE task_id = $1 AND ($2::timestamptz IS NULL OR (created_at, id) > ($2, $3::uuid))
+		ORDER BY created_at, id
+		LIMIT $4`
+
+	var afterCreatedAt *time.Time
+	var afterID *string
+	if after != nil {
+		afterCreatedAt, afterID = &after.CreatedAt, &after.ID
+	}
+
+	rows, err := s.db.Query(ctx, query, taskID, afterCreatedAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*pb.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over comments: %w", err)
+	}
+	return comments, nil
+}
+
+// EditComment replaces the body of the comment. It returns ErrNotFound if the
+// task has no such comment and ErrNotAuthor if authorID did not write it.
+func (s *Storage) EditComment(ctx context.Context, id string, taskID string, authorID string, body string) (*pb.Comment, error) {
+	query := `UPDATE comments SET body = $4, edited_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND task_id = $2 AND author_id = $3
+		RETURNING ` + commentColumns
+
+	comment, err := scanComment(s.db.QueryRow(ctx, query, id, taskID, authorID, body))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, s.commentAccessError(ctx, id, taskID)
+		}
+		return nil, fmt.Errorf("failed to edit comment: %w", err)
+	}
+	return comment, nil
+}
+
+// DeleteComment deletes the comment with the same rules as EditComment.
+func (s *Storage) DeleteComment(ctx context.Context, id string, taskID string, authorID string) error {
+	cmdTag, err := s.db.Exec(ctx, `DELETE FROM comments WHERE id = $1 AND task_id = $2 AND author_id = $3`, id, taskID, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return s.commentAccessError(ctx, id, taskID)
+	}
+
+	return nil
+}
+
+// commentAccessError explains why a comment update matched no rows.
+func (s *Storage) commentAccessError(ctx context.Context, id string, taskID string) error {
+	var exists bool
+	err := s.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM comments WHERE id = $1 AND task_id = $2)`, id, taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check comment: %w", err)
+	}
+	if exists {
+		return ErrNotAuthor
+	}
+	return ErrNotFound
+}
+
+func scanComment(row pgx.Row) (*pb.Comment, error) {
+	var comment pb.Comment
+	var id, taskID uuid.UUID
+	var createdAt, updatedAt time.Time
+	var editedAt *time.Time
+
+	if err := row.Scan(&id, &taskID, &comment.AuthorId, &comment.Body, &createdAt, &updatedAt, &editedAt); err != nil {
+		return nil, err
+	}
+
+	comment.Id = id.String()
+	comment.TaskId = taskID.String()
+	comment.CreatedAt = timestamppb.New(createdAt)
+	comment.UpdatedAt = timestamppb.New(updatedAt)
+	comment.EditedAt = optionalTimestamp(editedAt)
+
+	return &comment, nil
+}
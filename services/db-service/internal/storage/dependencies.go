@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// dependencyLockKey serializes dependency changes so that two concurrent
+// inserts cannot close a cycle that neither of them sees on its own.
+const dependencyLockKey = 7483001
+
+// AddDependency records that taskID cannot be completed before dependsOnID.
+// It returns ErrDependencyCycle if dependsOnID already (transitively) depends
+// on taskID and ErrNotFound if either task does not exist. Adding an existing
+// dependency is a no-op.
+func (s *Storage) AddDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, dependencyLockKey); err != nil {
+		return fmt.Errorf("failed to lock dependencies: %w", err)
+	}
+
+	var cycle bool
+	err = tx.QueryRow(ctx, `WITH RECURSIVE prerequisites(id) AS (
+			SELECT depends_on_id FROM task_dependencies WHERE task_id = $2
+			UNION
+			SELECT d.depends_on_id FROM task_dependencies d JOIN prerequisites p ON d.task_id = p.id
+		)
+		SELECT EXISTS (SELECT 1 FROM prerequisites WHERE id = $1)`, taskID, dependsOnID).Scan(&cycle)
+	if err != nil {
+		return fmt.Errorf("failed to check dependency cycle: %w", err)
+	}
+	if cycle {
+		return ErrDependencyCycle
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO task_dependencies (task_id, depends_on_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`, taskID, dependsOnID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to add dependency: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) RemoveDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	cmdTag, err := s.db.Exec(ctx, `DELETE FROM task_dependencies WHERE task_id = $1 AND depends_on_id = $2`, taskID, dependsOnID)
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
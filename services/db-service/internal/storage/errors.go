@@ -1,6 +1,33 @@
 package storage
 
-import "errors"
+import (
+	"errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
-var ErrNotFound = errors.New("not found")
\ No newline at end of file
+
+var ErrNotFound = errors.New("not found")
+
+// ErrTaskBlocked is returned when a task cannot be completed because some of
+// its prerequisites are still open.
+var ErrTaskBlocked = errors.New("task has open prerequisites")
+
+// ErrDependencyCycle is returned when a new dependency would make a task
+// (transitively) depend on itself.
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// ErrNotMember is returned when a task is assigned to a user who is not a
+// member of the task's checklist.
+var ErrNotMember = errors.New("not a checklist member")
+
+// ErrNotAuthor is returned when a comment is changed by someone other than
+// its author.
+var ErrNotAuthor = errors.New("not the comment author")
+
+// isForeignKeyViolation reports whether err is a Postgres foreign_key_violation,
+// i.e. the row references a parent (checklist, task) that does not exist.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
\ No newline at end of file
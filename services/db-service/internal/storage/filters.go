@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"checklist-go/services/db-service/internal/filter"
+	"checklist-go/services/db-service/internal/search"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// filterTimeColumns maps the time fields of the filter language to columns.
+var filterTimeColumns = map[string]string{
+	"due":       "tasks.due_at",
+	"created":   "tasks.created_at",
+	"updated":   "tasks.updated_at",
+	"completed": "tasks.completed_at",
+}
+
+// filterSQL translates a parsed filter expression into a condition over the
+// tasks table. Values are appended to args and referenced by placeholder, so
+// only column names and operators from this file reach the query text.
+type filterSQL struct {
+	args []any
+	now  time.Time
+}
+
+func (f *filterSQL) arg(v any) string {
+	f.args = append(f.args, v)
+	return fmt.Sprintf("$%d", len(f.args))
+}
+
+func (f *filterSQL) build(e filter.Expr) (string, error) {
+	switch e := e.(type) {
+	case filter.And:
+		return f.binary(e.Left, "AND", e.Right)
+	case filter.Or:
+		return f.binary(e.Left, "OR", e.Right)
+	case filter.Not:
+		x, err := f.build(e.X)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + x, nil
+
+	case filter.Flag:
+		var cond string
+		switch e.Field {
+		case "done":
+			cond = "tasks.done"
+		case "blocked":
+			cond = blockedCondition
+		default:
+			return "", fmt.Errorf("unsupported filter flag %q", e.Field)
+		}
+		if !e.Value {
+			return "(NOT " + cond + ")", nil
+		}
+		return "(" + cond + ")", nil
+
+	case filter.Match:
+		switch e.Field {
+		case "tag":
+			return "(tasks.tags @> ARRAY[" + f.arg(e.Value) + "::text])", nil
+		case "status":
+			return "(tasks.status = " + f.arg(e.Value) + ")", nil
+		case "assignee":
+			return "EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = " + f.arg(e.Value) + ")", nil
+		case "checklist":
+			return "(tasks.checklist_id IS NOT DISTINCT FROM " + f.arg(e.Value) + "::uuid)", nil
+		case "title":
+			return "(tasks.title ILIKE " + f.arg(likePattern(e.Value)) + ")", nil
+		case "description":
+			return "(COALESCE(tasks.description, '') ILIKE " + f.arg(likePattern(e.Value)) + ")", nil
+		case "text":
+			return "(tasks.search_vector @@ plainto_tsquery(" + f.arg(search.Config) + "::regconfig, " + f.arg(e.Value) + "))", nil
+		}
+		return "", fmt.Errorf("unsupported filter field %q", e.Field)
+
+	case filter.TimeSet:
+		column, ok := filterTimeColumns[e.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		if e.Set {
+			return "(" + column + " IS NOT NULL)", nil
+		}
+		return "(" + column + " IS NULL)", nil
+
+	case filter.TimeCompare:
+		column, ok := filterTimeColumns[e.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		at := e.Value.Resolve(f.now)
+		nextDay := at.AddDate(0, 0, 1)
+
+		// A date stands for the whole day, so "<= date" includes it and
+		// "> date" starts after it.
+		var cond string
+		switch {
+		case e.Op == "=" && e.Value.Day:
+			cond = column + " >= " + f.arg(at) + " AND " + column + " < " + f.arg(nextDay)
+		case e.Op == "<=" && e.Value.Day:
+			cond = column + " < " + f.arg(nextDay)
+		case e.Op == ">" && e.Value.Day:
+			cond = column + " >= " + f.arg(nextDay)
+		case e.Op == "<" || e.Op == "<=" || e.Op == ">" || e.Op == ">=":
+			cond = column + " " + e.Op + " " + f.arg(at)
+		default:
+			return "", fmt.Errorf("unsupported time comparison %q", e.Op)
+		}
+		// Tasks without the time do not match, and NOT of the comparison
+		// matches them rather than yielding NULL.
+		return "COALESCE(" + cond + ", false)", nil
+	}
+	return "", fmt.Errorf("unsupported filter expression %T", e)
+}
+
+func (f *filterSQL) binary(left filter.Expr, op string, right filter.Expr) (string, error) {
+	l, err := f.build(left)
+	if err != nil {
+		return "", err
+	}
+	r, err := f.build(right)
+	if err != nil {
+		return "", err
+	}
+	return "(" + l + " " + op + " " + r + ")", nil
+}
+
+// likePattern turns s into an ILIKE pattern matching it as a substring.
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"checklist-go/services/db-service/internal/filter"
+	"checklist-go/services/db-service/internal/search"
+)
+
+var filterNow = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// filterCompilers builds a filter expression with each SQL backend.
+var filterCompilers = map[string]func(filter.Expr) (string, []any, error){
+	"postgres": func(e filter.Expr) (string, []any, error) {
+		f := filterSQL{now: filterNow}
+		sql, err := f.build(e)
+		return sql, f.args, err
+	},
+	"sqlite": func(e filter.Expr) (string, []any, error) {
+		f := sqliteFilterSQL{now: filterNow}
+		sql, err := f.build(e)
+		return sql, f.args, err
+	},
+}
+
+func TestFilterSQL(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+
+	tests := []struct {
+		input    string
+		postgres string
+		sqlite   string
+		// args are the same for both backends unless sqliteArgs is set.
+		args       []any
+		sqliteArgs []any
+	}{
+		{
+			input:    "done",
+			postgres: "(tasks.done)",
+			sqlite:   "(tasks.done)",
+		},
+		{
+			input:    "tag:backend AND NOT done",
+			postgres: "((tasks.tags @> ARRAY[$1::text]) AND NOT (tasks.done))",
+			sqlite:   "(EXISTS (SELECT 1 FROM json_each(tasks.tags) WHERE json_each.value = $1) AND NOT (tasks.done))",
+			args:     []any{"backend"},
+		},
+		{
+			input:    "status:todo OR assignee:alice blocked:false",
+			postgres: "((tasks.status = $1) OR (EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = $2) AND (NOT " + blockedCondition + ")))",
+			sqlite:   "((tasks.status = $1) OR (EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = $2) AND (NOT " + blockedCondition + ")))",
+			args:     []any{"todo", "alice"},
+		},
+		{
+			input:      "checklist:1B4E28BA-2FA1-11D2-883F-0016D3CCA427",
+			postgres:   "(tasks.checklist_id IS NOT DISTINCT FROM $1::uuid)",
+			sqlite:     "(tasks.checklist_id IS $1)",
+			args:       []any{"1B4E28BA-2FA1-11D2-883F-0016D3CCA427"},
+			sqliteArgs: []any{"1b4e28ba-2fa1-11d2-883f-0016d3cca427"},
+		},
+		{
+			input:      `title:"50%_off\\"`,
+			postgres:   "(tasks.title ILIKE $1)",
+			sqlite:     "contains_fold(tasks.title, $1)",
+			args:       []any{`%50\%\_off\\%`},
+			sqliteArgs: []any{`50%_off\`},
+		},
+		{
+			input:      "description:x",
+			postgres:   "(COALESCE(tasks.description, '') ILIKE $1)",
+			sqlite:     "contains_fold(COALESCE(tasks.description, ''), $1)",
+			args:       []any{"%x%"},
+			sqliteArgs: []any{"x"},
+		},
+		{
+			input:      `text:"release notes"`,
+			postgres:   "(tasks.search_vector @@ plainto_tsquery($1::regconfig, $2))",
+			sqlite:     "search_plain_match($1, tasks.title, COALESCE(tasks.description, ''))",
+			args:       []any{search.Config, "release notes"},
+			sqliteArgs: []any{"release notes"},
+		},
+		{
+			input:    "due:none OR completed:any",
+			postgres: "((tasks.due_at IS NULL) OR (tasks.completed_at IS NOT NULL))",
+			sqlite:   "((tasks.due_at IS NULL) OR (tasks.completed_at IS NOT NULL))",
+		},
+		{
+			input:      "due<7d",
+			postgres:   "COALESCE(tasks.due_at < $1, false)",
+			sqlite:     "COALESCE(tasks.due_at < $1, false)",
+			args:       []any{filterNow.Add(7 * 24 * time.Hour)},
+			sqliteArgs: []any{filterNow.Add(7 * 24 * time.Hour).UnixMicro()},
+		},
+		{
+			input:      "due:2025-03-01",
+			postgres:   "COALESCE(tasks.due_at >= $1 AND tasks.due_at < $2, false)",
+			sqlite:     "COALESCE(tasks.due_at >= $1 AND tasks.due_at < $2, false)",
+			args:       []any{day, nextDay},
+			sqliteArgs: []any{day.UnixMicro(), nextDay.UnixMicro()},
+		},
+		{
+			input:      "created<=2025-03-01 updated>2025-03-01",
+			postgres:   "(COALESCE(tasks.created_at < $1, false) AND COALESCE(tasks.updated_at >= $2, false))",
+			sqlite:     "(COALESCE(tasks.created_at < $1, false) AND COALESCE(tasks.updated_at >= $2, false))",
+			args:       []any{nextDay, nextDay},
+			sqliteArgs: []any{nextDay.UnixMicro(), nextDay.UnixMicro()},
+		},
+		{
+			// Values only ever reach the query as arguments.
+			input:      `title:"'); DROP TABLE tasks; --" OR tag:"' OR 1=1"`,
+			postgres:   "((tasks.title ILIKE $1) OR (tasks.tags @> ARRAY[$2::text]))",
+			sqlite:     "(contains_fold(tasks.title, $1) OR EXISTS (SELECT 1 FROM json_each(tasks.tags) WHERE json_each.value = $2))",
+			args:       []any{"%'); DROP TABLE tasks; --%", "' OR 1=1"},
+			sqliteArgs: []any{"'); DROP TABLE tasks; --", "' OR 1=1"},
+		},
+	}
+	for _, tt := range tests {
+		expr, err := filter.Parse(tt.input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.input, err)
+		}
+		for backend, compile := range filterCompilers {
+			t.Run(backend+"/"+tt.input, func(t *testing.T) {
+				want, wantArgs := tt.postgres, tt.args
+				if backend == "sqlite" {
+					want = tt.sqlite
+					if tt.sqliteArgs != nil {
+						wantArgs = tt.sqliteArgs
+					}
+				}
+
+				sql, args, err := compile(expr)
+				if err != nil {
+					t.Fatalf("build() error = %v", err)
+				}
+				if sql != want {
+					t.Errorf("build() =\n%s\nwant\n%s", sql, want)
+				}
+				if !reflect.DeepEqual(args, wantArgs) {
+					t.Errorf("args = %#v, want %#v", args, wantArgs)
+				}
+			})
+		}
+	}
+}
+
+// TestFilterSQLRejects checks that field names and operators the filter
+// language does not have are refused instead of being written into the query,
+// even when the tree was not built by filter.Parse.
+func TestFilterSQLRejects(t *testing.T) {
+	const injected = "true) OR (1=1"
+	day := filter.TimeValue{At: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Day: true}
+
+	tests := []struct {
+		name string
+		expr filter.Expr
+	}{
+		{"flag field", filter.Flag{Field: injected, Value: true}},
+		{"match field", filter.Match{Field: injected, Value: "x"}},
+		{"time set field", filter.TimeSet{Field: injected, Set: true}},
+		{"time compare field", filter.TimeCompare{Field: injected, Op: "<", Value: day}},
+		{"time compare operator", filter.TimeCompare{Field: "due", Op: injected, Value: day}},
+		{"exact time that is not a date", filter.TimeCompare{Field: "due", Op: "=", Value: filter.TimeValue{At: day.At}}},
+		{"nested in AND", filter.And{Left: filter.Flag{Field: "done", Value: true}, Right: filter.Not{X: filter.Match{Field: injected, Value: "x"}}}},
+		{"nested in OR", filter.Or{Left: filter.TimeSet{Field: injected}, Right: filter.Flag{Field: "done", Value: true}}},
+		{"nil", nil},
+	}
+	for _, tt := range tests {
+		for backend, compile := range filterCompilers {
+			t.Run(backend+"/"+tt.name, func(t *testing.T) {
+				sql, _, err := compile(tt.expr)
+				if err == nil {
+					t.Fatalf("build() = %q, want an error", sql)
+				}
+				if sql != "" {
+					t.Errorf("build() = %q alongside error %v", sql, err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,823 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MemoryStorage is a TaskRepository that keeps everything in process memory.
+// It follows the Postgres implementation: the same orderings, errors, cascades
+// and timestamps (UTC, microsecond precision, one instant per operation like
+// NOW() in a transaction). Text search stems words like the russian
+// configuration, see search.Query. All methods are safe for concurrent use.
+type MemoryStorage struct {
+	mu  sync.RWMutex
+	seq uint64
+
+	tasks        map[string]*memTask
+	checklists   map[string]*memChecklist
+	dependencies []*memDependency
+	states       map[string][]*memState
+	transitions  map[string][]*memTransition
+	members      []*memMember
+	assignees    []*memAssignee
+	shareLinks   map[string]*memShareLink
+	recurrences  map[string]*memRecurrence
+	templates    map[string]*memTemplate
+	comments     map[string]*memComment
+	attachments  map[string]*memAttachment
+	savedViews   map[string]*memSavedView
+}
+
+type memTask struct {
+	seq          uint64
+	id           string
+	title        string
+	description  string
+	done         bool
+	status       string
+	checklistID  string
+	recurrenceID string
+	tags         []string
+	createdAt    time.Time
+	updatedAt    time.Time
+	dueAt        *time.Time
+	completedAt  *time.Time
+	occurrenceAt *time.Time
+}
+
+type memChecklist struct {
+	seq          uint64
+	id           string
+	title        string
+	description  string
+	recurrenceID string
+	createdAt    time.Time
+	updatedAt    time.Time
+	occurrenceAt *time.Time
+}
+
+type memDependency struct {
+	seq         uint64
+	taskID      string
+	dependsOnID string
+	createdAt   time.Time
+}
+
+type memState struct {
+	key    string
+	name   string
+	isDone bool
+}
+
+type memTransition struct {
+	from, to string
+}
+
+type memMember struct {
+	seq         uint64
+	checklistID string
+	userID      string
+	createdAt   time.Time
+}
+
+type memAssignee struct {
+	seq       uint64
+	taskID    string
+	userID    string
+	createdAt time.Time
+}
+
+// NewMemoryStorage returns an empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		tasks:       make(map[string]*memTask),
+		checklists:  make(map[string]*memChecklist),
+		states:      make(map[string][]*memState),
+		transitions: make(map[string][]*memTransition),
+		shareLinks:  make(map[string]*memShareLink),
+		recurrences: make(map[string]*memRecurrence),
+		templates:   make(map[string]*memTemplate),
+		comments:    make(map[string]*memComment),
+		attachments: make(map[string]*memAttachment),
+		savedViews:  make(map[string]*memSavedView),
+	}
+}
+
+func (s *MemoryStorage) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (s *MemoryStorage) Close() {}
+
+// now is the NOW() of an operation, truncated to what Postgres stores.
+func (s *MemoryStorage) now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// next returns the next insertion sequence number, which breaks ties between
+// rows created at the same instant.
+func (s *MemoryStorage) next() uint64 {
+	s.seq++
+	return s.seq
+}
+
+func timePtr(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime().UTC().Truncate(time.Microsecond)
+	return &v
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
+func copyTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return slices.Clone(tags)
+}
+
+// newestFirst orders by created_at desc like the Postgres list queries.
+func newestFirst(aCreated time.Time, aSeq uint64, bCreated time.Time, bSeq uint64) int {
+	if c := bCreated.Compare(aCreated); c != 0 {
+		return c
+	}
+	return compareSeq(bSeq, aSeq)
+}
+
+// oldestFirst orders by created_at.
+func oldestFirst(aCreated time.Time, aSeq uint64, bCreated time.Time, bSeq uint64) int {
+	return newestFirst(bCreated, bSeq, aCreated, aSeq)
+}
+
+func compareSeq(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Tasks
+
+func (s *MemoryStorage) CreateTask(ctx context.Context, task *pb.Task) (*pb.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checklistID := ""
+	if task.ChecklistId != "" {
+		checklistID = normalizeID(task.ChecklistId)
+		if _, ok := s.checklists[checklistID]; !ok {
+			return nil, ErrNotFound
+		}
+	}
+
+	now := s.now()
+	t := &memTask{
+		seq:         s.next(),
+		id:          uuid.NewString(),
+		title:       task.Title,
+		description: task.Description,
+		status:      s.initialStatus(checklistID),
+		checklistID: checklistID,
+		tags:        copyTags(task.Tags),
+		createdAt:   now,
+		updatedAt:   now,
+		dueAt:       timePtr(task.DueAt),
+	}
+	s.tasks[t.id] = t
+	return s.taskProto(t), nil
+}
+
+func (s *MemoryStorage) ListTasks(ctx context.Context, taskFilter TaskFilter) ([]*pb.Task, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	where := memFilter{s: s, now: time.Now()}
+	checklistID := normalizeID(taskFilter.ChecklistID)
+
+	var matched []*memTask
+	for _, t := range s.tasks {
+		if taskFilter.ChecklistID != "" && t.checklistID != checklistID {
+			continue
+		}
+		if taskFilter.AssigneeID != "" && !s.isAssigned(t.id, taskFilter.AssigneeID) {
+			continue
+		}
+		if taskFilter.OpenOnly && t.done {
+			continue
+		}
+		if taskFilter.Expression != nil {
+			ok, err := where.match(taskFilter.Expression, t)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile filter: %w", err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		matched = append(matched, t)
+	}
+	slices.SortFunc(matched, func(a, b *memTask) int {
+		return newestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+
+	var tasks []*pb.Task
+	for _, t := range matched {
+		tasks = append(tasks, s.taskProto(t))
+	}
+	return tasks, nil
+}
+
+func (s *MemoryStorage) GetTask(ctx context.Context, id string) (*pb.Task, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tasks[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return s.taskProto(t), nil
+}
+
+func (s *MemoryStorage) MarkTaskDone(ctx context.Context, id string, requirePrerequisites bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tasks[normalizeID(id)]
+	if !ok {
+		return ErrNotFound
+	}
+	if requirePrerequisites && s.isBlocked(t.id) {
+		return ErrTaskBlocked
+	}
+
+	now := s.now()
+	t.done = true
+	t.status = s.doneStatus(t.checklistID)
+	if t.completedAt == nil {
+		t.completedAt = &now
+	}
+	t.updatedAt = now
+	return nil
+}
+
+func (s *MemoryStorage) DeleteTask(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id = normalizeID(id)
+	if _, ok := s.tasks[id]; !ok {
+		return ErrNotFound
+	}
+	s.deleteTask(id)
+	return nil
+}
+
+// deleteTask removes the task and everything that references it, like the
+// ON DELETE clauses of the schema.
+func (s *MemoryStorage) deleteTask(id string) {
+	delete(s.tasks, id)
+	s.dependencies = slices.DeleteFunc(s.dependencies, func(d *memDependency) bool {
+		return d.taskID == id || d.dependsOnID == id
+	})
+	s.assignees = slices.DeleteFunc(s.assignees, func(a *memAssignee) bool { return a.taskID == id })
+	for cid, c := range s.comments {
+		if c.taskID == id {
+			delete(s.comments, cid)
+		}
+	}
+	for aid, a := range s.attachments {
+		if a.taskID == id {
+			delete(s.attachments, aid)
+		}
+	}
+	for rid, r := range s.recurrences {
+		if r.taskID == id {
+			s.deleteRecurrence(rid)
+		}
+	}
+}
+
+func (s *MemoryStorage) CountOpenTasks(ctx context.Context) (open int64, overdue int64, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	for _, t := range s.tasks {
+		if t.done {
+			continue
+		}
+		open++
+		if t.dueAt != nil && t.dueAt.Before(now) {
+			overdue++
+		}
+	}
+	return open, overdue, nil
+}
+
+func (s *MemoryStorage) taskProto(t *memTask) *pb.Task {
+	task := &pb.Task{
+		Id:           t.id,
+		Title:        t.title,
+		Description:  t.description,
+		Done:         t.done,
+		CreatedAt:    timestamppb.New(t.createdAt),
+		UpdatedAt:    timestamppb.New(t.updatedAt),
+		ChecklistId:  t.checklistID,
+		DueAt:        optionalTimestamp(t.dueAt),
+		CompletedAt:  optionalTimestamp(t.completedAt),
+		RecurrenceId: t.recurrenceID,
+		Tags:         copyTags(t.tags),
+		Blocked:      s.isBlocked(t.id),
+		DependsOnIds: []string{},
+		Status:       t.status,
+		AssigneeIds:  []string{},
+	}
+	for _, d := range s.sortedDependencies() {
+		if d.taskID == t.id {
+			task.DependsOnIds = append(task.DependsOnIds, d.dependsOnID)
+		}
+	}
+	for _, a := range s.sortedAssignees() {
+		if a.taskID == t.id {
+			task.AssigneeIds = append(task.AssigneeIds, a.userID)
+		}
+	}
+	return task
+}
+
+// isBlocked reports whether the task has open prerequisites.
+func (s *MemoryStorage) isBlocked(taskID string) bool {
+	for _, d := range s.dependencies {
+		if d.taskID != taskID {
+			continue
+		}
+		if p, ok := s.tasks[d.dependsOnID]; ok && !p.done {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStorage) isAssigned(taskID string, userID string) bool {
+	for _, a := range s.assignees {
+		if a.taskID == taskID && a.userID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStorage) sortedDependencies() []*memDependency {
+	deps := slices.Clone(s.dependencies)
+	slices.SortStableFunc(deps, func(a, b *memDependency) int {
+		return oldestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	return deps
+}
+
+func (s *MemoryStorage) sortedAssignees() []*memAssignee {
+	assignees := slices.Clone(s.assignees)
+	slices.SortStableFunc(assignees, func(a, b *memAssignee) int {
+		return oldestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	return assignees
+}
+
+// Checklists
+
+func (s *MemoryStorage) CreateChecklist(ctx context.Context, title string, description string) (*pb.Checklist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	c := &memChecklist{seq: s.next(), id: uuid.NewString(), title: title, description: description, createdAt: now, updatedAt: now}
+	s.checklists[c.id] = c
+	return checklistProto(c), nil
+}
+
+func (s *MemoryStorage) ListChecklists(ctx context.Context) ([]*pb.Checklist, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sorted := mapValues(s.checklists)
+	slices.SortFunc(sorted, func(a, b *memChecklist) int {
+		return newestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var checklists []*pb.Checklist
+	for _, c := range sorted {
+		checklists = append(checklists, checklistProto(c))
+	}
+	return checklists, nil
+}
+
+func (s *MemoryStorage) GetChecklist(ctx context.Context, id string) (*pb.Checklist, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.checklists[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return checklistProto(c), nil
+}
+
+func (s *MemoryStorage) CreateChecklistWithTasks(ctx context.Context, checklist *pb.Checklist, tasks []*pb.Task) (*pb.Checklist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	c := &memChecklist{seq: s.next(), id: uuid.NewString(), title: checklist.Title, description: checklist.Description,
+		createdAt: now, updatedAt: now}
+	s.checklists[c.id] = c
+
+	for _, task := range tasks {
+		t := &memTask{
+			seq:         s.next(),
+			id:          uuid.NewString(),
+			title:       task.Title,
+			description: task.Description,
+			status:      "todo",
+			checklistID: c.id,
+			tags:        copyTags(task.Tags),
+			createdAt:   now,
+			updatedAt:   now,
+			dueAt:       timePtr(task.DueAt),
+		}
+		s.tasks[t.id] = t
+	}
+	return checklistProto(c), nil
+}
+
+func checklistProto(c *memChecklist) *pb.Checklist {
+	return &pb.Checklist{
+		Id:           c.id,
+		Title:        c.title,
+		Description:  c.description,
+		CreatedAt:    timestamppb.New(c.createdAt),
+		UpdatedAt:    timestamppb.New(c.updatedAt),
+		RecurrenceId: c.recurrenceID,
+		OccurrenceAt: optionalTimestamp(c.occurrenceAt),
+	}
+}
+
+func mapValues[V any](m map[string]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Dependencies
+
+func (s *MemoryStorage) AddDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskID, dependsOnID = normalizeID(taskID), normalizeID(dependsOnID)
+
+	// Walk the prerequisites of dependsOnID; reaching taskID closes a cycle.
+	seen := map[string]bool{}
+	queue := []string{dependsOnID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, d := range s.dependencies {
+			if d.taskID != id || seen[d.dependsOnID] {
+				continue
+			}
+			if d.dependsOnID == taskID {
+				return ErrDependencyCycle
+			}
+			seen[d.dependsOnID] = true
+			queue = append(queue, d.dependsOnID)
+		}
+	}
+
+	if taskID == dependsOnID {
+		return errors.New("failed to add dependency: a task cannot depend on itself")
+	}
+	if _, ok := s.tasks[taskID]; !ok {
+		return ErrNotFound
+	}
+	if _, ok := s.tasks[dependsOnID]; !ok {
+		return ErrNotFound
+	}
+	for _, d := range s.dependencies {
+		if d.taskID == taskID && d.dependsOnID == dependsOnID {
+			return nil
+		}
+	}
+	s.dependencies = append(s.dependencies, &memDependency{seq: s.next(), taskID: taskID, dependsOnID: dependsOnID, createdAt: s.now()})
+	return nil
+}
+
+func (s *MemoryStorage) RemoveDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskID, dependsOnID = normalizeID(taskID), normalizeID(dependsOnID)
+	n := len(s.dependencies)
+	s.dependencies = slices.DeleteFunc(s.dependencies, func(d *memDependency) bool {
+		return d.taskID == taskID && d.dependsOnID == dependsOnID
+	})
+	if len(s.dependencies) == n {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Workflows
+
+// initialStatus is the in-memory counterpart of the SQL initialStatus.
+func (s *MemoryStorage) initialStatus(checklistID string) string {
+	for _, st := range s.states[checklistID] {
+		if !st.isDone {
+			return st.key
+		}
+	}
+	return "todo"
+}
+
+// doneStatus is the in-memory counterpart of the SQL doneStatus.
+func (s *MemoryStorage) doneStatus(checklistID string) string {
+	for _, st := range s.states[checklistID] {
+		if st.isDone {
+			return st.key
+		}
+	}
+	return "done"
+}
+
+func (s *MemoryStorage) GetWorkflow(ctx context.Context, checklistID string) (*pb.Workflow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := normalizeID(checklistID)
+	if _, ok := s.checklists[id]; !ok {
+		return nil, ErrNotFound
+	}
+
+	w := &pb.Workflow{ChecklistId: checklistID, States: []*pb.WorkflowState{}, Transitions: []*pb.WorkflowTransition{}}
+	positions := map[string]int{}
+	for i, st := range s.states[id] {
+		positions[st.key] = i
+		w.States = append(w.States, &pb.WorkflowState{Key: st.key, Name: st.name, IsDone: st.isDone})
+	}
+
+	transitions := slices.Clone(s.transitions[id])
+	slices.SortStableFunc(transitions, func(a, b *memTransition) int {
+		if c := positions[a.from] - positions[b.from]; c != 0 {
+			return c
+		}
+		return positions[a.to] - positions[b.to]
+	})
+	for _, t := range transitions {
+		w.Transitions = append(w.Transitions, &pb.WorkflowTransition{FromState: t.from, ToState: t.to})
+	}
+	return w, nil
+}
+
+func (s *MemoryStorage) SetWorkflow(ctx context.Context, w *pb.Workflow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(w.ChecklistId)
+	if _, ok := s.checklists[id]; !ok {
+		return ErrNotFound
+	}
+
+	// Build the new workflow first so that a constraint violation leaves the
+	// old one in place, as the rolled back transaction does.
+	var states []*memState
+	keys := map[string]*memState{}
+	for _, st := range w.States {
+		if _, dup := keys[st.Key]; dup {
+			return fmt.Errorf("failed to save workflow: duplicate state %q", st.Key)
+		}
+		state := &memState{key: st.Key, name: st.Name, isDone: st.IsDone}
+		keys[st.Key] = state
+		states = append(states, state)
+	}
+	var transitions []*memTransition
+	for _, t := range w.Transitions {
+		if keys[t.FromState] == nil || keys[t.ToState] == nil {
+			return fmt.Errorf("failed to save workflow: transition %s -> %s references an unknown state", t.FromState, t.ToState)
+		}
+		if !slices.ContainsFunc(transitions, func(x *memTransition) bool { return x.from == t.FromState && x.to == t.ToState }) {
+			transitions = append(transitions, &memTransition{from: t.FromState, to: t.ToState})
+		}
+	}
+	s.states[id] = states
+	s.transitions[id] = transitions
+
+	now := s.now()
+	for _, t := range s.tasks {
+		if t.checklistID != id {
+			continue
+		}
+		if keys[t.status] == nil {
+			if t.done {
+				t.status = s.doneStatus(id)
+			} else {
+				t.status = s.initialStatus(id)
+			}
+			t.updatedAt = now
+		}
+		if st := keys[t.status]; st != nil && st.isDone != t.done {
+			t.done = st.isDone
+			if !st.isDone {
+				t.completedAt = nil
+			} else if t.completedAt == nil {
+				t.completedAt = &now
+			}
+			t.updatedAt = now
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) TransitionTask(ctx context.Context, id string, from string, to string, done bool, requirePrerequisites bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tasks[normalizeID(id)]
+	if !ok {
+		return ErrNotFound
+	}
+	if t.status != from {
+		return ErrConflict
+	}
+	if requirePrerequisites && s.isBlocked(t.id) {
+		return ErrTaskBlocked
+	}
+
+	now := s.now()
+	t.status = to
+	t.done = done
+	if !done {
+		t.completedAt = nil
+	} else if t.completedAt == nil {
+		t.completedAt = &now
+	}
+	t.updatedAt = now
+	return nil
+}
+
+// copyWorkflow gives the checklist dst the same custom workflow as src.
+func (s *MemoryStorage) copyWorkflow(src string, dst string) {
+	for _, st := range s.states[src] {
+		copied := *st
+		s.states[dst] = append(s.states[dst], &copied)
+	}
+	for _, t := range s.transitions[src] {
+		copied := *t
+		s.transitions[dst] = append(s.transitions[dst], &copied)
+	}
+}
+
+// Members and assignees
+
+func (s *MemoryStorage) AddChecklistMember(ctx context.Context, checklistID string, userID string) (*pb.ChecklistMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(checklistID)
+	if _, ok := s.checklists[id]; !ok {
+		return nil, ErrNotFound
+	}
+	for _, m := range s.members {
+		if m.checklistID == id && m.userID == userID {
+			return memberProto(m), nil
+		}
+	}
+	m := &memMember{seq: s.next(), checklistID: id, userID: userID, createdAt: s.now()}
+	s.members = append(s.members, m)
+	return memberProto(m), nil
+}
+
+func (s *MemoryStorage) RemoveChecklistMember(ctx context.Context, checklistID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(checklistID)
+	n := len(s.members)
+	s.members = slices.DeleteFunc(s.members, func(m *memMember) bool { return m.checklistID == id && m.userID == userID })
+	if len(s.members) == n {
+		return ErrNotFound
+	}
+	s.assignees = slices.DeleteFunc(s.assignees, func(a *memAssignee) bool {
+		t := s.tasks[a.taskID]
+		return a.userID == userID && t != nil && t.checklistID == id
+	})
+	return nil
+}
+
+func (s *MemoryStorage) ListChecklistMembers(ctx context.Context, checklistID string) ([]*pb.ChecklistMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := normalizeID(checklistID)
+	sorted := slices.Clone(s.members)
+	slices.SortStableFunc(sorted, func(a, b *memMember) int {
+		return oldestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var members []*pb.ChecklistMember
+	for _, m := range sorted {
+		if m.checklistID == id {
+			members = append(members, memberProto(m))
+		}
+	}
+	return members, nil
+}
+
+func (s *MemoryStorage) AssignTask(ctx context.Context, taskID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tasks[normalizeID(taskID)]
+	if !ok {
+		return ErrNotFound
+	}
+	if s.isAssigned(t.id, userID) {
+		return nil
+	}
+	if t.checklistID == "" || !slices.ContainsFunc(s.members, func(m *memMember) bool {
+		return m.checklistID == t.checklistID && m.userID == userID
+	}) {
+		return ErrNotMember
+	}
+	s.assignees = append(s.assignees, &memAssignee{seq: s.next(), taskID: t.id, userID: userID, createdAt: s.now()})
+	return nil
+}
+
+func (s *MemoryStorage) UnassignTask(ctx context.Context, taskID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(taskID)
+	n := len(s.assignees)
+	s.assignees = slices.DeleteFunc(s.assignees, func(a *memAssignee) bool { return a.taskID == id && a.userID == userID })
+	if len(s.assignees) == n {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func memberProto(m *memMember) *pb.ChecklistMember {
+	return &pb.ChecklistMember{ChecklistId: m.checklistID, UserId: m.userID, CreatedAt: timestamppb.New(m.createdAt)}
+}
+
+// compareIDs orders UUIDs like the uuid type, which compares bytes and thus
+// matches the order of their lower-case text form.
+func compareIDs(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+// Stats
+
+func (s *MemoryStorage) GetStats(ctx context.Context, q StatsQuery) (*pb.Stats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	checklistID := normalizeID(q.ChecklistID)
+	var tasks []statsTask
+	for _, t := range s.tasks {
+		if q.ChecklistID != "" && t.checklistID != checklistID {
+			continue
+		}
+		st := statsTask{
+			checklistID: t.checklistID,
+			done:        t.done,
+			createdAt:   t.createdAt,
+			dueAt:       t.dueAt,
+			completedAt: t.completedAt,
+		}
+		if c, ok := s.checklists[t.checklistID]; ok {
+			st.checklistTitle = c.title
+		}
+		tasks = append(tasks, st)
+	}
+	return aggregateStats(q, tasks)
+}
@@ -0,0 +1,870 @@
+package storage
+
+import (
+	"bytes"
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/search"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type memShareLink struct {
+	seq          uint64
+	id           string
+	checklistID  string
+	tokenHash    []byte
+	passwordHash string
+	expiresAt    *time.Time
+	revokedAt    *time.Time
+	createdAt    time.Time
+}
+
+type memRecurrence struct {
+	seq         uint64
+	id          string
+	taskID      string
+	checklistID string
+	rrule       string
+	dtstart     time.Time
+	timezone    string
+	next        *time.Time
+	lastError   string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type memTemplate struct {
+	seq            uint64
+	id             string
+	name           string
+	description    string
+	checklistTitle string
+	createdAt      time.Time
+	updatedAt      time.Time
+	tasks          []*pb.TaskBlueprint
+}
+
+type memComment struct {
+	seq       uint64
+	id        string
+	taskID    string
+	authorID  string
+	body      string
+	createdAt time.Time
+	updatedAt time.Time
+	editedAt  *time.Time
+}
+
+type memAttachment struct {
+	seq         uint64
+	id          string
+	taskID      string
+	filename    string
+	contentType string
+	sizeBytes   int64
+	sha256      string
+	storageKey  string
+	uploadedBy  string
+	createdAt   time.Time
+}
+
+type memSavedView struct {
+	seq       uint64
+	id        string
+	name      string
+	filter    string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// Share links
+
+func (s *MemoryStorage) CreateShareLink(ctx context.Context, checklistID string, tokenHash []byte, passwordHash string, expiresAt *time.Time) (*pb.ShareLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(checklistID)
+	if _, ok := s.checklists[id]; !ok {
+		return nil, ErrNotFound
+	}
+	for _, l := range s.shareLinks {
+		if bytes.Equal(l.tokenHash, tokenHash) {
+			return nil, errors.New("failed to create share link: duplicate token hash")
+		}
+	}
+
+	var expires *time.Time
+	if expiresAt != nil {
+		t := expiresAt.UTC().Truncate(time.Microsecond)
+		expires = &t
+	}
+	l := &memShareLink{seq: s.next(), id: uuid.NewString(), checklistID: id, tokenHash: bytes.Clone(tokenHash),
+		passwordHash: passwordHash, expiresAt: expires, createdAt: s.now()}
+	s.shareLinks[l.id] = l
+	return shareLinkProto(l), nil
+}
+
+func (s *MemoryStorage) ListShareLinks(ctx context.Context, checklistID string) ([]*pb.ShareLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := normalizeID(checklistID)
+	sorted := mapValues(s.shareLinks)
+	slices.SortFunc(sorted, func(a, b *memShareLink) int {
+		return newestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var links []*pb.ShareLink
+	for _, l := range sorted {
+		if l.checklistID == id {
+			links = append(links, shareLinkProto(l))
+		}
+	}
+	return links, nil
+}
+
+func (s *MemoryStorage) RevokeShareLink(ctx context.Context, id string) (*pb.ShareLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.shareLinks[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if l.revokedAt == nil {
+		now := s.now()
+		l.revokedAt = &now
+	}
+	return shareLinkProto(l), nil
+}
+
+func (s *MemoryStorage) GetShareLinkByTokenHash(ctx context.Context, tokenHash []byte) (*pb.ShareLink, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, l := range s.shareLinks {
+		if bytes.Equal(l.tokenHash, tokenHash) {
+			return shareLinkProto(l), l.passwordHash, nil
+		}
+	}
+	return nil, "", ErrNotFound
+}
+
+func shareLinkProto(l *memShareLink) *pb.ShareLink {
+	return &pb.ShareLink{
+		Id:                l.id,
+		ChecklistId:       l.checklistID,
+		PasswordProtected: l.passwordHash != "",
+		ExpiresAt:         optionalTimestamp(l.expiresAt),
+		RevokedAt:         optionalTimestamp(l.revokedAt),
+		CreatedAt:         timestamppb.New(l.createdAt),
+	}
+}
+
+// Recurrences
+
+func (s *MemoryStorage) UpsertRecurrence(ctx context.Context, r *pb.Recurrence) (*pb.Recurrence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskID, checklistID := "", ""
+	switch {
+	case r.TaskId != "" && r.ChecklistId == "":
+		taskID = normalizeID(r.TaskId)
+		if _, ok := s.tasks[taskID]; !ok {
+			return nil, ErrNotFound
+		}
+	case r.ChecklistId != "" && r.TaskId == "":
+		checklistID = normalizeID(r.ChecklistId)
+		if _, ok := s.checklists[checklistID]; !ok {
+			return nil, ErrNotFound
+		}
+	default:
+		return nil, errors.New("failed to save recurrence: exactly one of task and checklist must be set")
+	}
+
+	now := s.now()
+	var rec *memRecurrence
+	for _, existing := range s.recurrences {
+		if (taskID != "" && existing.taskID == taskID) || (checklistID != "" && existing.checklistID == checklistID) {
+			rec = existing
+			break
+		}
+	}
+	if rec == nil {
+		rec = &memRecurrence{seq: s.next(), id: uuid.NewString(), taskID: taskID, checklistID: checklistID, createdAt: now}
+		s.recurrences[rec.id] = rec
+	}
+	rec.rrule = r.Rrule
+	rec.dtstart = r.Dtstart.AsTime().UTC().Truncate(time.Microsecond)
+	rec.timezone = r.Timezone
+	rec.next = timePtr(r.NextOccurrenceAt)
+	rec.lastError = ""
+	rec.updatedAt = now
+	return recurrenceProto(rec), nil
+}
+
+func (s *MemoryStorage) ListRecurrences(ctx context.Context) ([]*pb.Recurrence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sorted := mapValues(s.recurrences)
+	slices.SortFunc(sorted, func(a, b *memRecurrence) int {
+		return newestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var recurrences []*pb.Recurrence
+	for _, r := range sorted {
+		recurrences = append(recurrences, recurrenceProto(r))
+	}
+	return recurrences, nil
+}
+
+func (s *MemoryStorage) DeleteRecurrence(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id = normalizeID(id)
+	if _, ok := s.recurrences[id]; !ok {
+		return ErrNotFound
+	}
+	s.deleteRecurrence(id)
+	return nil
+}
+
+// deleteRecurrence removes the rule; its occurrences stay but lose the link
+// (ON DELETE SET NULL).
+func (s *MemoryStorage) deleteRecurrence(id string) {
+	delete(s.recurrences, id)
+	for _, t := range s.tasks {
+		if t.recurrenceID == id {
+			t.recurrenceID = ""
+		}
+	}
+	for _, c := range s.checklists {
+		if c.recurrenceID == id {
+			c.recurrenceID = ""
+		}
+	}
+}
+
+func (s *MemoryStorage) ProcessDueRecurrences(ctx context.Context, now time.Time, limit int, plan PlanFunc) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*memRecurrence
+	for _, r := range s.recurrences {
+		if r.next == nil {
+			continue
+		}
+		if !r.next.After(now) || s.latestOccurrenceCompleted(r) {
+			due = append(due, r)
+		}
+	}
+	slices.SortFunc(due, func(a, b *memRecurrence) int {
+		if c := a.next.Compare(*b.next); c != 0 {
+			return c
+		}
+		return compareSeq(a.seq, b.seq)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	var materialized int
+	for _, r := range due {
+		dr := DueRecurrence{ID: r.id, TaskID: r.taskID, ChecklistID: r.checklistID, RRule: r.rrule,
+			Timezone: r.timezone, DTStart: r.dtstart, NextOccurrenceAt: *r.next}
+		occurrence, next, err := plan(dr)
+		if err != nil {
+			r.next = nil
+			r.lastError = err.Error()
+			r.updatedAt = s.now()
+			continue
+		}
+		s.materializeOccurrence(r, occurrence.UTC().Truncate(time.Microsecond))
+		r.next = copyTime(next)
+		if r.next != nil {
+			*r.next = r.next.UTC().Truncate(time.Microsecond)
+		}
+		r.updatedAt = s.now()
+		materialized++
+	}
+	return materialized, nil
+}
+
+// latestOccurrenceCompleted reports whether the newest occurrence of r (the
+// source itself before the first one) is completed: the task is done, or the
+// checklist has tasks and all of them are done.
+func (s *MemoryStorage) latestOccurrenceCompleted(r *memRecurrence) bool {
+	newer := func(a, b *time.Time) bool {
+		// ORDER BY occurrence_at DESC NULLS LAST
+		return b == nil || (a != nil && a.After(*b))
+	}
+
+	if r.taskID != "" {
+		var latest *memTask
+		for _, t := range s.tasks {
+			if t.recurrenceID != r.id && t.id != r.taskID {
+				continue
+			}
+			if latest == nil || newer(t.occurrenceAt, latest.occurrenceAt) {
+				latest = t
+			}
+		}
+		return latest != nil && latest.done
+	}
+
+	var latest *memChecklist
+	for _, c := range s.checklists {
+		if c.recurrenceID != r.id && c.id != r.checklistID {
+			continue
+		}
+		if latest == nil || newer(c.occurrenceAt, latest.occurrenceAt) {
+			latest = c
+		}
+	}
+	if latest == nil {
+		return false
+	}
+	hasTasks := false
+	for _, t := range s.tasks {
+		if t.checklistID != latest.id {
+			continue
+		}
+		if !t.done {
+			return false
+		}
+		hasTasks = true
+	}
+	return hasTasks
+}
+
+// materializeOccurrence copies the source task, or the source checklist with
+// all its tasks, as the occurrence of r at the given time. An occurrence that
+// already exists is left alone.
+func (s *MemoryStorage) materializeOccurrence(r *memRecurrence, occurrence time.Time) {
+	now := s.now()
+
+	if r.taskID != "" {
+		src, ok := s.tasks[r.taskID]
+		if !ok {
+			return
+		}
+		for _, t := range s.tasks {
+			if t.recurrenceID == r.id && t.occurrenceAt != nil && t.occurrenceAt.Equal(occurrence) {
+				return
+			}
+		}
+		t := &memTask{
+			seq:          s.next(),
+			id:           uuid.NewString(),
+			title:        src.title,
+			description:  src.description,
+			status:       s.initialStatus(src.checklistID),
+			checklistID:  src.checklistID,
+			recurrenceID: r.id,
+			tags:         copyTags(src.tags),
+			createdAt:    now,
+			updatedAt:    now,
+			dueAt:        &occurrence,
+			occurrenceAt: copyTime(&occurrence),
+		}
+		s.tasks[t.id] = t
+		return
+	}
+
+	src, ok := s.checklists[r.checklistID]
+	if !ok {
+		return
+	}
+	for _, c := range s.checklists {
+		if c.recurrenceID == r.id && c.occurrenceAt != nil && c.occurrenceAt.Equal(occurrence) {
+			return
+		}
+	}
+	c := &memChecklist{seq: s.next(), id: uuid.NewString(), title: src.title, description: src.description,
+		recurrenceID: r.id, createdAt: now, updatedAt: now, occurrenceAt: copyTime(&occurrence)}
+	s.checklists[c.id] = c
+	s.copyWorkflow(src.id, c.id)
+
+	var sources []*memTask
+	for _, t := range s.tasks {
+		if t.checklistID == src.id {
+			sources = append(sources, t)
+		}
+	}
+	slices.SortFunc(sources, func(a, b *memTask) int { return compareSeq(a.seq, b.seq) })
+	for _, srcTask := range sources {
+		t := &memTask{
+			seq:         s.next(),
+			id:          uuid.NewString(),
+			title:       srcTask.title,
+			description: srcTask.description,
+			status:      s.initialStatus(c.id),
+			checklistID: c.id,
+			tags:        copyTags(srcTask.tags),
+			createdAt:   now,
+			updatedAt:   now,
+			dueAt:       copyTime(&occurrence),
+		}
+		s.tasks[t.id] = t
+	}
+}
+
+func recurrenceProto(r *memRecurrence) *pb.Recurrence {
+	return &pb.Recurrence{
+		Id:               r.id,
+		TaskId:           r.taskID,
+		ChecklistId:      r.checklistID,
+		Rrule:            r.rrule,
+		Dtstart:          timestamppb.New(r.dtstart),
+		Timezone:         r.timezone,
+		NextOccurrenceAt: optionalTimestamp(r.next),
+		CreatedAt:        timestamppb.New(r.createdAt),
+		UpdatedAt:        timestamppb.New(r.updatedAt),
+		LastError:        r.lastError,
+	}
+}
+
+// Templates
+
+func (s *MemoryStorage) CreateTemplateFromChecklist(ctx context.Context, checklistID string, name string, description string, checklistTitle string) (*pb.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.checklists[normalizeID(checklistID)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if checklistTitle == "" {
+		checklistTitle = c.title
+	}
+
+	var sources []*memTask
+	for _, t := range s.tasks {
+		if t.checklistID == c.id {
+			sources = append(sources, t)
+		}
+	}
+	slices.SortFunc(sources, func(a, b *memTask) int {
+		if n := a.createdAt.Compare(b.createdAt); n != 0 {
+			return n
+		}
+		return compareIDs(a.id, b.id)
+	})
+
+	now := s.now()
+	tmpl := &memTemplate{seq: s.next(), id: uuid.NewString(), name: name, description: description,
+		checklistTitle: checklistTitle, createdAt: now, updatedAt: now, tasks: []*pb.TaskBlueprint{}}
+	for _, t := range sources {
+		blueprint := &pb.TaskBlueprint{Title: t.title, Description: t.description, Tags: copyTags(t.tags)}
+		if t.dueAt != nil {
+			// EXTRACT(EPOCH ...)::BIGINT rounds half away from zero.
+			seconds := math.Round(t.dueAt.Sub(c.createdAt).Seconds())
+			blueprint.DueOffset = durationpb.New(time.Duration(seconds) * time.Second)
+		}
+		tmpl.tasks = append(tmpl.tasks, blueprint)
+	}
+	s.templates[tmpl.id] = tmpl
+	return templateProto(tmpl), nil
+}
+
+func (s *MemoryStorage) GetTemplate(ctx context.Context, id string) (*pb.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tmpl, ok := s.templates[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return templateProto(tmpl), nil
+}
+
+func (s *MemoryStorage) ListTemplates(ctx context.Context) ([]*pb.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sorted := mapValues(s.templates)
+	slices.SortFunc(sorted, func(a, b *memTemplate) int {
+		return newestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	templates := []*pb.Template{}
+	for _, tmpl := range sorted {
+		templates = append(templates, templateProto(tmpl))
+	}
+	return templates, nil
+}
+
+func templateProto(t *memTemplate) *pb.Template {
+	tmpl := &pb.Template{
+		Id:             t.id,
+		Name:           t.name,
+		Description:    t.description,
+		ChecklistTitle: t.checklistTitle,
+		CreatedAt:      timestamppb.New(t.createdAt),
+		UpdatedAt:      timestamppb.New(t.updatedAt),
+		Tasks:          []*pb.TaskBlueprint{},
+	}
+	for _, task := range t.tasks {
+		blueprint := &pb.TaskBlueprint{Title: task.Title, Description: task.Description, Tags: copyTags(task.Tags)}
+		if task.DueOffset != nil {
+			blueprint.DueOffset = durationpb.New(task.DueOffset.AsDuration())
+		}
+		tmpl.Tasks = append(tmpl.Tasks, blueprint)
+	}
+	return tmpl
+}
+
+// Comments
+
+func (s *MemoryStorage) AddComment(ctx context.Context, taskID string, authorID string, body string) (*pb.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := normalizeID(taskID)
+	if _, ok := s.tasks[id]; !ok {
+		return nil, ErrNotFound
+	}
+	now := s.now()
+	c := &memComment{seq: s.next(), id: uuid.NewString(), taskID: id, authorID: authorID, body: body, createdAt: now, updatedAt: now}
+	s.comments[c.id] = c
+	return commentProto(c), nil
+}
+
+func (s *MemoryStorage) ListComments(ctx context.Context, taskID string, after *CommentCursor, limit int) ([]*pb.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := normalizeID(taskID)
+	var matched []*memComment
+	for _, c := range s.comments {
+		if c.taskID != id {
+			continue
+		}
+		if after != nil && compareComment(c.createdAt, c.id, after.CreatedAt, normalizeID(after.ID)) <= 0 {
+			continue
+		}
+		matched = append(matched, c)
+	}
+	slices.SortFunc(matched, func(a, b *memComment) int {
+		return compareComment(a.createdAt, a.id, b.createdAt, b.id)
+	})
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+
+	var comments []*pb.Comment
+	for _, c := range matched {
+		comments = append(comments, commentProto(c))
+	}
+	return comments, nil
+}
+
+// compareComment orders by (created_at, id) like the keyset pagination.
+func compareComment(aCreated time.Time, aID string, bCreated time.Time, bID string) int {
+	if c := aCreated.Compare(bCreated); c != 0 {
+		return c
+	}
+	return compareIDs(aID, bID)
+}
+
+func (s *MemoryStorage) EditComment(ctx context.Context, id string, taskID string, authorID string, body string) (*pb.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.ownComment(id, taskID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	c.body = body
+	c.editedAt = &now
+	c.updatedAt = now
+	return commentProto(c), nil
+}
+
+func (s *MemoryStorage) DeleteComment(ctx context.Context, id string, taskID string, authorID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.ownComment(id, taskID, authorID)
+	if err != nil {
+		return err
+	}
+	delete(s.comments, c.id)
+	return nil
+}
+
+// ownComment returns the comment of the task if authorID wrote it, and
+// otherwise the error commentAccessError gives.
+func (s *MemoryStorage) ownComment(id string, taskID string, authorID string) (*memComment, error) {
+	c, ok := s.comments[normalizeID(id)]
+	if !ok || c.taskID != normalizeID(taskID) {
+		return nil, ErrNotFound
+	}
+	if c.authorID != authorID {
+		return nil, ErrNotAuthor
+	}
+	return c, nil
+}
+
+func commentProto(c *memComment) *pb.Comment {
+	return &pb.Comment{
+		Id:        c.id,
+		TaskId:    c.taskID,
+		AuthorId:  c.authorID,
+		Body:      c.body,
+		CreatedAt: timestamppb.New(c.createdAt),
+		UpdatedAt: timestamppb.New(c.updatedAt),
+		EditedAt:  optionalTimestamp(c.editedAt),
+	}
+}
+
+// Attachments
+
+func (s *MemoryStorage) CreateAttachment(ctx context.Context, a *pb.Attachment) (*pb.Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskID := normalizeID(a.TaskId)
+	if _, ok := s.tasks[taskID]; !ok {
+		return nil, ErrNotFound
+	}
+	for _, existing := range s.attachments {
+		if existing.storageKey == a.StorageKey {
+			return nil, errors.New("failed to create attachment: duplicate storage key")
+		}
+	}
+
+	stored := &memAttachment{
+		seq:         s.next(),
+		id:          uuid.NewString(),
+		taskID:      taskID,
+		filename:    a.Filename,
+		contentType: a.ContentType,
+		sizeBytes:   a.SizeBytes,
+		sha256:      a.Sha256,
+		storageKey:  a.StorageKey,
+		uploadedBy:  a.UploadedBy,
+		createdAt:   s.now(),
+	}
+	s.attachments[stored.id] = stored
+	return attachmentProto(stored), nil
+}
+
+func (s *MemoryStorage) ListAttachments(ctx context.Context, taskID string) ([]*pb.Attachment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := normalizeID(taskID)
+	sorted := mapValues(s.attachments)
+	slices.SortFunc(sorted, func(a, b *memAttachment) int {
+		return oldestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var attachments []*pb.Attachment
+	for _, a := range sorted {
+		if a.taskID == id {
+			attachments = append(attachments, attachmentProto(a))
+		}
+	}
+	return attachments, nil
+}
+
+func (s *MemoryStorage) GetAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.attachments[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return attachmentProto(a), nil
+}
+
+func (s *MemoryStorage) DeleteAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.attachments[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	delete(s.attachments, a.id)
+	return attachmentProto(a), nil
+}
+
+func attachmentProto(a *memAttachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          a.id,
+		TaskId:      a.taskID,
+		Filename:    a.filename,
+		ContentType: a.contentType,
+		SizeBytes:   a.sizeBytes,
+		Sha256:      a.sha256,
+		StorageKey:  a.storageKey,
+		UploadedBy:  a.uploadedBy,
+		CreatedAt:   timestamppb.New(a.createdAt),
+	}
+}
+
+// Saved views
+
+func (s *MemoryStorage) CreateSavedView(ctx context.Context, name string, filter string) (*pb.SavedView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	v := &memSavedView{seq: s.next(), id: uuid.NewString(), name: name, filter: filter, createdAt: now, updatedAt: now}
+	s.savedViews[v.id] = v
+	return savedViewProto(v), nil
+}
+
+func (s *MemoryStorage) ListSavedViews(ctx context.Context) ([]*pb.SavedView, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sorted := mapValues(s.savedViews)
+	slices.SortFunc(sorted, func(a, b *memSavedView) int {
+		if c := cmp.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return oldestFirst(a.createdAt, a.seq, b.createdAt, b.seq)
+	})
+	var views []*pb.SavedView
+	for _, v := range sorted {
+		views = append(views, savedViewProto(v))
+	}
+	return views, nil
+}
+
+func (s *MemoryStorage) GetSavedView(ctx context.Context, id string) (*pb.SavedView, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.savedViews[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return savedViewProto(v), nil
+}
+
+func (s *MemoryStorage) UpdateSavedView(ctx context.Context, id string, name string, filter string) (*pb.SavedView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.savedViews[normalizeID(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	v.name, v.filter, v.updatedAt = name, filter, s.now()
+	return savedViewProto(v), nil
+}
+
+func (s *MemoryStorage) DeleteSavedView(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id = normalizeID(id)
+	if _, ok := s.savedViews[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.savedViews, id)
+	return nil
+}
+
+func savedViewProto(v *memSavedView) *pb.SavedView {
+	return &pb.SavedView{
+		Id:        v.id,
+		Name:      v.name,
+		Filter:    v.filter,
+		CreatedAt: timestamppb.New(v.createdAt),
+		UpdatedAt: timestamppb.New(v.updatedAt),
+	}
+}
+
+// Search
+
+// Weights of the title (A), description (B) and comment (C) matches, the
+// ts_rank_cd defaults.
+const (
+	titleWeight       = 1.0
+	descriptionWeight = 0.4
+	commentWeight     = 0.2
+	snippetMaxWords   = 30
+)
+
+func (s *MemoryStorage) SearchTasks(ctx context.Context, tsquery string, checklistID string, limit int) ([]*pb.SearchResult, error) {
+	q, err := search.CompileQuery(tsquery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	checklistID = normalizeID(checklistID)
+	type hit struct {
+		task    *memTask
+		rank    float64
+		comment *memComment
+	}
+	var hits []hit
+	for _, t := range s.tasks {
+		if checklistID != "" && t.checklistID != checklistID {
+			continue
+		}
+
+		var best *memComment
+		bestRank := 0.0
+		for _, c := range s.comments {
+			if c.taskID != t.id || !q.Match(c.body) {
+				continue
+			}
+			rank := q.Rank(c.body, commentWeight)
+			if best == nil || rank > bestRank || (rank == bestRank && oldestFirst(c.createdAt, c.seq, best.createdAt, best.seq) < 0) {
+				best, bestRank = c, rank
+			}
+		}
+
+		if !q.Match(t.title, t.description) && best == nil {
+			continue
+		}
+		rank := q.Rank(t.title, titleWeight) + q.Rank(t.description, descriptionWeight) + bestRank
+		hits = append(hits, hit{task: t, rank: rank, comment: best})
+	}
+	slices.SortFunc(hits, func(a, b hit) int {
+		if c := cmp.Compare(b.rank, a.rank); c != 0 {
+			return c
+		}
+		return newestFirst(a.task.updatedAt, a.task.seq, b.task.updatedAt, b.task.seq)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	var results []*pb.SearchResult
+	for _, h := range hits {
+		result := &pb.SearchResult{
+			Task:           s.taskProto(h.task),
+			Rank:           float32(h.rank),
+			TitleHighlight: q.Highlight(h.task.title),
+		}
+		if q.Match(h.task.description) {
+			result.DescriptionSnippet = q.Snippet(h.task.description, snippetMaxWords)
+		}
+		if h.comment != nil {
+			result.CommentId = h.comment.id
+			result.CommentSnippet = q.Snippet(h.comment.body, snippetMaxWords)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
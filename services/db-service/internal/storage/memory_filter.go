@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"checklist-go/services/db-service/internal/filter"
+	"checklist-go/services/db-service/internal/search"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// memFilter evaluates a parsed filter expression against a task with the
+// semantics of filterSQL.
+type memFilter struct {
+	s   *MemoryStorage
+	now time.Time
+}
+
+func (f memFilter) match(e filter.Expr, t *memTask) (bool, error) {
+	switch e := e.(type) {
+	case filter.And:
+		l, err := f.match(e.Left, t)
+		if err != nil {
+			return false, err
+		}
+		r, err := f.match(e.Right, t)
+		return l && r, err
+	case filter.Or:
+		l, err := f.match(e.Left, t)
+		if err != nil {
+			return false, err
+		}
+		r, err := f.match(e.Right, t)
+		return l || r, err
+	case filter.Not:
+		x, err := f.match(e.X, t)
+		return !x, err
+
+	case filter.Flag:
+		var v bool
+		switch e.Field {
+		case "done":
+			v = t.done
+		case "blocked":
+			v = f.s.isBlocked(t.id)
+		default:
+			return false, fmt.Errorf("unsupported filter flag %q", e.Field)
+		}
+		return v == e.Value, nil
+
+	case filter.Match:
+		switch e.Field {
+		case "tag":
+			return slices.Contains(t.tags, e.Value), nil
+		case "status":
+			return t.status == e.Value, nil
+		case "assignee":
+			return f.s.isAssigned(t.id, e.Value), nil
+		case "checklist":
+			return t.checklistID == normalizeID(e.Value), nil
+		case "title":
+			return containsFold(t.title, e.Value), nil
+		case "description":
+			return containsFold(t.description, e.Value), nil
+		case "text":
+			return search.PlainQuery(e.Value).Match(t.title, t.description), nil
+		}
+		return false, fmt.Errorf("unsupported filter field %q", e.Field)
+
+	case filter.TimeSet:
+		v, ok := taskTime(t, e.Field)
+		if !ok {
+			return false, fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		return (v != nil) == e.Set, nil
+
+	case filter.TimeCompare:
+		v, ok := taskTime(t, e.Field)
+		if !ok {
+			return false, fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		at := e.Value.Resolve(f.now)
+		nextDay := at.AddDate(0, 0, 1)
+		// Tasks without the time do not match, like the COALESCE in SQL.
+		if v == nil {
+			switch e.Op {
+			case "=", "<", "<=", ">", ">=":
+				return false, nil
+			}
+			return false, fmt.Errorf("unsupported time comparison %q", e.Op)
+		}
+
+		switch {
+		case e.Op == "=" && e.Value.Day:
+			return !v.Before(at) && v.Before(nextDay), nil
+		case e.Op == "<=" && e.Value.Day:
+			return v.Before(nextDay), nil
+		case e.Op == ">" && e.Value.Day:
+			return !v.Before(nextDay), nil
+		case e.Op == "<":
+			return v.Before(at), nil
+		case e.Op == "<=":
+			return !v.After(at), nil
+		case e.Op == ">":
+			return v.After(at), nil
+		case e.Op == ">=":
+			return !v.Before(at), nil
+		}
+		return false, fmt.Errorf("unsupported time comparison %q", e.Op)
+	}
+	return false, fmt.Errorf("unsupported filter expression %T", e)
+}
+
+// taskTime returns the time field of the filter language; ok is false for
+// unknown fields.
+func taskTime(t *memTask, field string) (v *time.Time, ok bool) {
+	switch field {
+	case "due":
+		return t.dueAt, true
+	case "created":
+		return &t.createdAt, true
+	case "updated":
+		return &t.updatedAt, true
+	case "completed":
+		return t.completedAt, true
+	}
+	return nil, false
+}
+
+// containsFold is the ILIKE '%substr%' of likePattern.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
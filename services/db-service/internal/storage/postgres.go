@@ -2,11 +2,14 @@ package storage
 
 import (
 	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/filter"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -16,8 +19,37 @@ type Storage struct {
 	db *pgxpool.Pool
 }
 
-func NewStorage(dsn string) (*Storage, error) {
-	pool, err := pgxpool.New(context.Background(), dsn)
+// Config describes the database connection and its pool.
+type Config struct {
+	DSN             string
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+	// Tracer, when not nil, observes every query.
+	Tracer pgx.QueryTracer
+}
+
+// NewPostgresStorage connects to the database. Zero pool settings keep the pgxpool
+// defaults.
+func NewPostgresStorage(cfg Config) (*Storage, error) {
+	config, err := pgxpool.ParseConfig(cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse DB_DSN: %w", err)
+	}
+	if cfg.MaxConns > 0 {
+		config.MaxConns = cfg.MaxConns
+	}
+	config.MinConns = cfg.MinConns
+	if cfg.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		config.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	config.ConnConfig.Tracer = cfg.Tracer
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 	}
@@ -31,31 +63,86 @@ func (s *Storage) Close() {
 	s.db.Close()
 }
 
-func (s *Storage) CreateTask(ctx context.Context, title string, description string) (*pb.Task, error) {
+// Ping checks that the database is reachable.
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.db.Ping(ctx)
+}
+
+// PoolStat returns a snapshot of the connection pool statistics.
+func (s *Storage) PoolStat() *pgxpool.Stat {
+	return s.db.Stat()
+}
+
+// blockedCondition is true for a row of tasks that has open prerequisites.
+const blockedCondition = `EXISTS (SELECT 1 FROM task_dependencies d JOIN tasks p ON p.id = d.depends_on_id
+	WHERE d.task_id = tasks.id AND NOT p.done)`
+
+// taskColumns is the column list every task query selects, in the order
+// scanTask expects.
+const taskColumns = `id, title, COALESCE(description, ''), done, created_at, updated_at,
+	COALESCE(checklist_id::text, ''), due_at, completed_at, COALESCE(recurrence_id::text, ''), tags,
+	` + blockedCondition + `,
+	ARRAY(SELECT d.depends_on_id::text FROM task_dependencies d WHERE d.task_id = tasks.id ORDER BY d.created_at),
+	status,
+	ARRAY(SELECT a.user_id FROM task_assignees a WHERE a.task_id = tasks.id ORDER BY a.created_at)`
+
+// CreateTask inserts a new task built from the title, description,
+// checklist ID, due date and tags of task and returns the stored row.
+func (s *Storage) CreateTask(ctx context.Context, task *pb.Task) (*pb.Task, error) {
 	id := uuid.New()
 
-	query := `INSERT INTO tasks (id, title, description) VALUES ($1, $2, $3) RETURNING created_at, updated_at`
+	var dueAt *time.Time
+	if task.DueAt != nil {
+		t := task.DueAt.AsTime()
+		dueAt = &t
+	}
 
-	var createdAt, updatedAt time.Time
+	tags := task.Tags
+	if tags == nil {
+		tags = []string{}
+	}
 
-	err := s.db.QueryRow(ctx, query, id, title, description).Scan(&createdAt, &updatedAt)
+	query := `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6, ` + initialStatus(`NULLIF($4, '')::uuid`) + `)
+		RETURNING ` + taskColumns
+
+	created, err := scanTask(s.db.QueryRow(ctx, query, id, task.Title, task.Description, task.ChecklistId, dueAt, tags))
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
-	return &pb.Task{
-		Id:          id.String(),
-		Title:       title,
-		Description: description,
-		Done:   false,
-		CreatedAt:   timestamppb.New(createdAt),
-		UpdatedAt:   timestamppb.New(updatedAt),
-	}, nil
+	return created, nil
+}
+
+// TaskFilter narrows ListTasks down; zero fields do not filter.
+type TaskFilter struct {
+	ChecklistID string
+	AssigneeID  string
+	OpenOnly    bool
+	// Expression is a parsed filter language expression.
+	Expression filter.Expr
 }
 
-func (s *Storage) ListTasks(ctx context.Context) ([]*pb.Task, error) {
-	query := `SELECT id, title, description, done, created_at, updated_at FROM tasks ORDER BY created_at desc`
-	rows, err := s.db.Query(ctx, query)
+func (s *Storage) ListTasks(ctx context.Context, taskFilter TaskFilter) ([]*pb.Task, error) {
+	where := filterSQL{args: []any{taskFilter.ChecklistID, taskFilter.AssigneeID, taskFilter.OpenOnly}, now: time.Now()}
+	expression := "true"
+	if taskFilter.Expression != nil {
+		var err error
+		if expression, err = where.build(taskFilter.Expression); err != nil {
+			return nil, fmt.Errorf("failed to compile filter: %w", err)
+		}
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM tasks
+		WHERE ($1 = '' OR checklist_id = NULLIF($1, '')::uuid)
+			AND ($2 = '' OR EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = $2))
+			AND NOT ($3 AND done)
+			AND ` + expression + `
+		ORDER BY created_at desc`
+	rows, err := s.db.Query(ctx, query, where.args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tasks: %w", err)
 	}
@@ -63,23 +150,11 @@ func (s *Storage) ListTasks(ctx context.Context) ([]*pb.Task, error) {
 
 	var tasks []*pb.Task
 	for rows.Next() {
-		var id uuid.UUID
-		var title, description string
-		var done bool
-		var createdAt, updatedAt time.Time
-
-		if err = rows.Scan(&id, &title, &description, &done, &createdAt, &updatedAt); err != nil {
+		task, err := scanTask(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
-
-		tasks = append(tasks, &pb.Task{
-			Id:          id.String(),
-			Title:       title,
-			Description: description,
-			Done:   done,
-			CreatedAt:   timestamppb.New(createdAt),
-			UpdatedAt:   timestamppb.New(updatedAt),
-		})
+		tasks = append(tasks, task)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("failed to iterate over tasks: %w", err)
@@ -88,35 +163,72 @@ func (s *Storage) ListTasks(ctx context.Context) ([]*pb.Task, error) {
 } 
 
 func (s *Storage) GetTask(ctx context.Context, id string) (*pb.Task, error) {
-	query := `SELECT id, title, description, done, created_at, updated_at FROM tasks WHERE id = $1`
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1`
 
-	var task pb.Task
-	var uid uuid.UUID
-	var created_at, updated_at time.Time
-
-	err := s.db.QueryRow(ctx, query, id).Scan(&uid, &task.Title, &task.Description, &task.Done, &created_at, &updated_at)
+	task, err := scanTask(s.db.QueryRow(ctx, query, id))
 	if err != nil {
-		if err.Error() == "no rows in result set"{
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
 		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
 
-	task.Id = uid.String()
-	task.CreatedAt = timestamppb.New(created_at)
-	task.UpdatedAt = timestamppb.New(updated_at)
+	return task, nil
+}
+
+// scanTask scans the taskColumns of row, followed by any extra columns of
+// the query into extra.
+func scanTask(row pgx.Row, extra ...any) (*pb.Task, error) {
+	var task pb.Task
+	var id uuid.UUID
+	var createdAt, updatedAt time.Time
+	var dueAt, completedAt *time.Time
+
+	dest := append([]any{&id, &task.Title, &task.Description, &task.Done, &createdAt, &updatedAt,
+		&task.ChecklistId, &dueAt, &completedAt, &task.RecurrenceId, &task.Tags, &task.Blocked, &task.DependsOnIds, &task.Status,
+		&task.AssigneeIds}, extra...)
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Id = id.String()
+	task.CreatedAt = timestamppb.New(createdAt)
+	task.UpdatedAt = timestamppb.New(updatedAt)
+	task.DueAt = optionalTimestamp(dueAt)
+	task.CompletedAt = optionalTimestamp(completedAt)
 
 	return &task, nil
 }
 
-func (s *Storage) MarkTaskDone(ctx context.Context, id string) error {
-	query := `UPDATE tasks SET done = true, updated_at = NOW() WHERE id = $1`
-	cmdTag, err := s.db.Exec(ctx, query, id)
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// MarkTaskDone completes the task by moving it to the first done state of its
+// workflow, regardless of the allowed transitions. When requirePrerequisites is set, a task
+// that still has open prerequisites is left untouched and ErrTaskBlocked is
+// returned.
+func (s *Storage) MarkTaskDone(ctx context.Context, id string, requirePrerequisites bool) error {
+	query := `UPDATE tasks SET done = true, status = ` + doneStatus(`tasks.checklist_id`) + `,
+		completed_at = COALESCE(completed_at, NOW()), updated_at = NOW()
+		WHERE id = $1 AND NOT ($2 AND ` + blockedCondition + `)`
+	cmdTag, err := s.db.Exec(ctx, query, id, requirePrerequisites)
 	if err != nil {
 		return fmt.Errorf("failed to mark task done: %w", err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := s.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to mark task done: %w", err)
+		}
+		if exists {
+			return ErrTaskBlocked
+		}
 		return ErrNotFound
 	}
 
@@ -0,0 +1,238 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const recurrenceColumns = `id, COALESCE(task_id::text, ''), COALESCE(checklist_id::text, ''), rrule, dtstart, timezone,
+	next_occurrence_at, created_at, updated_at, COALESCE(last_error, '')`
+
+// DueRecurrence is a recurrence rule picked up by the scheduler because its
+// next occurrence is due or because its latest occurrence has been completed.
+type DueRecurrence struct {
+	ID               string
+	TaskID           string
+	ChecklistID      string
+	RRule            string
+	Timezone         string
+	DTStart          time.Time
+	NextOccurrenceAt time.Time
+}
+
+// PlanFunc decides which occurrence of a due recurrence to materialize and
+// when the one after it is. A nil next means the rule is exhausted.
+type PlanFunc func(r DueRecurrence) (occurrence time.Time, next *time.Time, err error)
+
+// UpsertRecurrence attaches the rule to the task or checklist of r, replacing
+// any rule the target already has.
+func (s *Storage) UpsertRecurrence(ctx context.Context, r *pb.Recurrence) (*pb.Recurrence, error) {
+	target := "task_id"
+	if r.ChecklistId != "" {
+		target = "checklist_id"
+	}
+
+	query := `INSERT INTO recurrences (id, task_id, checklist_id, rrule, dtstart, timezone, next_occurrence_at)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5, $6, $7)
+		ON CONFLICT (` + target + `) DO UPDATE SET
+			rrule = EXCLUDED.rrule,
+			dtstart = EXCLUDED.dtstart,
+			timezone = EXCLUDED.timezone,
+			next_occurrence_at = EXCLUDED.next_occurrence_at,
+			last_error = NULL,
+			updated_at = NOW()
+		RETURNING ` + recurrenceColumns
+
+	var next *time.Time
+	if r.NextOccurrenceAt != nil {
+		t := r.NextOccurrenceAt.AsTime()
+		next = &t
+	}
+
+	rec, err := scanRecurrence(s.db.QueryRow(ctx, query, uuid.New(), r.TaskId, r.ChecklistId, r.Rrule, r.Dtstart.AsTime(), r.Timezone, next))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to save recurrence: %w", err)
+	}
+	return rec, nil
+}
+
+func (s *Storage) ListRecurrences(ctx context.Context) ([]*pb.Recurrence, error) {
+	query := `SELECT ` + recurrenceColumns + ` FROM recurrences ORDER BY created_at desc`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var recurrences []*pb.Recurrence
+	for rows.Next() {
+		rec, err := scanRecurrence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recurrence: %w", err)
+		}
+		recurrences = append(recurrences, rec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over recurrences: %w", err)
+	}
+	return recurrences, nil
+}
+
+func (s *Storage) DeleteRecurrence(ctx context.Context, id string) error {
+	cmdTag, err := s.db.Exec(ctx, `DELETE FROM recurrences WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete recurrence: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ProcessDueRecurrences materializes occurrences for up to limit recurrences
+// that are due at now, or whose latest occurrence (the source task or
+// checklist before the first one) is completed. It returns the number of
+// occurrences materialized. A rule plan fails for is disabled by clearing its
+// next occurrence and recording the error as its last_error, so that it is
+// not picked up again until it is set anew; those errors are not returned.
+//
+// Rules are locked with FOR UPDATE SKIP LOCKED, so concurrent db-service
+// replicas work on disjoint sets, and the unique (recurrence_id,
+// occurrence_at) indexes turn any remaining race into a no-op insert.
+func (s *Storage) ProcessDueRecurrences(ctx context.Context, now time.Time, limit int, plan PlanFunc) (int, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `SELECT r.id, COALESCE(r.task_id::text, ''), COALESCE(r.checklist_id::text, ''), r.rrule, r.timezone,
+			r.dtstart, r.next_occurrence_at
+		FROM recurrences r
+		WHERE r.next_occurrence_at IS NOT NULL
+			AND (r.next_occurrence_at <= $1
+				OR (r.task_id IS NOT NULL AND (
+					SELECT t.done FROM tasks t
+					WHERE t.recurrence_id = r.id OR t.id = r.task_id
+					ORDER BY t.occurrence_at DESC NULLS LAST
+					LIMIT 1))
+				OR (r.checklist_id IS NOT NULL AND (
+					SELECT EXISTS (SELECT 1 FROM tasks t WHERE t.checklist_id = c.id)
+						AND NOT EXISTS (SELECT 1 FROM tasks t WHERE t.checklist_id = c.id AND NOT t.done)
+					FROM checklists c
+					WHERE c.recurrence_id = r.id OR c.id = r.checklist_id
+					ORDER BY c.occurrence_at DESC NULLS LAST
+					LIMIT 1)))
+		ORDER BY r.next_occurrence_at
+		LIMIT $2
+		FOR UPDATE OF r SKIP LOCKED`
+
+	rows, err := tx.Query(ctx, query, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select due recurrences: %w", err)
+	}
+	due, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DueRecurrence, error) {
+		var r DueRecurrence
+		err := row.Scan(&r.ID, &r.TaskID, &r.ChecklistID, &r.RRule, &r.Timezone, &r.DTStart, &r.NextOccurrenceAt)
+		return r, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan due recurrences: %w", err)
+	}
+
+	var materialized int
+	for _, r := range due {
+		occurrence, next, err := plan(r)
+		if err != nil {
+			_, err = tx.Exec(ctx, `UPDATE recurrences SET next_occurrence_at = NULL, last_error = $2, updated_at = NOW() WHERE id = $1`,
+				r.ID, err.Error())
+			if err != nil {
+				return 0, fmt.Errorf("failed to disable recurrence %s: %w", r.ID, err)
+			}
+			continue
+		}
+		if err := materializeOccurrence(ctx, tx, r, occurrence); err != nil {
+			return 0, fmt.Errorf("recurrence %s: %w", r.ID, err)
+		}
+		_, err = tx.Exec(ctx, `UPDATE recurrences SET next_occurrence_at = $2, updated_at = NOW() WHERE id = $1`, r.ID, next)
+		if err != nil {
+			return 0, fmt.Errorf("failed to advance recurrence %s: %w", r.ID, err)
+		}
+		materialized++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return materialized, nil
+}
+
+// materializeOccurrence copies the source task, or the source checklist with
+// all its tasks, as the occurrence of r at the given time.
+func materializeOccurrence(ctx context.Context, tx pgx.Tx, r DueRecurrence, occurrence time.Time) error {
+	if r.TaskID != "" {
+		_, err := tx.Exec(ctx, `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status, recurrence_id, occurrence_at)
+			SELECT $1, title, description, checklist_id, $3, tags, `+initialStatus(`tasks.checklist_id`)+`, $2, $3 FROM tasks WHERE id = $4
+			ON CONFLICT (recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL DO NOTHING`,
+			uuid.New(), r.ID, occurrence, r.TaskID)
+		if err != nil {
+			return fmt.Errorf("failed to create task occurrence: %w", err)
+		}
+		return nil
+	}
+
+	checklistID := uuid.New()
+	cmdTag, err := tx.Exec(ctx, `INSERT INTO checklists (id, title, description, recurrence_id, occurrence_at)
+		SELECT $1, title, description, $2, $3 FROM checklists WHERE id = $4
+		ON CONFLICT (recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL DO NOTHING`,
+		checklistID, r.ID, occurrence, r.ChecklistID)
+	if err != nil {
+		return fmt.Errorf("failed to create checklist occurrence: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return nil
+	}
+
+	if err := copyWorkflow(ctx, tx, r.ChecklistID, checklistID.String()); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status)
+		SELECT gen_random_uuid(), title, description, $1, $2, tags, `+initialStatus(`$1`)+` FROM tasks WHERE checklist_id = $3`,
+		checklistID, occurrence, r.ChecklistID)
+	if err != nil {
+		return fmt.Errorf("failed to copy checklist tasks: %w", err)
+	}
+	return nil
+}
+
+func scanRecurrence(row pgx.Row) (*pb.Recurrence, error) {
+	var rec pb.Recurrence
+	var id uuid.UUID
+	var dtstart, createdAt, updatedAt time.Time
+	var next *time.Time
+
+	err := row.Scan(&id, &rec.TaskId, &rec.ChecklistId, &rec.Rrule, &dtstart, &rec.Timezone, &next, &createdAt, &updatedAt, &rec.LastError)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Id = id.String()
+	rec.Dtstart = timestamppb.New(dtstart)
+	rec.NextOccurrenceAt = optionalTimestamp(next)
+	rec.CreatedAt = timestamppb.New(createdAt)
+	rec.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &rec, nil
+}
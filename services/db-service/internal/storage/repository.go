@@ -0,0 +1,124 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskRepository is everything the db-service needs from a storage backend.
+// Storage implements it on Postgres, SQLiteStorage on a single SQLite file
+// and MemoryStorage in process memory.
+// Implementations report missing rows with ErrNotFound and the other errors
+// of this package as documented on the Postgres methods.
+type TaskRepository interface {
+	Ping(ctx context.Context) error
+	Close()
+
+	CreateTask(ctx context.Context, task *pb.Task) (*pb.Task, error)
+	ListTasks(ctx context.Context, taskFilter TaskFilter) ([]*pb.Task, error)
+	GetTask(ctx context.Context, id string) (*pb.Task, error)
+	MarkTaskDone(ctx context.Context, id string, requirePrerequisites bool) error
+	DeleteTask(ctx context.Context, id string) error
+	CountOpenTasks(ctx context.Context) (open int64, overdue int64, err error)
+
+	CreateChecklist(ctx context.Context, title string, description string) (*pb.Checklist, error)
+	ListChecklists(ctx context.Context) ([]*pb.Checklist, error)
+	GetChecklist(ctx context.Context, id string) (*pb.Checklist, error)
+	CreateChecklistWithTasks(ctx context.Context, checklist *pb.Checklist, tasks []*pb.Task) (*pb.Checklist, error)
+
+	CreateShareLink(ctx context.Context, checklistID string, tokenHash []byte, passwordHash string, expiresAt *time.Time) (*pb.ShareLink, error)
+	ListShareLinks(ctx context.Context, checklistID string) ([]*pb.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id string) (*pb.ShareLink, error)
+	GetShareLinkByTokenHash(ctx context.Context, tokenHash []byte) (*pb.ShareLink, string, error)
+
+	UpsertRecurrence(ctx context.Context, r *pb.Recurrence) (*pb.Recurrence, error)
+	ListRecurrences(ctx context.Context) ([]*pb.Recurrence, error)
+	DeleteRecurrence(ctx context.Context, id string) error
+	ProcessDueRecurrences(ctx context.Context, now time.Time, limit int, plan PlanFunc) (int, error)
+
+	CreateTemplateFromChecklist(ctx context.Context, checklistID string, name string, description string, checklistTitle string) (*pb.Template, error)
+	GetTemplate(ctx context.Context, id string) (*pb.Template, error)
+	ListTemplates(ctx context.Context) ([]*pb.Template, error)
+
+	AddDependency(ctx context.Context, taskID string, dependsOnID string) error
+	RemoveDependency(ctx context.Context, taskID string, dependsOnID string) error
+
+	GetWorkflow(ctx context.Context, checklistID string) (*pb.Workflow, error)
+	SetWorkflow(ctx context.Context, w *pb.Workflow) error
+	TransitionTask(ctx context.Context, id string, from string, to string, done bool, requirePrerequisites bool) error
+
+	AddChecklistMember(ctx context.Context, checklistID string, userID string) (*pb.ChecklistMember, error)
+	RemoveChecklistMember(ctx context.Context, checklistID string, userID string) error
+	ListChecklistMembers(ctx context.Context, checklistID string) ([]*pb.ChecklistMember, error)
+	AssignTask(ctx context.Context, taskID string, userID string) error
+	UnassignTask(ctx context.Context, taskID string, userID string) error
+
+	AddComment(ctx context.Context, taskID string, authorID string, body string) (*pb.Comment, error)
+	ListComments(ctx context.Context, taskID string, after *CommentCursor, limit int) ([]*pb.Comment, error)
+	EditComment(ctx context.Context, id string, taskID string, authorID string, body string) (*pb.Comment, error)
+	DeleteComment(ctx context.Context, id string, taskID string, authorID string) error
+
+	CreateAttachment(ctx context.Context, a *pb.Attachment) (*pb.Attachment, error)
+	ListAttachments(ctx context.Context, taskID string) ([]*pb.Attachment, error)
+	GetAttachment(ctx context.Context, id string) (*pb.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string) (*pb.Attachment, error)
+
+	SearchTasks(ctx context.Context, tsquery string, checklistID string, limit int) ([]*pb.SearchResult, error)
+
+	CreateSavedView(ctx context.Context, name string, filter string) (*pb.SavedView, error)
+	ListSavedViews(ctx context.Context) ([]*pb.SavedView, error)
+	GetSavedView(ctx context.Context, id string) (*pb.SavedView, error)
+	UpdateSavedView(ctx context.Context, id string, name string, filter string) (*pb.SavedView, error)
+	DeleteSavedView(ctx context.Context, id string) error
+
+	GetStats(ctx context.Context, q StatsQuery) (*pb.Stats, error)
+}
+
+var (
+	_ TaskRepository = (*Storage)(nil)
+	_ TaskRepository = (*SQLiteStorage)(nil)
+	_ TaskRepository = (*MemoryStorage)(nil)
+)
+
+// normalizeID brings a UUID into the canonical form the uuid column type
+// stores, for backends that keep IDs as text: IDs differing only in case or
+// format then find the same row.
+func normalizeID(id string) string {
+	if u, err := uuid.Parse(id); err == nil {
+		return u.String()
+	}
+	return id
+}
+
+// Backend names the storage backend a DSN selects: "memory" for memory://,
+// "sqlite" for sqlite:// and "postgres" for everything else.
+func Backend(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil {
+		switch u.Scheme {
+		case "memory", "sqlite":
+			return u.Scheme
+		}
+	}
+	return "postgres"
+}
+
+// NewStorage opens the backend selected by cfg.DSN. memory:// keeps all data
+// in process memory and loses it on exit; it is meant for local development
+// and tests. sqlite:///path/to/file.db stores everything in one file for
+// single-node deployments.
+func NewStorage(cfg Config) (TaskRepository, error) {
+	switch Backend(cfg.DSN) {
+	case "memory":
+		return NewMemoryStorage(), nil
+	case "sqlite":
+		return NewSQLiteStorage(cfg)
+	case "postgres":
+		return NewPostgresStorage(cfg)
+	}
+	return nil, fmt.Errorf("unsupported storage backend in DSN %q", cfg.DSN)
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/search"
+	"context"
+	"fmt"
+)
+
+const (
+	titleHeadlineOptions   = "StartSel=" + search.HighlightStart + ", StopSel=" + search.HighlightStop + ", HighlightAll=true"
+	snippetHeadlineOptions = "StartSel=" + search.HighlightStart + ", StopSel=" + search.HighlightStop +
+		", MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=\" … \""
+)
+
+// SearchTasks runs a tsquery (see search.ParseQuery) against task titles,
+// descriptions and comments, best matches first. Snippets carry the
+// search.HighlightStart and search.HighlightStop markers; the description
+// and comment snippets are empty when they do not match.
+func (s *Storage) SearchTasks(ctx context.Context, tsquery string, checklistID string, limit int) ([]*pb.SearchResult, error) {
+	query := `SELECT ` + taskColumns + `, m.rank,
+			ts_headline($4::regconfig, tasks.title, q.query, $5),
+			CASE WHEN to_tsvector($4::regconfig, COALESCE(tasks.description, '')) @@ q.query
+				THEN ts_headline($4::regconfig, tasks.description, q.query, $6) ELSE '' END,
+			COALESCE(bc.comment_id::text, ''),
+			COALESCE(ts_headline($4::regconfig, bc.comment_body, q.query, $6), '')
+		FROM tasks
+		CROSS JOIN to_tsquery($4::regconfig, $1) AS q(query)
+		LEFT JOIN LATERAL (
+			SELECT c.id AS comment_id, c.body AS comment_body, ts_rank_cd(c.search_vector, q.query) AS comment_rank
+			FROM comments c
+			WHERE c.task_id = tasks.id AND c.search_vector @@ q.query
+			ORDER BY comment_rank DESC, c.created_at
+			LIMIT 1) bc ON true
+		CROSS JOIN LATERAL (
+			SELECT ts_rank_cd(tasks.search_vector, q.query) + COALESCE(bc.comment_rank, 0) AS rank) m
+		WHERE (tasks.search_vector @@ q.query OR bc.comment_id IS NOT NULL)
+			AND ($2 = '' OR tasks.checklist_id = NULLIF($2, '')::uuid)
+		ORDER BY m.rank DESC, tasks.updated_at DESC
+		LIMIT $3`
+
+	rows, err := s.db.Query(ctx, query, tsquery, checklistID, limit, search.Config, titleHeadlineOptions, snippetHeadlineOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*pb.SearchResult
+	for rows.Next() {
+		var result pb.SearchResult
+		task, err := scanTask(rows, &result.Rank, &result.TitleHighlight, &result.DescriptionSnippet,
+			&result.CommentId, &result.CommentSnippet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		result.Task = task
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over search results: %w", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const shareLinkColumns = `id, checklist_id, password_hash IS NOT NULL, expires_at, revoked_at, created_at`
+
+// CreateShareLink stores a share link for the checklist. Only the hash of the
+// token is persisted; passwordHash may be empty and expiresAt may be nil.
+func (s *Storage) CreateShareLink(ctx context.Context, checklistID string, tokenHash []byte, passwordHash string, expiresAt *time.Time) (*pb.ShareLink, error) {
+	query := `INSERT INTO share_links (id, checklist_id, token_hash, password_hash, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+		RETURNING ` + shareLinkColumns
+
+	link, err := scanShareLink(s.db.QueryRow(ctx, query, uuid.New(), checklistID, tokenHash, passwordHash, expiresAt))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to create share link: %w", err)
+	}
+	return link, nil
+}
+
+func (s *Storage) ListShareLinks(ctx context.Context, checklistID string) ([]*pb.ShareLink, error) {
+	query := `SELECT ` + shareLinkColumns + ` FROM share_links WHERE checklist_id = $1 ORDER BY created_at desc`
+	rows, err := s.db.Query(ctx, query, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*pb.ShareLink
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over share links: %w", err)
+	}
+	return links, nil
+}
+
+// RevokeShareLink marks the link as revoked. Revoking an already revoked link
+// keeps the original revocation time.
+func (s *Storage) RevokeShareLink(ctx context.Context, id string) (*pb.ShareLink, error) {
+	query := `UPDATE share_links SET revoked_at = COALESCE(revoked_at, NOW()) WHERE id = $1 RETURNING ` + shareLinkColumns
+
+	link, err := scanShareLink(s.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	return link, nil
+}
+
+// GetShareLinkByTokenHash returns the link with the given token hash together
+// with its password hash (empty when the link is not password protected).
+func (s *Storage) GetShareLinkByTokenHash(ctx context.Context, tokenHash []byte) (*pb.ShareLink, string, error) {
+	query := `SELECT ` + shareLinkColumns + `, COALESCE(password_hash, '') FROM share_links WHERE token_hash = $1`
+
+	var passwordHash string
+	link, err := scanShareLink(s.db.QueryRow(ctx, query, tokenHash), &passwordHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", fmt.Errorf("failed to get share link: %w", err)
+	}
+	return link, passwordHash, nil
+}
+
+func scanShareLink(row pgx.Row, extra ...any) (*pb.ShareLink, error) {
+	var link pb.ShareLink
+	var id, checklistID uuid.UUID
+	var expiresAt, revokedAt *time.Time
+	var createdAt time.Time
+
+	dest := append([]any{&id, &checklistID, &link.PasswordProtected, &expiresAt, &revokedAt, &createdAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	link.Id = id.String()
+	link.ChecklistId = checklistID.String()
+	link.CreatedAt = timestamppb.New(createdAt)
+	link.ExpiresAt = optionalTimestamp(expiresAt)
+	link.RevokedAt = optionalTimestamp(revokedAt)
+
+	return &link, nil
+}
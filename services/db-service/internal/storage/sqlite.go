@@ -0,0 +1,511 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/search"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// SQLiteStorage is a TaskRepository on a SQLite database file, for single-node
+// deployments without Postgres. The schema (see migrations/sqlite) mirrors the
+// Postgres one; IDs are canonical UUID text, times are microseconds since the
+// Unix epoch and tags are JSON arrays. Text search runs in Go through the
+// search_* SQL functions registered below and stems words like MemoryStorage.
+type SQLiteStorage struct {
+	db *sql.DB
+}
+
+// sqlitePragmas are applied to every connection: foreign keys are off by
+// default in SQLite, WAL lets readers run next to the writer, and the busy
+// timeout makes writers queue up instead of failing.
+const sqlitePragmas = "_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(10000)&_txlock=immediate"
+
+// OpenSQLite opens the database of a sqlite:// DSN:
+// sqlite:///var/lib/checklist/checklist.db is an absolute path and
+// sqlite://checklist.db is relative to the working directory. Query
+// parameters are passed on to the driver.
+func OpenSQLite(dsn string) (*sql.DB, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || u.Scheme != "sqlite" {
+		return nil, fmt.Errorf("failed to parse DB_DSN: want sqlite://path")
+	}
+	path := u.Host + u.Path
+	if path == "" {
+		return nil, fmt.Errorf("failed to parse DB_DSN: the sqlite DSN has no file path")
+	}
+
+	driverDSN := "file:" + path + "?" + sqlitePragmas
+	if u.RawQuery != "" {
+		driverDSN += "&" + u.RawQuery
+	}
+	db, err := sql.Open("sqlite", driverDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+	return db, nil
+}
+
+// NewSQLiteStorage opens the database of cfg.DSN, see OpenSQLite. The schema
+// must have been migrated. Of the pool settings MinConns does not apply.
+func NewSQLiteStorage(cfg Config) (*SQLiteStorage, error) {
+	db, err := OpenSQLite(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(int(cfg.MaxConns))
+	}
+	db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+	db.SetConnMaxIdleTime(cfg.MaxConnIdleTime)
+
+	if err := db.PingContext(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+	return &SQLiteStorage{db: db}, nil
+}
+
+func (s *SQLiteStorage) Close() {
+	s.db.Close()
+}
+
+func (s *SQLiteStorage) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func init() {
+	// gen_random_uuid is the Postgres function of the same name.
+	sqlite.MustRegisterScalarFunction("gen_random_uuid", 0, func(_ *sqlite.FunctionContext, _ []driver.Value) (driver.Value, error) {
+		return uuid.NewString(), nil
+	})
+	// contains_fold(s, substr) is s ILIKE '%substr%' of likePattern.
+	sqlite.MustRegisterDeterministicScalarFunction("contains_fold", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return containsFold(sqlText(args[0]), sqlText(args[1])), nil
+	})
+	// search_plain_match(text, documents...) is the @@ plainto_tsquery(text)
+	// of the filter language.
+	sqlite.MustRegisterDeterministicScalarFunction("search_plain_match", -1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return search.PlainQuery(sqlText(args[0])).Match(sqlTexts(args[1:])...), nil
+	})
+	// search_match(tsquery, documents...) is documents @@ tsquery.
+	sqlite.MustRegisterDeterministicScalarFunction("search_match", -1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		q, err := search.CompileQuery(sqlText(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return q.Match(sqlTexts(args[1:])...), nil
+	})
+	// search_rank(tsquery, document, weight) ranks like search.Query.Rank.
+	sqlite.MustRegisterDeterministicScalarFunction("search_rank", 3, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		q, err := search.CompileQuery(sqlText(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight, _ := args[2].(float64)
+		return q.Rank(sqlText(args[1]), weight), nil
+	})
+	// search_highlight(tsquery, document) marks every match.
+	sqlite.MustRegisterDeterministicScalarFunction("search_highlight", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		q, err := search.CompileQuery(sqlText(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return q.Highlight(sqlText(args[1])), nil
+	})
+	// search_snippet(tsquery, document) is the fragment around the first match.
+	sqlite.MustRegisterDeterministicScalarFunction("search_snippet", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		q, err := search.CompileQuery(sqlText(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return q.Snippet(sqlText(args[1]), snippetMaxWords), nil
+	})
+}
+
+// sqlText returns a TEXT argument of an SQL function; NULL is empty.
+func sqlText(v driver.Value) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return ""
+}
+
+func sqlTexts(args []driver.Value) []string {
+	texts := make([]string, len(args))
+	for i, v := range args {
+		texts[i] = sqlText(v)
+	}
+	return texts
+}
+
+// isSQLiteForeignKeyViolation is isForeignKeyViolation for SQLite.
+func isSQLiteForeignKeyViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
+
+// sqliteNow is the NOW() of an operation: one instant, in microseconds.
+func sqliteNow() int64 {
+	return time.Now().UnixMicro()
+}
+
+// sqliteTime converts an optional time to its column value.
+func sqliteTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UnixMicro()
+}
+
+func sqliteTimestamp(t *timestamppb.Timestamp) any {
+	if t == nil {
+		return nil
+	}
+	return t.AsTime().UnixMicro()
+}
+
+func fromMicros(us int64) time.Time {
+	return time.UnixMicro(us).UTC()
+}
+
+func optionalFromMicros(us sql.NullInt64) *time.Time {
+	if !us.Valid {
+		return nil
+	}
+	t := fromMicros(us.Int64)
+	return &t
+}
+
+// sqliteTags encodes tags as the JSON array of the tags columns.
+func sqliteTags(tags []string) string {
+	if tags == nil {
+		tags = []string{}
+	}
+	b, _ := json.Marshal(tags)
+	return string(b)
+}
+
+// sqliteStrings decodes a JSON array of strings.
+func sqliteStrings(s string) ([]string, error) {
+	list := []string{}
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return nil, fmt.Errorf("invalid JSON array %q: %w", s, err)
+	}
+	return list, nil
+}
+
+// sqliteRow is satisfied by *sql.Row and *sql.Rows.
+type sqliteRow interface {
+	Scan(dest ...any) error
+}
+
+// sqliteExecer is satisfied by the database and a transaction.
+type sqliteExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// withTx runs fn in a transaction, which takes the database write lock.
+func (s *SQLiteStorage) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// rowsAffected reports whether res changed any row.
+func rowsAffected(res sql.Result) (bool, error) {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Tasks
+
+// sqliteTaskColumns is taskColumns for SQLite, in the order scanSQLiteTask
+// expects. Rows created in the same instant keep their insertion order
+// through rowid.
+const sqliteTaskColumns = `tasks.id, tasks.title, COALESCE(tasks.description, ''), tasks.done, tasks.created_at, tasks.updated_at,
+	COALESCE(tasks.checklist_id, ''), tasks.due_at, tasks.completed_at, COALESCE(tasks.recurrence_id, ''), tasks.tags,
+	` + blockedCondition + `,
+	(SELECT json_group_array(d.depends_on_id ORDER BY d.created_at, d.rowid) FROM task_dependencies d WHERE d.task_id = tasks.id),
+	tasks.status,
+	(SELECT json_group_array(a.user_id ORDER BY a.created_at, a.rowid) FROM task_assignees a WHERE a.task_id = tasks.id)`
+
+func scanSQLiteTask(row sqliteRow, extra ...any) (*pb.Task, error) {
+	var task pb.Task
+	var createdAt, updatedAt int64
+	var dueAt, completedAt sql.NullInt64
+	var tags, dependsOn, assignees string
+
+	dest := append([]any{&task.Id, &task.Title, &task.Description, &task.Done, &createdAt, &updatedAt,
+		&task.ChecklistId, &dueAt, &completedAt, &task.RecurrenceId, &tags, &task.Blocked, &dependsOn, &task.Status,
+		&assignees}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if task.Tags, err = sqliteStrings(tags); err != nil {
+		return nil, err
+	}
+	if task.DependsOnIds, err = sqliteStrings(dependsOn); err != nil {
+		return nil, err
+	}
+	if task.AssigneeIds, err = sqliteStrings(assignees); err != nil {
+		return nil, err
+	}
+	task.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	task.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	task.DueAt = optionalTimestamp(optionalFromMicros(dueAt))
+	task.CompletedAt = optionalTimestamp(optionalFromMicros(completedAt))
+
+	return &task, nil
+}
+
+func (s *SQLiteStorage) CreateTask(ctx context.Context, task *pb.Task) (*pb.Task, error) {
+	id := uuid.NewString()
+	now := sqliteNow()
+
+	query := `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, ` + initialStatus(`NULLIF($4, '')`) + `, $7, $7)`
+	_, err := s.db.ExecContext(ctx, query, id, task.Title, task.Description, normalizeID(task.ChecklistId),
+		sqliteTimestamp(task.DueAt), sqliteTags(task.Tags), now)
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return s.GetTask(ctx, id)
+}
+
+func (s *SQLiteStorage) ListTasks(ctx context.Context, taskFilter TaskFilter) ([]*pb.Task, error) {
+	where := sqliteFilterSQL{args: []any{normalizeID(taskFilter.ChecklistID), taskFilter.AssigneeID, taskFilter.OpenOnly}, now: time.Now()}
+	expression := "true"
+	if taskFilter.Expression != nil {
+		var err error
+		if expression, err = where.build(taskFilter.Expression); err != nil {
+			return nil, fmt.Errorf("failed to compile filter: %w", err)
+		}
+	}
+
+	query := `SELECT ` + sqliteTaskColumns + ` FROM tasks
+		WHERE ($1 = '' OR checklist_id = $1)
+			AND ($2 = '' OR EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = $2))
+			AND NOT ($3 AND done)
+			AND ` + expression + `
+		ORDER BY created_at DESC, rowid DESC`
+	return s.queryTasks(ctx, s.db, query, where.args...)
+}
+
+func (s *SQLiteStorage) queryTasks(ctx context.Context, q sqliteExecer, query string, args ...any) ([]*pb.Task, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*pb.Task
+	for rows.Next() {
+		task, err := scanSQLiteTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over tasks: %w", err)
+	}
+	return tasks, nil
+}
+
+func (s *SQLiteStorage) GetTask(ctx context.Context, id string) (*pb.Task, error) {
+	query := `SELECT ` + sqliteTaskColumns + ` FROM tasks WHERE id = $1`
+
+	task, err := scanSQLiteTask(s.db.QueryRowContext(ctx, query, normalizeID(id)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
+}
+
+func (s *SQLiteStorage) MarkTaskDone(ctx context.Context, id string, requirePrerequisites bool) error {
+	id = normalizeID(id)
+	query := `UPDATE tasks SET done = 1, status = ` + doneStatus(`tasks.checklist_id`) + `,
+		completed_at = COALESCE(completed_at, $3), updated_at = $3
+		WHERE id = $1 AND NOT ($2 AND ` + blockedCondition + `)`
+	res, err := s.db.ExecContext(ctx, query, id, requirePrerequisites, sqliteNow())
+	if err != nil {
+		return fmt.Errorf("failed to mark task done: %w", err)
+	}
+	if ok, err := rowsAffected(res); err != nil {
+		return fmt.Errorf("failed to mark task done: %w", err)
+	} else if ok {
+		return nil
+	}
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to mark task done: %w", err)
+	}
+	if exists {
+		return ErrTaskBlocked
+	}
+	return ErrNotFound
+}
+
+func (s *SQLiteStorage) DeleteTask(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM tasks WHERE id = $1`, normalizeID(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	return notFoundUnless(res, "failed to delete task")
+}
+
+// notFoundUnless returns ErrNotFound when res changed no row.
+func notFoundUnless(res sql.Result, failure string) error {
+	ok, err := rowsAffected(res)
+	if err != nil {
+		return fmt.Errorf("%s: %w", failure, err)
+	}
+	if !ok {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLiteStorage) CountOpenTasks(ctx context.Context) (open int64, overdue int64, err error) {
+	query := `SELECT COUNT(*), COUNT(*) FILTER (WHERE due_at < $1) FROM tasks WHERE NOT done`
+	if err := s.db.QueryRowContext(ctx, query, sqliteNow()).Scan(&open, &overdue); err != nil {
+		return 0, 0, fmt.Errorf("failed to count open tasks: %w", err)
+	}
+	return open, overdue, nil
+}
+
+// Checklists
+
+const sqliteChecklistColumns = `id, title, COALESCE(description, ''), created_at, updated_at,
+	COALESCE(recurrence_id, ''), occurrence_at`
+
+func scanSQLiteChecklist(row sqliteRow) (*pb.Checklist, error) {
+	var checklist pb.Checklist
+	var createdAt, updatedAt int64
+	var occurrenceAt sql.NullInt64
+
+	if err := row.Scan(&checklist.Id, &checklist.Title, &checklist.Description, &createdAt, &updatedAt,
+		&checklist.RecurrenceId, &occurrenceAt); err != nil {
+		return nil, err
+	}
+
+	checklist.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	checklist.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	checklist.OccurrenceAt = optionalTimestamp(optionalFromMicros(occurrenceAt))
+
+	return &checklist, nil
+}
+
+func (s *SQLiteStorage) CreateChecklist(ctx context.Context, title string, description string) (*pb.Checklist, error) {
+	query := `INSERT INTO checklists (id, title, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+		RETURNING ` + sqliteChecklistColumns
+
+	checklist, err := scanSQLiteChecklist(s.db.QueryRowContext(ctx, query, uuid.NewString(), title, description, sqliteNow()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checklist: %w", err)
+	}
+	return checklist, nil
+}
+
+func (s *SQLiteStorage) ListChecklists(ctx context.Context) ([]*pb.Checklist, error) {
+	query := `SELECT ` + sqliteChecklistColumns + ` FROM checklists ORDER BY created_at DESC, rowid DESC`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklists: %w", err)
+	}
+	defer rows.Close()
+
+	var checklists []*pb.Checklist
+	for rows.Next() {
+		checklist, err := scanSQLiteChecklist(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist: %w", err)
+		}
+		checklists = append(checklists, checklist)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over checklists: %w", err)
+	}
+	return checklists, nil
+}
+
+func (s *SQLiteStorage) GetChecklist(ctx context.Context, id string) (*pb.Checklist, error) {
+	query := `SELECT ` + sqliteChecklistColumns + ` FROM checklists WHERE id = $1`
+
+	checklist, err := scanSQLiteChecklist(s.db.QueryRowContext(ctx, query, normalizeID(id)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	return checklist, nil
+}
+
+func (s *SQLiteStorage) CreateChecklistWithTasks(ctx context.Context, checklist *pb.Checklist, tasks []*pb.Task) (*pb.Checklist, error) {
+	var created *pb.Checklist
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		now := sqliteNow()
+		query := `INSERT INTO checklists (id, title, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+			RETURNING ` + sqliteChecklistColumns
+		var err error
+		created, err = scanSQLiteChecklist(tx.QueryRowContext(ctx, query, uuid.NewString(), checklist.Title, checklist.Description, now))
+		if err != nil {
+			return fmt.Errorf("failed to create checklist: %w", err)
+		}
+
+		for _, task := range tasks {
+			_, err := tx.ExecContext(ctx, `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $7)`,
+				uuid.NewString(), task.Title, task.Description, created.Id, sqliteTimestamp(task.DueAt), sqliteTags(task.Tags), now)
+			if err != nil {
+				return fmt.Errorf("failed to create checklist tasks: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
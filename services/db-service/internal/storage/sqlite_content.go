@@ -0,0 +1,884 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/search"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Share links
+
+const sqliteShareLinkColumns = `id, checklist_id, password_hash IS NOT NULL, expires_at, revoked_at, created_at`
+
+func scanSQLiteShareLink(row sqliteRow, extra ...any) (*pb.ShareLink, error) {
+	var link pb.ShareLink
+	var expiresAt, revokedAt sql.NullInt64
+	var createdAt int64
+
+	dest := append([]any{&link.Id, &link.ChecklistId, &link.PasswordProtected, &expiresAt, &revokedAt, &createdAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	link.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	link.ExpiresAt = optionalTimestamp(optionalFromMicros(expiresAt))
+	link.RevokedAt = optionalTimestamp(optionalFromMicros(revokedAt))
+	return &link, nil
+}
+
+func (s *SQLiteStorage) CreateShareLink(ctx context.Context, checklistID string, tokenHash []byte, passwordHash string, expiresAt *time.Time) (*pb.ShareLink, error) {
+	query := `INSERT INTO share_links (id, checklist_id, token_hash, password_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		RETURNING ` + sqliteShareLinkColumns
+
+	link, err := scanSQLiteShareLink(s.db.QueryRowContext(ctx, query, uuid.NewString(), normalizeID(checklistID), tokenHash,
+		passwordHash, sqliteTime(expiresAt), sqliteNow()))
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to create share link: %w", err)
+	}
+	return link, nil
+}
+
+func (s *SQLiteStorage) ListShareLinks(ctx context.Context, checklistID string) ([]*pb.ShareLink, error) {
+	query := `SELECT ` + sqliteShareLinkColumns + ` FROM share_links WHERE checklist_id = $1 ORDER BY created_at DESC, rowid DESC`
+	rows, err := s.db.QueryContext(ctx, query, normalizeID(checklistID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*pb.ShareLink
+	for rows.Next() {
+		link, err := scanSQLiteShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over share links: %w", err)
+	}
+	return links, nil
+}
+
+func (s *SQLiteStorage) RevokeShareLink(ctx context.Context, id string) (*pb.ShareLink, error) {
+	query := `UPDATE share_links SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1 RETURNING ` + sqliteShareLinkColumns
+
+	link, err := scanSQLiteShareLink(s.db.QueryRowContext(ctx, query, normalizeID(id), sqliteNow()))
+	if err != nil {
+		return nil, errNoRows(err, "failed to revoke share link")
+	}
+	return link, nil
+}
+
+func (s *SQLiteStorage) GetShareLinkByTokenHash(ctx context.Context, tokenHash []byte) (*pb.ShareLink, string, error) {
+	query := `SELECT ` + sqliteShareLinkColumns + `, COALESCE(password_hash, '') FROM share_links WHERE token_hash = $1`
+
+	var passwordHash string
+	link, err := scanSQLiteShareLink(s.db.QueryRowContext(ctx, query, tokenHash), &passwordHash)
+	if err != nil {
+		return nil, "", errNoRows(err, "failed to get share link")
+	}
+	return link, passwordHash, nil
+}
+
+// Recurrences
+
+const sqliteRecurrenceColumns = `id, COALESCE(task_id, ''), COALESCE(checklist_id, ''), rrule, dtstart, timezone,
+	next_occurrence_at, created_at, updated_at, COALESCE(last_error, '')`
+
+func scanSQLiteRecurrence(row sqliteRow) (*pb.Recurrence, error) {
+	var rec pb.Recurrence
+	var dtstart, createdAt, updatedAt int64
+	var next sql.NullInt64
+
+	err := row.Scan(&rec.Id, &rec.TaskId, &rec.ChecklistId, &rec.Rrule, &dtstart, &rec.Timezone, &next, &createdAt, &updatedAt, &rec.LastError)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Dtstart = timestamppb.New(fromMicros(dtstart))
+	rec.NextOccurrenceAt = optionalTimestamp(optionalFromMicros(next))
+	rec.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	rec.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	return &rec, nil
+}
+
+func (s *SQLiteStorage) UpsertRecurrence(ctx context.Context, r *pb.Recurrence) (*pb.Recurrence, error) {
+	target := "task_id"
+	if r.ChecklistId != "" {
+		target = "checklist_id"
+	}
+
+	query := `INSERT INTO recurrences (id, task_id, checklist_id, rrule, dtstart, timezone, next_occurrence_at, created_at, updated_at)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, $6, $7, $8, $8)
+		ON CONFLICT (` + target + `) DO UPDATE SET
+			rrule = excluded.rrule,
+			dtstart = excluded.dtstart,
+			timezone = excluded.timezone,
+			next_occurrence_at = excluded.next_occurrence_at,
+			last_error = NULL,
+			updated_at = excluded.updated_at
+		RETURNING ` + sqliteRecurrenceColumns
+
+	rec, err := scanSQLiteRecurrence(s.db.QueryRowContext(ctx, query, uuid.NewString(), normalizeID(r.TaskId), normalizeID(r.ChecklistId),
+		r.Rrule, r.Dtstart.AsTime().UnixMicro(), r.Timezone, sqliteTimestamp(r.NextOccurrenceAt), sqliteNow()))
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to save recurrence: %w", err)
+	}
+	return rec, nil
+}
+
+func (s *SQLiteStorage) ListRecurrences(ctx context.Context) ([]*pb.Recurrence, error) {
+	query := `SELECT ` + sqliteRecurrenceColumns + ` FROM recurrences ORDER BY created_at DESC, rowid DESC`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var recurrences []*pb.Recurrence
+	for rows.Next() {
+		rec, err := scanSQLiteRecurrence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recurrence: %w", err)
+		}
+		recurrences = append(recurrences, rec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over recurrences: %w", err)
+	}
+	return recurrences, nil
+}
+
+func (s *SQLiteStorage) DeleteRecurrence(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM recurrences WHERE id = $1`, normalizeID(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete recurrence: %w", err)
+	}
+	return notFoundUnless(res, "failed to delete recurrence")
+}
+
+// ProcessDueRecurrences works like Storage.ProcessDueRecurrences. SQLite has
+// a single writer, so the transaction alone keeps concurrent callers from
+// materializing the same occurrence.
+func (s *SQLiteStorage) ProcessDueRecurrences(ctx context.Context, now time.Time, limit int, plan PlanFunc) (int, error) {
+	var materialized int
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		query := `SELECT r.id, COALESCE(r.task_id, ''), COALESCE(r.checklist_id, ''), r.rrule, r.timezone,
+				r.dtstart, r.next_occurrence_at
+			FROM recurrences r
+			WHERE r.next_occurrence_at IS NOT NULL
+				AND (r.next_occurrence_at <= $1
+					OR (r.task_id IS NOT NULL AND (
+						SELECT t.done FROM tasks t
+						WHERE t.recurrence_id = r.id OR t.id = r.task_id
+						ORDER BY t.occurrence_at DESC NULLS LAST
+						LIMIT 1))
+					OR (r.checklist_id IS NOT NULL AND (
+						SELECT EXISTS (SELECT 1 FROM tasks t WHERE t.checklist_id = c.id)
+							AND NOT EXISTS (SELECT 1 FROM tasks t WHERE t.checklist_id = c.id AND NOT t.done)
+						FROM checklists c
+						WHERE c.recurrence_id = r.id OR c.id = r.checklist_id
+						ORDER BY c.occurrence_at DESC NULLS LAST
+						LIMIT 1)))
+			ORDER BY r.next_occurrence_at
+			LIMIT $2`
+
+		rows, err := tx.QueryContext(ctx, query, now.UnixMicro(), limit)
+		if err != nil {
+			return fmt.Errorf("failed to select due recurrences: %w", err)
+		}
+		var due []DueRecurrence
+		for rows.Next() {
+			var r DueRecurrence
+			var dtstart, next int64
+			if err := rows.Scan(&r.ID, &r.TaskID, &r.ChecklistID, &r.RRule, &r.Timezone, &dtstart, &next); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan due recurrences: %w", err)
+			}
+			r.DTStart, r.NextOccurrenceAt = fromMicros(dtstart), fromMicros(next)
+			due = append(due, r)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to scan due recurrences: %w", err)
+		}
+
+		for _, r := range due {
+			occurrence, next, err := plan(r)
+			if err != nil {
+				_, err = tx.ExecContext(ctx, `UPDATE recurrences SET next_occurrence_at = NULL, last_error = $2, updated_at = $3 WHERE id = $1`,
+					r.ID, err.Error(), sqliteNow())
+				if err != nil {
+					return fmt.Errorf("failed to disable recurrence %s: %w", r.ID, err)
+				}
+				continue
+			}
+			if err := sqliteMaterializeOccurrence(ctx, tx, r, occurrence); err != nil {
+				return fmt.Errorf("recurrence %s: %w", r.ID, err)
+			}
+			_, err = tx.ExecContext(ctx, `UPDATE recurrences SET next_occurrence_at = $2, updated_at = $3 WHERE id = $1`,
+				r.ID, sqliteTime(next), sqliteNow())
+			if err != nil {
+				return fmt.Errorf("failed to advance recurrence %s: %w", r.ID, err)
+			}
+			materialized++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return materialized, nil
+}
+
+// sqliteMaterializeOccurrence is materializeOccurrence for SQLite.
+func sqliteMaterializeOccurrence(ctx context.Context, tx *sql.Tx, r DueRecurrence, occurrence time.Time) error {
+	now := sqliteNow()
+	if r.TaskID != "" {
+		_, err := tx.ExecContext(ctx, `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status, recurrence_id, occurrence_at, created_at, updated_at)
+			SELECT $1, title, description, checklist_id, $3, tags, `+initialStatus(`tasks.checklist_id`)+`, $2, $3, $5, $5 FROM tasks WHERE id = $4
+			ON CONFLICT (recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL DO NOTHING`,
+			uuid.NewString(), r.ID, occurrence.UnixMicro(), r.TaskID, now)
+		if err != nil {
+			return fmt.Errorf("failed to create task occurrence: %w", err)
+		}
+		return nil
+	}
+
+	checklistID := uuid.NewString()
+	res, err := tx.ExecContext(ctx, `INSERT INTO checklists (id, title, description, recurrence_id, occurrence_at, created_at, updated_at)
+		SELECT $1, title, description, $2, $3, $5, $5 FROM checklists WHERE id = $4
+		ON CONFLICT (recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL DO NOTHING`,
+		checklistID, r.ID, occurrence.UnixMicro(), r.ChecklistID, now)
+	if err != nil {
+		return fmt.Errorf("failed to create checklist occurrence: %w", err)
+	}
+	if ok, err := rowsAffected(res); err != nil || !ok {
+		return err
+	}
+
+	if err := sqliteCopyWorkflow(ctx, tx, r.ChecklistID, checklistID); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO tasks (id, title, description, checklist_id, due_at, tags, status, created_at, updated_at)
+		SELECT gen_random_uuid(), title, description, $1, $2, tags, `+initialStatus(`$1`)+`, $4, $4 FROM tasks WHERE checklist_id = $3
+		ORDER BY created_at, rowid`,
+		checklistID, occurrence.UnixMicro(), r.ChecklistID, now)
+	if err != nil {
+		return fmt.Errorf("failed to copy checklist tasks: %w", err)
+	}
+	return nil
+}
+
+// Templates
+
+const sqliteTemplateColumns = `id, name, description, checklist_title, created_at, updated_at`
+
+func scanSQLiteTemplate(row sqliteRow) (*pb.Template, error) {
+	var template pb.Template
+	var createdAt, updatedAt int64
+
+	err := row.Scan(&template.Id, &template.Name, &template.Description, &template.ChecklistTitle, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	template.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	template.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	return &template, nil
+}
+
+func (s *SQLiteStorage) CreateTemplateFromChecklist(ctx context.Context, checklistID string, name string, description string, checklistTitle string) (*pb.Template, error) {
+	checklistID = normalizeID(checklistID)
+	var template *pb.Template
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var sourceTitle string
+		err := tx.QueryRowContext(ctx, `SELECT title FROM checklists WHERE id = $1`, checklistID).Scan(&sourceTitle)
+		if err != nil {
+			return errNoRows(err, "failed to get checklist")
+		}
+		if checklistTitle == "" {
+			checklistTitle = sourceTitle
+		}
+
+		id := uuid.NewString()
+		query := `INSERT INTO templates (id, name, description, checklist_title, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5) RETURNING ` + sqliteTemplateColumns
+		template, err = scanSQLiteTemplate(tx.QueryRowContext(ctx, query, id, name, description, checklistTitle, sqliteNow()))
+		if err != nil {
+			return fmt.Errorf("failed to create template: %w", err)
+		}
+
+		// round() rounds half away from zero like the ::BIGINT cast in
+		// Postgres.
+		_, err = tx.ExecContext(ctx, `INSERT INTO template_tasks (template_id, position, title, description, due_offset_seconds, tags)
+			SELECT $1, ROW_NUMBER() OVER (ORDER BY t.created_at, t.id) - 1, t.title, COALESCE(t.description, ''),
+				CAST(round((t.due_at - c.created_at) / 1000000.0) AS INTEGER), t.tags
+			FROM tasks t
+			JOIN checklists c ON c.id = t.checklist_id
+			WHERE t.checklist_id = $2`, id, checklistID)
+		if err != nil {
+			return fmt.Errorf("failed to copy checklist tasks: %w", err)
+		}
+
+		template.Tasks, err = sqliteListTemplateTasks(ctx, tx, template.Id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *SQLiteStorage) GetTemplate(ctx context.Context, id string) (*pb.Template, error) {
+	id = normalizeID(id)
+	query := `SELECT ` + sqliteTemplateColumns + ` FROM templates WHERE id = $1`
+
+	template, err := scanSQLiteTemplate(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		return nil, errNoRows(err, "failed to get template")
+	}
+
+	template.Tasks, err = sqliteListTemplateTasks(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *SQLiteStorage) ListTemplates(ctx context.Context) ([]*pb.Template, error) {
+	query := `SELECT ` + sqliteTemplateColumns + ` FROM templates ORDER BY created_at DESC, rowid DESC`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*pb.Template{}
+	for rows.Next() {
+		template, err := scanSQLiteTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan template: %w", err)
+	}
+	rows.Close()
+
+	for _, template := range templates {
+		template.Tasks, err = sqliteListTemplateTasks(ctx, s.db, template.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+func sqliteListTemplateTasks(ctx context.Context, q sqliteExecer, templateID string) ([]*pb.TaskBlueprint, error) {
+	rows, err := q.QueryContext(ctx, `SELECT title, description, due_offset_seconds, tags FROM template_tasks
+		WHERE template_id = $1 ORDER BY position`, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := []*pb.TaskBlueprint{}
+	for rows.Next() {
+		var task pb.TaskBlueprint
+		var offset sql.NullInt64
+		var tags string
+		if err := rows.Scan(&task.Title, &task.Description, &offset, &tags); err != nil {
+			return nil, fmt.Errorf("failed to scan template task: %w", err)
+		}
+		if task.Tags, err = sqliteStrings(tags); err != nil {
+			return nil, fmt.Errorf("failed to scan template task: %w", err)
+		}
+		if offset.Valid {
+			task.DueOffset = durationpb.New(time.Duration(offset.Int64) * time.Second)
+		}
+		tasks = append(tasks, &task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan template task: %w", err)
+	}
+	return tasks, nil
+}
+
+// Comments
+
+const sqliteCommentColumns = `id, task_id, author_id, body, created_at, updated_at, edited_at`
+
+func scanSQLiteComment(row sqliteRow) (*pb.Comment, error) {
+	var comment pb.Comment
+	var createdAt, updatedAt int64
+	var editedAt sql.NullInt64
+
+	if err := row.Scan(&comment.Id, &comment.TaskId, &comment.AuthorId, &comment.Body, &createdAt, &updatedAt, &editedAt); err != nil {
+		return nil, err
+	}
+
+	comment.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	comment.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	comment.EditedAt = optionalTimestamp(optionalFromMicros(editedAt))
+	return &comment, nil
+}
+
+func (s *SQLiteStorage) AddComment(ctx context.Context, taskID string, authorID string, body string) (*pb.Comment, error) {
+	query := `INSERT INTO comments (id, task_id, author_id, body, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING ` + sqliteCommentColumns
+
+	comment, err := scanSQLiteComment(s.db.QueryRowContext(ctx, query, uuid.NewString(), normalizeID(taskID), authorID, body, sqliteNow()))
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (s *SQLiteStorage) ListComments(ctx context.Context, taskID string, after *CommentCursor, limit int) ([]*pb.Comment, error) {
+	query := `SELECT ` + sqliteCommentColumns + ` FROM comments
+		WHERE task_id = $1 AND ($2 IS NULL OR (created_at, id) > ($2, $3))
+		ORDER BY created_at, id
+		LIMIT $4`
+
+	var afterCreatedAt, afterID any
+	if after != nil {
+		afterCreatedAt, afterID = after.CreatedAt.UnixMicro(), normalizeID(after.ID)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, normalizeID(taskID), afterCreatedAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*pb.Comment
+	for rows.Next() {
+		comment, err := scanSQLiteComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over comments: %w", err)
+	}
+	return comments, nil
+}
+
+func (s *SQLiteStorage) EditComment(ctx context.Context, id string, taskID string, authorID string, body string) (*pb.Comment, error) {
+	id, taskID = normalizeID(id), normalizeID(taskID)
+	query := `UPDATE comments SET body = $4, edited_at = $5, updated_at = $5
+		WHERE id = $1 AND task_id = $2 AND author_id = $3
+		RETURNING ` + sqliteCommentColumns
+
+	comment, err := scanSQLiteComment(s.db.QueryRowContext(ctx, query, id, taskID, authorID, body, sqliteNow()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, s.commentAccessError(ctx, id, taskID)
+		}
+		return nil, fmt.Errorf("failed to edit comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (s *SQLiteStorage) DeleteComment(ctx context.Context, id string, taskID string, authorID string) error {
+	id, taskID = normalizeID(id), normalizeID(taskID)
+	res, err := s.db.ExecContext(ctx, `DELETE FROM comments WHERE id = $1 AND task_id = $2 AND author_id = $3`, id, taskID, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if ok, err := rowsAffected(res); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	} else if !ok {
+		return s.commentAccessError(ctx, id, taskID)
+	}
+	return nil
+}
+
+func (s *SQLiteStorage) commentAccessError(ctx context.Context, id string, taskID string) error {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM comments WHERE id = $1 AND task_id = $2)`, id, taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check comment: %w", err)
+	}
+	if exists {
+		return ErrNotAuthor
+	}
+	return ErrNotFound
+}
+
+// Attachments
+
+const sqliteAttachmentColumns = `id, task_id, filename, content_type, size_bytes, sha256, storage_key,
+	COALESCE(uploaded_by, ''), created_at`
+
+func scanSQLiteAttachment(row sqliteRow) (*pb.Attachment, error) {
+	var a pb.Attachment
+	var createdAt int64
+
+	err := row.Scan(&a.Id, &a.TaskId, &a.Filename, &a.ContentType, &a.SizeBytes, &a.Sha256, &a.StorageKey,
+		&a.UploadedBy, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	a.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	return &a, nil
+}
+
+func (s *SQLiteStorage) CreateAttachment(ctx context.Context, a *pb.Attachment) (*pb.Attachment, error) {
+	query := `INSERT INTO attachments (id, task_id, filename, content_type, size_bytes, sha256, storage_key, uploaded_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9)
+		RETURNING ` + sqliteAttachmentColumns
+
+	created, err := scanSQLiteAttachment(s.db.QueryRowContext(ctx, query, uuid.NewString(), normalizeID(a.TaskId), a.Filename,
+		a.ContentType, a.SizeBytes, a.Sha256, a.StorageKey, a.UploadedBy, sqliteNow()))
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return created, nil
+}
+
+func (s *SQLiteStorage) ListAttachments(ctx context.Context, taskID string) ([]*pb.Attachment, error) {
+	query := `SELECT ` + sqliteAttachmentColumns + ` FROM attachments WHERE task_id = $1 ORDER BY created_at, rowid`
+	rows, err := s.db.QueryContext(ctx, query, normalizeID(taskID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*pb.Attachment
+	for rows.Next() {
+		a, err := scanSQLiteAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+func (s *SQLiteStorage) GetAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	query := `SELECT ` + sqliteAttachmentColumns + ` FROM attachments WHERE id = $1`
+
+	a, err := scanSQLiteAttachment(s.db.QueryRowContext(ctx, query, normalizeID(id)))
+	if err != nil {
+		return nil, errNoRows(err, "failed to get attachment")
+	}
+	return a, nil
+}
+
+func (s *SQLiteStorage) DeleteAttachment(ctx context.Context, id string) (*pb.Attachment, error) {
+	query := `DELETE FROM attachments WHERE id = $1 RETURNING ` + sqliteAttachmentColumns
+
+	a, err := scanSQLiteAttachment(s.db.QueryRowContext(ctx, query, normalizeID(id)))
+	if err != nil {
+		return nil, errNoRows(err, "failed to delete attachment")
+	}
+	return a, nil
+}
+
+// Saved views
+
+const sqliteSavedViewColumns = `id, name, filter, created_at, updated_at`
+
+func scanSQLiteSavedView(row sqliteRow) (*pb.SavedView, error) {
+	var view pb.SavedView
+	var createdAt, updatedAt int64
+
+	if err := row.Scan(&view.Id, &view.Name, &view.Filter, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	view.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	view.UpdatedAt = timestamppb.New(fromMicros(updatedAt))
+	return &view, nil
+}
+
+func (s *SQLiteStorage) CreateSavedView(ctx context.Context, name string, filter string) (*pb.SavedView, error) {
+	query := `INSERT INTO saved_views (id, name, filter, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+		RETURNING ` + sqliteSavedViewColumns
+
+	view, err := scanSQLiteSavedView(s.db.QueryRowContext(ctx, query, uuid.NewString(), name, filter, sqliteNow()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved view: %w", err)
+	}
+	return view, nil
+}
+
+func (s *SQLiteStorage) ListSavedViews(ctx context.Context) ([]*pb.SavedView, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+sqliteSavedViewColumns+` FROM saved_views ORDER BY name, created_at, rowid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []*pb.SavedView
+	for rows.Next() {
+		view, err := scanSQLiteSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved view: %w", err)
+		}
+		views = append(views, view)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over saved views: %w", err)
+	}
+	return views, nil
+}
+
+func (s *SQLiteStorage) GetSavedView(ctx context.Context, id string) (*pb.SavedView, error) {
+	view, err := scanSQLiteSavedView(s.db.QueryRowContext(ctx, `SELECT `+sqliteSavedViewColumns+` FROM saved_views WHERE id = $1`, normalizeID(id)))
+	if err != nil {
+		return nil, errNoRows(err, "failed to get saved view")
+	}
+	return view, nil
+}
+
+func (s *SQLiteStorage) UpdateSavedView(ctx context.Context, id string, name string, filter string) (*pb.SavedView, error) {
+	query := `UPDATE saved_views SET name = $2, filter = $3, updated_at = $4 WHERE id = $1 RETURNING ` + sqliteSavedViewColumns
+
+	view, err := scanSQLiteSavedView(s.db.QueryRowContext(ctx, query, normalizeID(id), name, filter, sqliteNow()))
+	if err != nil {
+		return nil, errNoRows(err, "failed to update saved view")
+	}
+	return view, nil
+}
+
+func (s *SQLiteStorage) DeleteSavedView(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1`, normalizeID(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+	return notFoundUnless(res, "failed to delete saved view")
+}
+
+// Search
+
+// SearchTasks works like Storage.SearchTasks with the ranking of
+// MemoryStorage: search_rank counts matches, weighted by field.
+func (s *SQLiteStorage) SearchTasks(ctx context.Context, tsquery string, checklistID string, limit int) ([]*pb.SearchResult, error) {
+	if _, err := search.CompileQuery(tsquery); err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+
+	query := `WITH candidates AS (
+			SELECT tasks.id AS task_id,
+				(SELECT c.id FROM comments c
+					WHERE c.task_id = tasks.id AND search_match($1, c.body)
+					ORDER BY search_rank($1, c.body, $6) DESC, c.created_at, c.rowid
+					LIMIT 1) AS comment_id
+			FROM tasks
+			WHERE $2 = '' OR tasks.checklist_id = $2
+		)
+		SELECT ` + sqliteTaskColumns + `,
+			search_rank($1, tasks.title, $4) + search_rank($1, COALESCE(tasks.description, ''), $5)
+				+ COALESCE(search_rank($1, bc.body, $6), 0) AS rank,
+			search_highlight($1, tasks.title),
+			CASE WHEN search_match($1, COALESCE(tasks.description, ''))
+				THEN search_snippet($1, tasks.description) ELSE '' END,
+			COALESCE(bc.id, ''),
+			COALESCE(search_snippet($1, bc.body), '')
+		FROM candidates
+		JOIN tasks ON tasks.id = candidates.task_id
+		LEFT JOIN comments bc ON bc.id = candidates.comment_id
+		WHERE search_match($1, tasks.title, COALESCE(tasks.description, '')) OR bc.id IS NOT NULL
+		ORDER BY rank DESC, tasks.updated_at DESC, tasks.rowid DESC
+		LIMIT $3`
+
+	rows, err := s.db.QueryContext(ctx, query, tsquery, normalizeID(checklistID), limit,
+		titleWeight, descriptionWeight, commentWeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*pb.SearchResult
+	for rows.Next() {
+		var result pb.SearchResult
+		task, err := scanSQLiteTask(rows, &result.Rank, &result.TitleHighlight, &result.DescriptionSnippet,
+			&result.CommentId, &result.CommentSnippet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		result.Task = task
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over search results: %w", err)
+	}
+	return results, nil
+}
+
+// Stats
+
+// GetStats computes the same figures as Storage.GetStats. SQLite has no
+// GROUPING SETS, so a copy of every task makes up the totals group, and no
+// percentile_cont, so the median is the average of the middle one or two
+// completion times by rank.
+func (s *SQLiteStorage) GetStats(ctx context.Context, q StatsQuery) (*pb.Stats, error) {
+	query := `WITH scoped AS (
+			SELECT 0 AS is_total, COALESCE(checklist_id, '') AS checklist_id, done, created_at, due_at, completed_at
+			FROM tasks
+			WHERE created_at >= $1 AND created_at < $2 AND ($3 = '' OR checklist_id = $3)
+		), groups AS (
+			SELECT * FROM scoped
+			UNION ALL
+			SELECT 1, '', done, created_at, due_at, completed_at FROM scoped
+		), ranked AS (
+			SELECT is_total, checklist_id, completed_at - created_at AS micros,
+				ROW_NUMBER() OVER (PARTITION BY is_total, checklist_id ORDER BY completed_at - created_at) AS i,
+				COUNT(*) OVER (PARTITION BY is_total, checklist_id) AS n
+			FROM groups
+			WHERE completed_at IS NOT NULL
+		), medians AS (
+			SELECT is_total, checklist_id, AVG(micros) AS micros
+			FROM ranked
+			WHERE i IN ((n + 1) / 2, (n + 2) / 2)
+			GROUP BY is_total, checklist_id
+		)
+		SELECT g.is_total, g.checklist_id, COALESCE(MAX(c.title), ''),
+			COUNT(*), COUNT(*) FILTER (WHERE g.done), MAX(m.micros),
+			COUNT(*) FILTER (WHERE NOT g.done AND g.due_at < $4)
+		FROM groups g
+		LEFT JOIN medians m ON m.is_total = g.is_total AND m.checklist_id = g.checklist_id
+		LEFT JOIN checklists c ON g.is_total = 0 AND c.id = g.checklist_id
+		GROUP BY g.is_total, g.checklist_id
+		ORDER BY g.is_total DESC, COUNT(*) DESC, g.checklist_id`
+
+	rows, err := s.db.QueryContext(ctx, query, q.From.UnixMicro(), q.To.UnixMicro(), normalizeID(q.ChecklistID), q.Now.UnixMicro())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := &pb.Stats{
+		From:     timestamppb.New(q.From),
+		To:       timestamppb.New(q.To),
+		GroupBy:  q.GroupBy,
+		Timezone: q.Timezone,
+		Totals:   &pb.ChecklistStats{},
+	}
+	for rows.Next() {
+		var isTotal bool
+		var median sql.NullFloat64
+		var cs pb.ChecklistStats
+		if err := rows.Scan(&isTotal, &cs.ChecklistId, &cs.ChecklistTitle, &cs.Total, &cs.Completed, &median, &cs.Overdue); err != nil {
+			return nil, fmt.Errorf("failed to scan stats: %w", err)
+		}
+		if cs.Total > 0 {
+			cs.CompletionRatio = float64(cs.Completed) / float64(cs.Total)
+		}
+		if median.Valid {
+			cs.MedianTimeToComplete = durationpb.New(time.Duration(median.Float64 * float64(time.Microsecond)))
+		}
+		if isTotal {
+			stats.Totals = &cs
+		} else {
+			stats.Checklists = append(stats.Checklists, &cs)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over stats: %w", err)
+	}
+
+	series, err := s.statsSeries(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	stats.Series = series
+	return stats, nil
+}
+
+// statsSeries counts created and completed tasks per bucket like
+// Storage.statsSeries. SQLite has no time zones, so the bucket starts are
+// computed in Go and passed in as a JSON array of Unix microseconds.
+func (s *SQLiteStorage) statsSeries(ctx context.Context, q StatsQuery) ([]*pb.StatsBucket, error) {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+	starts, err := statsBuckets(q, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+	micros := make([]int64, 0, len(starts))
+	for _, start := range starts {
+		micros = append(micros, start.UnixMicro())
+	}
+	buckets, err := json.Marshal(micros)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+
+	query := `WITH buckets AS (
+			SELECT value AS start, LEAD(value, 1, $2) OVER (ORDER BY key) AS next
+			FROM json_each($4)
+		), created AS (
+			SELECT b.start, COUNT(*) AS n
+			FROM buckets b
+			JOIN tasks t ON t.created_at >= b.start AND t.created_at < b.next
+			WHERE t.created_at >= $1 AND t.created_at < $2 AND ($3 = '' OR t.checklist_id = $3)
+			GROUP BY b.start
+		), completed AS (
+			SELECT b.start, COUNT(*) AS n
+			FROM buckets b
+			JOIN tasks t ON t.completed_at >= b.start AND t.completed_at < b.next
+			WHERE t.completed_at >= $1 AND t.completed_at < $2 AND ($3 = '' OR t.checklist_id = $3)
+			GROUP BY b.start
+		)
+		SELECT b.start, COALESCE(cr.n, 0), COALESCE(co.n, 0)
+		FROM buckets b
+		LEFT JOIN created cr ON cr.start = b.start
+		LEFT JOIN completed co ON co.start = b.start
+		ORDER BY b.start`
+
+	rows, err := s.db.QueryContext(ctx, query, q.From.UnixMicro(), q.To.UnixMicro(), normalizeID(q.ChecklistID), string(buckets))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+	defer rows.Close()
+
+	var series []*pb.StatsBucket
+	for rows.Next() {
+		var start int64
+		var bucket pb.StatsBucket
+		if err := rows.Scan(&start, &bucket.Created, &bucket.Completed); err != nil {
+			return nil, fmt.Errorf("failed to scan stats series: %w", err)
+		}
+		bucket.Start = timestamppb.New(fromMicros(start))
+		series = append(series, &bucket)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over stats series: %w", err)
+	}
+	return series, nil
+}
@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"checklist-go/services/db-service/internal/filter"
+	"fmt"
+	"time"
+)
+
+// sqliteFilterSQL is filterSQL for the SQLite schema: tags are a JSON array,
+// times are unix microseconds and text matching goes through the functions
+// registered in sqlite.go.
+type sqliteFilterSQL struct {
+	args []any
+	now  time.Time
+}
+
+func (f *sqliteFilterSQL) arg(v any) string {
+	f.args = append(f.args, v)
+	return fmt.Sprintf("$%d", len(f.args))
+}
+
+func (f *sqliteFilterSQL) build(e filter.Expr) (string, error) {
+	switch e := e.(type) {
+	case filter.And:
+		return f.binary(e.Left, "AND", e.Right)
+	case filter.Or:
+		return f.binary(e.Left, "OR", e.Right)
+	case filter.Not:
+		x, err := f.build(e.X)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + x, nil
+
+	case filter.Flag:
+		var cond string
+		switch e.Field {
+		case "done":
+			cond = "tasks.done"
+		case "blocked":
+			cond = blockedCondition
+		default:
+			return "", fmt.Errorf("unsupported filter flag %q", e.Field)
+		}
+		if !e.Value {
+			return "(NOT " + cond + ")", nil
+		}
+		return "(" + cond + ")", nil
+
+	case filter.Match:
+		switch e.Field {
+		case "tag":
+			return "EXISTS (SELECT 1 FROM json_each(tasks.tags) WHERE json_each.value = " + f.arg(e.Value) + ")", nil
+		case "status":
+			return "(tasks.status = " + f.arg(e.Value) + ")", nil
+		case "assignee":
+			return "EXISTS (SELECT 1 FROM task_assignees a WHERE a.task_id = tasks.id AND a.user_id = " + f.arg(e.Value) + ")", nil
+		case "checklist":
+			return "(tasks.checklist_id IS " + f.arg(normalizeID(e.Value)) + ")", nil
+		case "title":
+			return "contains_fold(tasks.title, " + f.arg(e.Value) + ")", nil
+		case "description":
+			return "contains_fold(COALESCE(tasks.description, ''), " + f.arg(e.Value) + ")", nil
+		case "text":
+			return "search_plain_match(" + f.arg(e.Value) + ", tasks.title, COALESCE(tasks.description, ''))", nil
+		}
+		return "", fmt.Errorf("unsupported filter field %q", e.Field)
+
+	case filter.TimeSet:
+		column, ok := filterTimeColumns[e.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		if e.Set {
+			return "(" + column + " IS NOT NULL)", nil
+		}
+		return "(" + column + " IS NULL)", nil
+
+	case filter.TimeCompare:
+		column, ok := filterTimeColumns[e.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported filter field %q", e.Field)
+		}
+		at := e.Value.Resolve(f.now).UnixMicro()
+		nextDay := e.Value.Resolve(f.now).AddDate(0, 0, 1).UnixMicro()
+
+		var cond string
+		switch {
+		case e.Op == "=" && e.Value.Day:
+			cond = column + " >= " + f.arg(at) + " AND " + column + " < " + f.arg(nextDay)
+		case e.Op == "<=" && e.Value.Day:
+			cond = column + " < " + f.arg(nextDay)
+		case e.Op == ">" && e.Value.Day:
+			cond = column + " >= " + f.arg(nextDay)
+		case e.Op == "<" || e.Op == "<=" || e.Op == ">" || e.Op == ">=":
+			cond = column + " " + e.Op + " " + f.arg(at)
+		default:
+			return "", fmt.Errorf("unsupported time comparison %q", e.Op)
+		}
+		return "COALESCE(" + cond + ", false)", nil
+	}
+	return "", fmt.Errorf("unsupported filter expression %T", e)
+}
+
+func (f *sqliteFilterSQL) binary(left filter.Expr, op string, right filter.Expr) (string, error) {
+	l, err := f.build(left)
+	if err != nil {
+		return "", err
+	}
+	r, err := f.build(right)
+	if err != nil {
+		return "", err
+	}
+	return "(" + l + " " + op + " " + r + ")", nil
+}
@@ -0,0 +1,317 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Dependencies
+
+// AddDependency works like Storage.AddDependency; the transaction's write
+// lock takes the place of the advisory lock.
+func (s *SQLiteStorage) AddDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	taskID, dependsOnID = normalizeID(taskID), normalizeID(dependsOnID)
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		var cycle bool
+		err := tx.QueryRowContext(ctx, `WITH RECURSIVE prerequisites(id) AS (
+				SELECT depends_on_id FROM task_dependencies WHERE task_id = $2
+				UNION
+				SELECT d.depends_on_id FROM task_dependencies d JOIN prerequisites p ON d.task_id = p.id
+			)
+			SELECT EXISTS (SELECT 1 FROM prerequisites WHERE id = $1)`, taskID, dependsOnID).Scan(&cycle)
+		if err != nil {
+			return fmt.Errorf("failed to check dependency cycle: %w", err)
+		}
+		if cycle {
+			return ErrDependencyCycle
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_dependencies (task_id, depends_on_id, created_at) VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING`, taskID, dependsOnID, sqliteNow())
+		if err != nil {
+			if isSQLiteForeignKeyViolation(err) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to add dependency: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *SQLiteStorage) RemoveDependency(ctx context.Context, taskID string, dependsOnID string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM task_dependencies WHERE task_id = $1 AND depends_on_id = $2`,
+		normalizeID(taskID), normalizeID(dependsOnID))
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+	return notFoundUnless(res, "failed to remove dependency")
+}
+
+// Workflows
+
+func (s *SQLiteStorage) GetWorkflow(ctx context.Context, checklistID string) (*pb.Workflow, error) {
+	checklistID = normalizeID(checklistID)
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM checklists WHERE id = $1)`, checklistID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	w := &pb.Workflow{ChecklistId: checklistID}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT key, name, is_done FROM workflow_states WHERE checklist_id = $1 ORDER BY position`, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow states: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var state pb.WorkflowState
+		if err := rows.Scan(&state.Key, &state.Name, &state.IsDone); err != nil {
+			return nil, fmt.Errorf("failed to scan workflow state: %w", err)
+		}
+		w.States = append(w.States, &state)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan workflow state: %w", err)
+	}
+
+	rows, err = s.db.QueryContext(ctx, `SELECT t.from_state, t.to_state FROM workflow_transitions t
+		JOIN workflow_states f ON f.checklist_id = t.checklist_id AND f.key = t.from_state
+		JOIN workflow_states o ON o.checklist_id = t.checklist_id AND o.key = t.to_state
+		WHERE t.checklist_id = $1 ORDER BY f.position, o.position`, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow transitions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var t pb.WorkflowTransition
+		if err := rows.Scan(&t.FromState, &t.ToState); err != nil {
+			return nil, fmt.Errorf("failed to scan workflow transition: %w", err)
+		}
+		w.Transitions = append(w.Transitions, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan workflow transition: %w", err)
+	}
+
+	return w, nil
+}
+
+func (s *SQLiteStorage) SetWorkflow(ctx context.Context, w *pb.Workflow) error {
+	checklistID := normalizeID(w.ChecklistId)
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM checklists WHERE id = $1)`, checklistID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to lock checklist: %w", err)
+		}
+		if !exists {
+			return ErrNotFound
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM workflow_states WHERE checklist_id = $1`, checklistID); err != nil {
+			return fmt.Errorf("failed to clear workflow: %w", err)
+		}
+		for i, state := range w.States {
+			_, err := tx.ExecContext(ctx, `INSERT INTO workflow_states (checklist_id, key, name, position, is_done) VALUES ($1, $2, $3, $4, $5)`,
+				checklistID, state.Key, state.Name, i, state.IsDone)
+			if err != nil {
+				return fmt.Errorf("failed to save workflow: %w", err)
+			}
+		}
+		for _, t := range w.Transitions {
+			_, err := tx.ExecContext(ctx, `INSERT INTO workflow_transitions (checklist_id, from_state, to_state) VALUES ($1, $2, $3)
+				ON CONFLICT DO NOTHING`, checklistID, t.FromState, t.ToState)
+			if err != nil {
+				return fmt.Errorf("failed to save workflow: %w", err)
+			}
+		}
+
+		now := sqliteNow()
+		_, err := tx.ExecContext(ctx, `UPDATE tasks SET
+				status = CASE WHEN done THEN `+doneStatus(`$1`)+` ELSE `+initialStatus(`$1`)+` END,
+				updated_at = $2
+			WHERE checklist_id = $1
+				AND status NOT IN (SELECT key FROM workflow_states WHERE checklist_id = $1)`, checklistID, now)
+		if err != nil {
+			return fmt.Errorf("failed to save workflow: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE tasks AS t SET
+				done = ws.is_done,
+				completed_at = CASE WHEN ws.is_done THEN COALESCE(t.completed_at, $2) END,
+				updated_at = $2
+			FROM workflow_states ws
+			WHERE t.checklist_id = $1 AND ws.checklist_id = $1 AND ws.key = t.status AND t.done <> ws.is_done`, checklistID, now)
+		if err != nil {
+			return fmt.Errorf("failed to save workflow: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *SQLiteStorage) TransitionTask(ctx context.Context, id string, from string, to string, done bool, requirePrerequisites bool) error {
+	id = normalizeID(id)
+	query := `UPDATE tasks SET status = $3, done = $4,
+			completed_at = CASE WHEN $4 THEN COALESCE(completed_at, $6) END,
+			updated_at = $6
+		WHERE id = $1 AND status = $2 AND NOT ($5 AND ` + blockedCondition + `)`
+	res, err := s.db.ExecContext(ctx, query, id, from, to, done, requirePrerequisites, sqliteNow())
+	if err != nil {
+		return fmt.Errorf("failed to transition task: %w", err)
+	}
+	if ok, err := rowsAffected(res); err != nil {
+		return fmt.Errorf("failed to transition task: %w", err)
+	} else if ok {
+		return nil
+	}
+
+	task, err := s.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task.Status != from {
+		return ErrConflict
+	}
+	return ErrTaskBlocked
+}
+
+// sqliteCopyWorkflow is copyWorkflow for SQLite.
+func sqliteCopyWorkflow(ctx context.Context, tx *sql.Tx, src string, dst string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO workflow_states (checklist_id, key, name, position, is_done)
+		SELECT $2, key, name, position, is_done FROM workflow_states WHERE checklist_id = $1`, src, dst)
+	if err != nil {
+		return fmt.Errorf("failed to copy workflow states: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO workflow_transitions (checklist_id, from_state, to_state)
+		SELECT $2, from_state, to_state FROM workflow_transitions WHERE checklist_id = $1`, src, dst)
+	if err != nil {
+		return fmt.Errorf("failed to copy workflow transitions: %w", err)
+	}
+	return nil
+}
+
+// Members and assignees
+
+func scanSQLiteChecklistMember(row sqliteRow) (*pb.ChecklistMember, error) {
+	var member pb.ChecklistMember
+	var createdAt int64
+	if err := row.Scan(&member.ChecklistId, &member.UserId, &createdAt); err != nil {
+		return nil, err
+	}
+	member.CreatedAt = timestamppb.New(fromMicros(createdAt))
+	return &member, nil
+}
+
+func (s *SQLiteStorage) AddChecklistMember(ctx context.Context, checklistID string, userID string) (*pb.ChecklistMember, error) {
+	query := `INSERT INTO checklist_members (checklist_id, user_id, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (checklist_id, user_id) DO UPDATE SET user_id = excluded.user_id
+		RETURNING checklist_id, user_id, created_at`
+
+	member, err := scanSQLiteChecklistMember(s.db.QueryRowContext(ctx, query, normalizeID(checklistID), userID, sqliteNow()))
+	if err != nil {
+		if isSQLiteForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to add checklist member: %w", err)
+	}
+	return member, nil
+}
+
+func (s *SQLiteStorage) RemoveChecklistMember(ctx context.Context, checklistID string, userID string) error {
+	checklistID = normalizeID(checklistID)
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `DELETE FROM checklist_members WHERE checklist_id = $1 AND user_id = $2`, checklistID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to remove checklist member: %w", err)
+		}
+		if err := notFoundUnless(res, "failed to remove checklist member"); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM task_assignees
+			WHERE user_id = $2 AND task_id IN (SELECT id FROM tasks WHERE checklist_id = $1)`, checklistID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to remove assignments: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *SQLiteStorage) ListChecklistMembers(ctx context.Context, checklistID string) ([]*pb.ChecklistMember, error) {
+	query := `SELECT checklist_id, user_id, created_at FROM checklist_members WHERE checklist_id = $1 ORDER BY created_at, rowid`
+	rows, err := s.db.QueryContext(ctx, query, normalizeID(checklistID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklist members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*pb.ChecklistMember
+	for rows.Next() {
+		member, err := scanSQLiteChecklistMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist member: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over checklist members: %w", err)
+	}
+	return members, nil
+}
+
+// AssignTask works like Storage.AssignTask. The insert and the membership
+// check are one statement, which SQLite runs under the write lock, so a
+// concurrent RemoveChecklistMember cannot interleave.
+func (s *SQLiteStorage) AssignTask(ctx context.Context, taskID string, userID string) error {
+	taskID = normalizeID(taskID)
+	res, err := s.db.ExecContext(ctx, `INSERT INTO task_assignees (task_id, user_id, created_at)
+		SELECT t.id, m.user_id, $3 FROM tasks t
+		JOIN checklist_members m ON m.checklist_id = t.checklist_id AND m.user_id = $2
+		WHERE t.id = $1
+		ON CONFLICT (task_id, user_id) DO NOTHING`, taskID, userID, sqliteNow())
+	if err != nil {
+		return fmt.Errorf("failed to assign task: %w", err)
+	}
+	if ok, err := rowsAffected(res); err != nil {
+		return fmt.Errorf("failed to assign task: %w", err)
+	} else if ok {
+		return nil
+	}
+
+	var taskExists, assigned bool
+	err = s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1),
+		EXISTS (SELECT 1 FROM task_assignees WHERE task_id = $1 AND user_id = $2)`, taskID, userID).Scan(&taskExists, &assigned)
+	if err != nil {
+		return fmt.Errorf("failed to assign task: %w", err)
+	}
+	switch {
+	case !taskExists:
+		return ErrNotFound
+	case assigned:
+		return nil
+	default:
+		return ErrNotMember
+	}
+}
+
+func (s *SQLiteStorage) UnassignTask(ctx context.Context, taskID string, userID string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM task_assignees WHERE task_id = $1 AND user_id = $2`, normalizeID(taskID), userID)
+	if err != nil {
+		return fmt.Errorf("failed to unassign task: %w", err)
+	}
+	return notFoundUnless(res, "failed to unassign task")
+}
+
+// errNoRows maps sql.ErrNoRows to ErrNotFound and wraps other errors.
+func errNoRows(err error, failure string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return fmt.Errorf("%s: %w", failure, err)
+}
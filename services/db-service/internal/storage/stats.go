@@ -0,0 +1,144 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatsQuery selects the tasks GetStats aggregates over.
+type StatsQuery struct {
+	// From and To bound created_at for the per-checklist figures, and
+	// created_at or completed_at for the series.
+	From, To    time.Time
+	ChecklistID string
+	// GroupBy is a date_trunc unit: day, week or month.
+	GroupBy  string
+	Timezone string
+	// Now decides which open tasks are overdue.
+	Now time.Time
+}
+
+// GetStats computes completion figures per checklist and in total, and the
+// number of tasks created and completed per GroupBy bucket. All aggregation
+// happens in Postgres.
+func (s *Storage) GetStats(ctx context.Context, q StatsQuery) (*pb.Stats, error) {
+	// The empty grouping set adds the totals row; GROUPING() tells it apart
+	// from the row of tasks without a checklist.
+	query := `SELECT GROUPING(t.checklist_id) = 1, COALESCE(t.checklist_id::text, ''), COALESCE(MAX(c.title), ''),
+			COUNT(*), COUNT(*) FILTER (WHERE t.done),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM t.completed_at - t.created_at)::float8)
+				FILTER (WHERE t.completed_at IS NOT NULL),
+			COUNT(*) FILTER (WHERE NOT t.done AND t.due_at < $4)
+		FROM tasks t
+		LEFT JOIN checklists c ON c.id = t.checklist_id
+		WHERE t.created_at >= $1 AND t.created_at < $2
+			AND ($3 = '' OR t.checklist_id = NULLIF($3, '')::uuid)
+		GROUP BY GROUPING SETS ((t.checklist_id), ())
+		ORDER BY 1 DESC, COUNT(*) DESC`
+
+	rows, err := s.db.Query(ctx, query, q.From, q.To, q.ChecklistID, q.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := &pb.Stats{
+		From:     timestamppb.New(q.From),
+		To:       timestamppb.New(q.To),
+		GroupBy:  q.GroupBy,
+		Timezone: q.Timezone,
+		Totals:   &pb.ChecklistStats{},
+	}
+	for rows.Next() {
+		var isTotal bool
+		var median *float64
+		var cs pb.ChecklistStats
+		if err := rows.Scan(&isTotal, &cs.ChecklistId, &cs.ChecklistTitle, &cs.Total, &cs.Completed, &median, &cs.Overdue); err != nil {
+			return nil, fmt.Errorf("failed to scan stats: %w", err)
+		}
+		if cs.Total > 0 {
+			cs.CompletionRatio = float64(cs.Completed) / float64(cs.Total)
+		}
+		if median != nil {
+			cs.MedianTimeToComplete = durationpb.New(time.Duration(*median * float64(time.Second)))
+		}
+		if isTotal {
+			stats.Totals = &cs
+		} else {
+			stats.Checklists = append(stats.Checklists, &cs)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over stats: %w", err)
+	}
+
+	series, err := s.statsSeries(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	stats.Series = series
+	return stats, nil
+}
+
+// statsSeries counts created and completed tasks per bucket, including empty
+// buckets. Buckets are truncated in q.Timezone so that days and months start
+// at local midnight.
+func (s *Storage) statsSeries(ctx context.Context, q StatsQuery) ([]*pb.StatsBucket, error) {
+	query := `WITH buckets AS (
+			SELECT generate_series(
+				date_trunc($4::text, $1::timestamptz AT TIME ZONE $5::text),
+				date_trunc($4::text, ($2::timestamptz - interval '1 microsecond') AT TIME ZONE $5::text),
+				('1 ' || $4::text)::interval) AS bucket
+		), created AS (
+			SELECT date_trunc($4, created_at AT TIME ZONE $5) AS bucket, COUNT(*) AS n
+			FROM tasks
+			WHERE created_at >= $1 AND created_at < $2 AND ($3 = '' OR checklist_id = NULLIF($3, '')::uuid)
+			GROUP BY 1
+		), completed AS (
+			SELECT date_trunc($4, completed_at AT TIME ZONE $5) AS bucket, COUNT(*) AS n
+			FROM tasks
+			WHERE completed_at >= $1 AND completed_at < $2 AND ($3 = '' OR checklist_id = NULLIF($3, '')::uuid)
+			GROUP BY 1
+		)
+		SELECT b.bucket AT TIME ZONE $5, COALESCE(cr.n, 0), COALESCE(co.n, 0)
+		FROM buckets b
+		LEFT JOIN created cr ON cr.bucket = b.bucket
+		LEFT JOIN completed co ON co.bucket = b.bucket
+		ORDER BY b.bucket`
+
+	rows, err := s.db.Query(ctx, query, q.From, q.To, q.ChecklistID, q.GroupBy, q.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+	defer rows.Close()
+
+	var series []*pb.StatsBucket
+	for rows.Next() {
+		var start time.Time
+		var bucket pb.StatsBucket
+		if err := rows.Scan(&start, &bucket.Created, &bucket.Completed); err != nil {
+			return nil, fmt.Errorf("failed to scan stats series: %w", err)
+		}
+		bucket.Start = timestamppb.New(start)
+		series = append(series, &bucket)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over stats series: %w", err)
+	}
+	return series, nil
+}
+
+// CountOpenTasks returns the number of tasks that are not done and how many
+// of them are overdue.
+func (s *Storage) CountOpenTasks(ctx context.Context) (open int64, overdue int64, err error) {
+	query := `SELECT COUNT(*), COUNT(*) FILTER (WHERE due_at < NOW()) FROM tasks WHERE NOT done`
+	if err := s.db.QueryRow(ctx, query).Scan(&open, &overdue); err != nil {
+		return 0, 0, fmt.Errorf("failed to count open tasks: %w", err)
+	}
+	return open, overdue, nil
+}
@@ -0,0 +1,193 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statsTask is what aggregateStats needs to know about a task.
+type statsTask struct {
+	checklistID    string
+	checklistTitle string
+	done           bool
+	createdAt      time.Time
+	dueAt          *time.Time
+	completedAt    *time.Time
+}
+
+// aggregateStats computes GetStats in Go for the memory backend. tasks must
+// hold every task of the checklist in q (all tasks without one) that was
+// created or completed in [From, To).
+func aggregateStats(q StatsQuery, tasks []statsTask) (*pb.Stats, error) {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stats series: %w", err)
+	}
+
+	type group struct {
+		stats       *pb.ChecklistStats
+		completions []float64
+	}
+	totals := &group{stats: &pb.ChecklistStats{}}
+	groups := map[string]*group{}
+	for _, t := range tasks {
+		if t.createdAt.Before(q.From) || !t.createdAt.Before(q.To) {
+			continue
+		}
+		g, ok := groups[t.checklistID]
+		if !ok {
+			g = &group{stats: &pb.ChecklistStats{ChecklistId: t.checklistID, ChecklistTitle: t.checklistTitle}}
+			groups[t.checklistID] = g
+		}
+		for _, g := range []*group{g, totals} {
+			g.stats.Total++
+			if t.done {
+				g.stats.Completed++
+			}
+			if t.completedAt != nil {
+				g.completions = append(g.completions, t.completedAt.Sub(t.createdAt).Seconds())
+			}
+			if !t.done && t.dueAt != nil && t.dueAt.Before(q.Now) {
+				g.stats.Overdue++
+			}
+		}
+	}
+
+	finish := func(g *group) *pb.ChecklistStats {
+		if g.stats.Total > 0 {
+			g.stats.CompletionRatio = float64(g.stats.Completed) / float64(g.stats.Total)
+		}
+		if len(g.completions) > 0 {
+			median := percentileCont(g.completions, 0.5)
+			g.stats.MedianTimeToComplete = durationpb.New(time.Duration(median * float64(time.Second)))
+		}
+		return g.stats
+	}
+
+	stats := &pb.Stats{
+		From:     timestamppb.New(q.From),
+		To:       timestamppb.New(q.To),
+		GroupBy:  q.GroupBy,
+		Timezone: q.Timezone,
+		Totals:   finish(totals),
+	}
+	for _, g := range groups {
+		stats.Checklists = append(stats.Checklists, finish(g))
+	}
+	slices.SortFunc(stats.Checklists, func(a, b *pb.ChecklistStats) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ChecklistId, b.ChecklistId)
+	})
+
+	stats.Series, err = statsSeries(q, loc, tasks)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// statsSeries is the Go counterpart of Storage.statsSeries: buckets start at
+// local midnight in loc and empty buckets are included.
+func statsSeries(q StatsQuery, loc *time.Location, tasks []statsTask) ([]*pb.StatsBucket, error) {
+	starts, err := statsBuckets(q, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []*pb.StatsBucket
+	index := map[time.Time]*pb.StatsBucket{}
+	for _, b := range starts {
+		bucket := &pb.StatsBucket{Start: timestamppb.New(b)}
+		series = append(series, bucket)
+		index[b] = bucket
+	}
+
+	count := func(at *time.Time, add func(*pb.StatsBucket)) {
+		if at == nil || at.Before(q.From) || !at.Before(q.To) {
+			return
+		}
+		b, err := truncateLocal(*at, q.GroupBy, loc)
+		if err != nil {
+			return
+		}
+		if bucket, ok := index[b]; ok {
+			add(bucket)
+		}
+	}
+	for _, t := range tasks {
+		count(&t.createdAt, func(b *pb.StatsBucket) { b.Created++ })
+		count(t.completedAt, func(b *pb.StatsBucket) { b.Completed++ })
+	}
+	return series, nil
+}
+
+// statsBuckets returns the start of every q.GroupBy bucket that overlaps
+// [q.From, q.To), in order, like the generate_series of Storage.statsSeries.
+func statsBuckets(q StatsQuery, loc *time.Location) ([]time.Time, error) {
+	first, err := truncateLocal(q.From, q.GroupBy, loc)
+	if err != nil {
+		return nil, err
+	}
+	last, err := truncateLocal(q.To.Add(-time.Microsecond), q.GroupBy, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var starts []time.Time
+	for b := first; !b.After(last); b = addBucket(b, q.GroupBy, loc) {
+		starts = append(starts, b)
+	}
+	return starts, nil
+}
+
+// truncateLocal is date_trunc(unit, t AT TIME ZONE loc) AT TIME ZONE loc.
+func truncateLocal(t time.Time, unit string, loc *time.Location) (time.Time, error) {
+	local := t.In(loc)
+	y, m, d := local.Date()
+	switch unit {
+	case "day":
+	case "week":
+		// ISO weeks start on Monday.
+		d -= (int(local.Weekday()) + 6) % 7
+	case "month":
+		d = 1
+	default:
+		return time.Time{}, fmt.Errorf("unsupported stats grouping %q", unit)
+	}
+	return time.Date(y, m, d, 0, 0, 0, 0, loc).UTC(), nil
+}
+
+// addBucket moves a bucket start one unit forward in local wall time.
+func addBucket(b time.Time, unit string, loc *time.Location) time.Time {
+	local := b.In(loc)
+	switch unit {
+	case "week":
+		local = local.AddDate(0, 0, 7)
+	case "month":
+		local = local.AddDate(0, 1, 0)
+	default:
+		local = local.AddDate(0, 0, 1)
+	}
+	return local.UTC()
+}
+
+// percentileCont is percentile_cont: linear interpolation between the two
+// nearest values.
+func percentileCont(values []float64, p float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	pos := p * float64(len(sorted)-1)
+	lo := int(pos)
+	if lo+1 >= len(sorted) {
+		return sorted[lo]
+	}
+	return sorted[lo] + (pos-float64(lo))*(sorted[lo+1]-sorted[lo])
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"checklist-go/services/db-service/internal/migrate"
+	sqlitemigrations "checklist-go/services/db-service/migrations/sqlite"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestSQLiteStats checks that the SQL aggregation of the SQLite backend
+// agrees with aggregateStats, which mirrors Postgres, on medians, empty and
+// local buckets, and the order of the checklists.
+func TestSQLiteStats(t *testing.T) {
+	ctx := context.Background()
+	dsn := "sqlite://" + filepath.Join(t.TempDir(), "checklist.db")
+	db, err := OpenSQLite(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := migrate.ConnectSQLite(ctx, db, sqlitemigrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close(ctx)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	st, err := NewSQLiteStorage(Config{DSN: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(st.Close)
+
+	// The range spans the end of daylight saving time in Berlin, on Sunday
+	// 2025-10-26, and the turn of the month.
+	base := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+	at := func(hours float64) time.Time { return base.Add(time.Duration(hours * float64(time.Hour))) }
+	done := func(hours float64) *time.Time { t := at(hours); return &t }
+	checklists := map[string]string{
+		"11111111-1111-4111-8111-111111111111": "release",
+		"22222222-2222-4222-8222-222222222222": "chores",
+	}
+	tasks := []statsTask{
+		{checklistID: "11111111-1111-4111-8111-111111111111", createdAt: at(0), done: true, completedAt: done(1)},
+		{checklistID: "11111111-1111-4111-8111-111111111111", createdAt: at(10), done: true, completedAt: done(12)},
+		{checklistID: "11111111-1111-4111-8111-111111111111", createdAt: at(20), done: true, completedAt: done(24)},
+		{checklistID: "11111111-1111-4111-8111-111111111111", createdAt: at(130), dueAt: done(140)},
+		{checklistID: "22222222-2222-4222-8222-222222222222", createdAt: at(131.5), done: true, completedAt: done(132.5)},
+		{checklistID: "22222222-2222-4222-8222-222222222222", createdAt: at(200), done: true, completedAt: done(204)},
+		// Reopened: completed once, but no longer done.
+		{checklistID: "22222222-2222-4222-8222-222222222222", createdAt: at(270), completedAt: done(275), dueAt: done(280)},
+		{createdAt: at(11.5), dueAt: done(300)},
+		// Created before the range, completed within it.
+		{createdAt: at(-48), done: true, completedAt: done(2)},
+		// Created after the range.
+		{createdAt: at(400)},
+	}
+
+	for id, title := range checklists {
+		_, err := st.db.ExecContext(ctx, `INSERT INTO checklists (id, title, created_at, updated_at) VALUES ($1, $2, 0, 0)`, id, title)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	micros := func(t *time.Time) any {
+		if t == nil {
+			return nil
+		}
+		return t.UnixMicro()
+	}
+	for i := range tasks {
+		task := &tasks[i]
+		task.checklistTitle = checklists[task.checklistID]
+		var checklistID any
+		if task.checklistID != "" {
+			checklistID = task.checklistID
+		}
+		_, err := st.db.ExecContext(ctx, `INSERT INTO tasks (id, title, done, checklist_id, created_at, updated_at, due_at, completed_at)
+			VALUES ($1, 'task', $2, $3, $4, $4, $5, $6)`,
+			uuid.NewString(), task.done, checklistID, task.createdAt.UnixMicro(), micros(task.dueAt), micros(task.completedAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, q := range []StatsQuery{
+		{GroupBy: "day", Timezone: "UTC"},
+		{GroupBy: "day", Timezone: "Europe/Berlin"},
+		{GroupBy: "week", Timezone: "America/New_York"},
+		{GroupBy: "month", Timezone: "Asia/Kolkata"},
+		{GroupBy: "day", Timezone: "UTC", ChecklistID: "22222222-2222-4222-8222-222222222222"},
+		{GroupBy: "day", Timezone: "UTC", ChecklistID: "33333333-3333-4333-8333-333333333333"},
+	} {
+		q.From, q.To, q.Now = at(0), at(14*24), at(290)
+		t.Run(q.GroupBy+" "+q.Timezone+" "+q.ChecklistID, func(t *testing.T) {
+			scoped := tasks
+			if q.ChecklistID != "" {
+				scoped = nil
+				for _, task := range tasks {
+					if task.checklistID == q.ChecklistID {
+						scoped = append(scoped, task)
+					}
+				}
+			}
+			want, err := aggregateStats(q, scoped)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := st.GetStats(ctx, q)
+			if err != nil {
+				t.Fatalf("GetStats: %v", err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("GetStats =\n%v\nwant\n%v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,857 @@
+// Package storagetest is the conformance suite for storage.TaskRepository:
+// every backend runs it, so the backends behave the same behind the
+// db-service.
+package storagetest
+
+import (
+	pb "checklist-go/proto"
+	"checklist-go/services/db-service/internal/filter"
+	"checklist-go/services/db-service/internal/search"
+	"checklist-go/services/db-service/internal/storage"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Run runs the suite. newRepo returns an empty repository for one subtest and
+// is responsible for cleaning it up.
+func Run(t *testing.T, newRepo func(t *testing.T) storage.TaskRepository) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r storage.TaskRepository)
+	}{
+		{"Tasks", testTasks},
+		{"Checklists", testChecklists},
+		{"Filters", testFilters},
+		{"Dependencies", testDependencies},
+		{"Workflows", testWorkflows},
+		{"Members", testMembers},
+		{"Comments", testComments},
+		{"ShareLinks", testShareLinks},
+		{"SavedViews", testSavedViews},
+		{"Templates", testTemplates},
+		{"Search", testSearch},
+		{"Stats", testStats},
+		{"Recurrences", testRecurrences},
+		{"Attachments", testAttachments},
+		{"ConcurrentCreate", testConcurrentCreate},
+		{"ConcurrentDone", testConcurrentDone},
+		{"ConcurrentTransition", testConcurrentTransition},
+		{"ConcurrentDependencies", testConcurrentDependencies},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func createTask(t *testing.T, r storage.TaskRepository, task *pb.Task) *pb.Task {
+	t.Helper()
+	created, err := r.CreateTask(context.Background(), task)
+	if err != nil {
+		t.Fatalf("CreateTask(%q): %v", task.Title, err)
+	}
+	return created
+}
+
+func createChecklist(t *testing.T, r storage.TaskRepository, title string) *pb.Checklist {
+	t.Helper()
+	c, err := r.CreateChecklist(context.Background(), title, "")
+	if err != nil {
+		t.Fatalf("CreateChecklist(%q): %v", title, err)
+	}
+	return c
+}
+
+func wantErr(t *testing.T, op string, err error, want error) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Fatalf("%s: got error %v, want %v", op, err, want)
+	}
+}
+
+func titles(tasks []*pb.Task) []string {
+	var out []string
+	for _, task := range tasks {
+		out = append(out, task.Title)
+	}
+	return out
+}
+
+func listTitles(t *testing.T, r storage.TaskRepository, f storage.TaskFilter) []string {
+	t.Helper()
+	tasks, err := r.ListTasks(context.Background(), f)
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	return titles(tasks)
+}
+
+func testTasks(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	due := time.Now().Add(48 * time.Hour).Truncate(time.Microsecond)
+
+	first := createTask(t, r, &pb.Task{Title: "first", Description: "one", DueAt: timestamppb.New(due), Tags: []string{"a", "b"}})
+	second := createTask(t, r, &pb.Task{Title: "second"})
+
+	if first.Id == "" || first.CreatedAt == nil || first.UpdatedAt == nil {
+		t.Fatalf("CreateTask returned %v, want ID and timestamps", first)
+	}
+	if first.Done || first.Status != "todo" || first.CompletedAt != nil {
+		t.Errorf("new task: done=%v status=%q completed_at=%v, want an open todo task", first.Done, first.Status, first.CompletedAt)
+	}
+	if !first.DueAt.AsTime().Equal(due) {
+		t.Errorf("due_at = %v, want %v", first.DueAt.AsTime(), due)
+	}
+	if !slices.Equal(first.Tags, []string{"a", "b"}) {
+		t.Errorf("tags = %v, want [a b]", first.Tags)
+	}
+
+	got, err := r.GetTask(ctx, first.Id)
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if got.Title != "first" || got.Description != "one" {
+		t.Errorf("GetTask = %v, want the created task", got)
+	}
+
+	if got := listTitles(t, r, storage.TaskFilter{}); !slices.Equal(got, []string{"second", "first"}) {
+		t.Errorf("ListTasks = %v, want newest first", got)
+	}
+
+	if err := r.MarkTaskDone(ctx, first.Id, false); err != nil {
+		t.Fatalf("MarkTaskDone: %v", err)
+	}
+	got, _ = r.GetTask(ctx, first.Id)
+	if !got.Done || got.Status != "done" || got.CompletedAt == nil {
+		t.Errorf("done task: done=%v status=%q completed_at=%v", got.Done, got.Status, got.CompletedAt)
+	}
+	if got := listTitles(t, r, storage.TaskFilter{OpenOnly: true}); !slices.Equal(got, []string{"second"}) {
+		t.Errorf("ListTasks(open) = %v, want [second]", got)
+	}
+
+	open, _, err := r.CountOpenTasks(ctx)
+	if err != nil || open != 1 {
+		t.Errorf("CountOpenTasks = %d, %v, want 1", open, err)
+	}
+
+	if err := r.DeleteTask(ctx, second.Id); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	missing := uuid.NewString()
+	_, err = r.GetTask(ctx, second.Id)
+	wantErr(t, "GetTask(deleted)", err, storage.ErrNotFound)
+	wantErr(t, "DeleteTask(deleted)", r.DeleteTask(ctx, second.Id), storage.ErrNotFound)
+	wantErr(t, "MarkTaskDone(missing)", r.MarkTaskDone(ctx, missing, false), storage.ErrNotFound)
+}
+
+func testChecklists(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+
+	c, err := r.CreateChecklistWithTasks(ctx, &pb.Checklist{Title: "trip"}, []*pb.Task{{Title: "pack"}, {Title: "book"}})
+	if err != nil {
+		t.Fatalf("CreateChecklistWithTasks: %v", err)
+	}
+	createTask(t, r, &pb.Task{Title: "loose"})
+
+	got, err := r.GetChecklist(ctx, c.Id)
+	if err != nil || got.Title != "trip" {
+		t.Fatalf("GetChecklist = %v, %v", got, err)
+	}
+	tasks := listTitles(t, r, storage.TaskFilter{ChecklistID: c.Id})
+	slices.Sort(tasks)
+	if !slices.Equal(tasks, []string{"book", "pack"}) {
+		t.Errorf("ListTasks(checklist) = %v, want book and pack", tasks)
+	}
+
+	createChecklist(t, r, "later")
+	lists, err := r.ListChecklists(ctx)
+	if err != nil || len(lists) != 2 || lists[0].Title != "later" {
+		t.Errorf("ListChecklists = %v, %v, want newest first", lists, err)
+	}
+
+	missing := uuid.NewString()
+	_, err = r.GetChecklist(ctx, missing)
+	wantErr(t, "GetChecklist(missing)", err, storage.ErrNotFound)
+	_, err = r.CreateTask(ctx, &pb.Task{Title: "orphan", ChecklistId: missing})
+	wantErr(t, "CreateTask(missing checklist)", err, storage.ErrNotFound)
+}
+
+func testFilters(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "groceries")
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+	createTask(t, r, &pb.Task{Title: "Buy Milk", Tags: []string{"shop"}, ChecklistId: c.Id, DueAt: timestamppb.New(yesterday)})
+	createTask(t, r, &pb.Task{Title: "Write report", Description: "quarterly numbers", Tags: []string{"work"}})
+	bread := createTask(t, r, &pb.Task{Title: "Buy bread", Tags: []string{"shop", "urgent"}})
+	if err := r.MarkTaskDone(ctx, bread.Id, false); err != nil {
+		t.Fatalf("MarkTaskDone: %v", err)
+	}
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"tag:shop", []string{"Buy Milk", "Buy bread"}},
+		{"tag:shop and not done", []string{"Buy Milk"}},
+		{"title:buy", []string{"Buy Milk", "Buy bread"}},
+		{"description:NUMBERS", []string{"Write report"}},
+		{"checklist:" + c.Id, []string{"Buy Milk"}},
+		{"due<0h", []string{"Buy Milk"}},
+		{"not due<0h", []string{"Buy bread", "Write report"}},
+		{"completed:any", []string{"Buy bread"}},
+		{"tag:urgent or tag:work", []string{"Buy bread", "Write report"}},
+	}
+	for _, tt := range tests {
+		expr, err := filter.Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("filter.Parse(%q): %v", tt.expr, err)
+		}
+		got := listTitles(t, r, storage.TaskFilter{Expression: expr})
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ListTasks(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func testDependencies(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	a := createTask(t, r, &pb.Task{Title: "a"})
+	b := createTask(t, r, &pb.Task{Title: "b"})
+	c := createTask(t, r, &pb.Task{Title: "c"})
+
+	if err := r.AddDependency(ctx, b.Id, a.Id); err != nil {
+		t.Fatalf("AddDependency(b, a): %v", err)
+	}
+	if err := r.AddDependency(ctx, c.Id, b.Id); err != nil {
+		t.Fatalf("AddDependency(c, b): %v", err)
+	}
+	if err := r.AddDependency(ctx, c.Id, b.Id); err != nil {
+		t.Errorf("AddDependency twice: %v", err)
+	}
+	wantErr(t, "AddDependency(a, c)", r.AddDependency(ctx, a.Id, c.Id), storage.ErrDependencyCycle)
+	wantErr(t, "AddDependency(missing)", r.AddDependency(ctx, a.Id, uuid.NewString()), storage.ErrNotFound)
+
+	got, _ := r.GetTask(ctx, b.Id)
+	if !got.Blocked || !slices.Equal(got.DependsOnIds, []string{a.Id}) {
+		t.Errorf("b: blocked=%v depends_on=%v, want blocked by a", got.Blocked, got.DependsOnIds)
+	}
+	wantErr(t, "MarkTaskDone(blocked)", r.MarkTaskDone(ctx, b.Id, true), storage.ErrTaskBlocked)
+
+	expr, _ := filter.Parse("blocked")
+	if got := listTitles(t, r, storage.TaskFilter{Expression: expr}); !slices.Equal(got, []string{"c", "b"}) {
+		t.Errorf("ListTasks(is:blocked) = %v, want [c b]", got)
+	}
+
+	if err := r.MarkTaskDone(ctx, a.Id, true); err != nil {
+		t.Fatalf("MarkTaskDone(a): %v", err)
+	}
+	if err := r.MarkTaskDone(ctx, b.Id, true); err != nil {
+		t.Errorf("MarkTaskDone(b) after a: %v", err)
+	}
+
+	if err := r.RemoveDependency(ctx, c.Id, b.Id); err != nil {
+		t.Fatalf("RemoveDependency: %v", err)
+	}
+	wantErr(t, "RemoveDependency twice", r.RemoveDependency(ctx, c.Id, b.Id), storage.ErrNotFound)
+}
+
+func testWorkflows(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "board")
+	task := createTask(t, r, &pb.Task{Title: "card", ChecklistId: c.Id})
+
+	w := &pb.Workflow{
+		ChecklistId: c.Id,
+		States: []*pb.WorkflowState{
+			{Key: "backlog", Name: "Backlog"},
+			{Key: "doing", Name: "Doing"},
+			{Key: "shipped", Name: "Shipped", IsDone: true},
+		},
+		Transitions: []*pb.WorkflowTransition{
+			{FromState: "backlog", ToState: "doing"},
+			{FromState: "doing", ToState: "shipped"},
+		},
+	}
+	if err := r.SetWorkflow(ctx, w); err != nil {
+		t.Fatalf("SetWorkflow: %v", err)
+	}
+
+	got, err := r.GetWorkflow(ctx, c.Id)
+	if err != nil {
+		t.Fatalf("GetWorkflow: %v", err)
+	}
+	if len(got.States) != 3 || got.States[0].Key != "backlog" || !got.States[2].IsDone || len(got.Transitions) != 2 {
+		t.Errorf("GetWorkflow = %v, want the saved workflow", got)
+	}
+
+	moved, _ := r.GetTask(ctx, task.Id)
+	if moved.Status != "backlog" {
+		t.Errorf("status after SetWorkflow = %q, want backlog", moved.Status)
+	}
+	added := createTask(t, r, &pb.Task{Title: "new card", ChecklistId: c.Id})
+	if added.Status != "backlog" {
+		t.Errorf("status of a new task = %q, want backlog", added.Status)
+	}
+
+	if err := r.TransitionTask(ctx, task.Id, "backlog", "doing", false, true); err != nil {
+		t.Fatalf("TransitionTask: %v", err)
+	}
+	wantErr(t, "TransitionTask(stale from)", r.TransitionTask(ctx, task.Id, "backlog", "doing", false, true), storage.ErrConflict)
+	if err := r.TransitionTask(ctx, task.Id, "doing", "shipped", true, true); err != nil {
+		t.Fatalf("TransitionTask: %v", err)
+	}
+	done, _ := r.GetTask(ctx, task.Id)
+	if !done.Done || done.Status != "shipped" || done.CompletedAt == nil {
+		t.Errorf("shipped task: done=%v status=%q completed_at=%v", done.Done, done.Status, done.CompletedAt)
+	}
+
+	_, err = r.GetWorkflow(ctx, uuid.NewString())
+	wantErr(t, "GetWorkflow(missing)", err, storage.ErrNotFound)
+}
+
+func testMembers(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "team")
+	task := createTask(t, r, &pb.Task{Title: "review", ChecklistId: c.Id})
+
+	wantErr(t, "AssignTask(non-member)", r.AssignTask(ctx, task.Id, "alice"), storage.ErrNotMember)
+
+	for _, user := range []string{"alice", "bob"} {
+		if _, err := r.AddChecklistMember(ctx, c.Id, user); err != nil {
+			t.Fatalf("AddChecklistMember(%s): %v", user, err)
+		}
+	}
+	if _, err := r.AddChecklistMember(ctx, c.Id, "alice"); err != nil {
+		t.Errorf("AddChecklistMember twice: %v", err)
+	}
+	members, err := r.ListChecklistMembers(ctx, c.Id)
+	if err != nil || len(members) != 2 || members[0].UserId != "alice" {
+		t.Errorf("ListChecklistMembers = %v, %v, want alice and bob", members, err)
+	}
+
+	if err := r.AssignTask(ctx, task.Id, "alice"); err != nil {
+		t.Fatalf("AssignTask: %v", err)
+	}
+	if err := r.AssignTask(ctx, task.Id, "alice"); err != nil {
+		t.Errorf("AssignTask twice: %v", err)
+	}
+	if got := listTitles(t, r, storage.TaskFilter{AssigneeID: "alice"}); !slices.Equal(got, []string{"review"}) {
+		t.Errorf("ListTasks(assignee) = %v, want [review]", got)
+	}
+
+	if err := r.RemoveChecklistMember(ctx, c.Id, "alice"); err != nil {
+		t.Fatalf("RemoveChecklistMember: %v", err)
+	}
+	got, _ := r.GetTask(ctx, task.Id)
+	if len(got.AssigneeIds) != 0 {
+		t.Errorf("assignees after removing the member = %v, want none", got.AssigneeIds)
+	}
+	wantErr(t, "UnassignTask(removed)", r.UnassignTask(ctx, task.Id, "alice"), storage.ErrNotFound)
+	wantErr(t, "AssignTask(missing task)", r.AssignTask(ctx, uuid.NewString(), "bob"), storage.ErrNotFound)
+}
+
+func testComments(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	task := createTask(t, r, &pb.Task{Title: "discuss"})
+
+	var ids []string
+	for _, body := range []string{"one", "two", "three"} {
+		c, err := r.AddComment(ctx, task.Id, "alice", body)
+		if err != nil {
+			t.Fatalf("AddComment: %v", err)
+		}
+		ids = append(ids, c.Id)
+	}
+
+	page, err := r.ListComments(ctx, task.Id, nil, 2)
+	if err != nil || len(page) != 2 {
+		t.Fatalf("ListComments = %d comments, %v, want 2", len(page), err)
+	}
+	last := page[1]
+	rest, err := r.ListComments(ctx, task.Id, &storage.CommentCursor{CreatedAt: last.CreatedAt.AsTime(), ID: last.Id}, 2)
+	if err != nil || len(rest) != 1 {
+		t.Fatalf("ListComments after cursor = %d comments, %v, want 1", len(rest), err)
+	}
+	seen := []string{page[0].Id, page[1].Id, rest[0].Id}
+	slices.Sort(seen)
+	slices.Sort(ids)
+	if !slices.Equal(seen, ids) {
+		t.Errorf("paged comments %v, want each of %v once", seen, ids)
+	}
+
+	edited, err := r.EditComment(ctx, ids[0], task.Id, "alice", "edited")
+	if err != nil || edited.Body != "edited" || edited.EditedAt == nil {
+		t.Errorf("EditComment = %v, %v", edited, err)
+	}
+	_, err = r.EditComment(ctx, ids[0], task.Id, "bob", "hijacked")
+	wantErr(t, "EditComment(other author)", err, storage.ErrNotAuthor)
+	wantErr(t, "DeleteComment(other author)", r.DeleteComment(ctx, ids[0], task.Id, "bob"), storage.ErrNotAuthor)
+	if err := r.DeleteComment(ctx, ids[0], task.Id, "alice"); err != nil {
+		t.Fatalf("DeleteComment: %v", err)
+	}
+	wantErr(t, "DeleteComment twice", r.DeleteComment(ctx, ids[0], task.Id, "alice"), storage.ErrNotFound)
+	_, err = r.AddComment(ctx, uuid.NewString(), "alice", "lost")
+	wantErr(t, "AddComment(missing task)", err, storage.ErrNotFound)
+}
+
+func testShareLinks(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "shared")
+
+	link, err := r.CreateShareLink(ctx, c.Id, []byte("token-hash"), "secret-hash", nil)
+	if err != nil {
+		t.Fatalf("CreateShareLink: %v", err)
+	}
+	if !link.PasswordProtected || link.RevokedAt != nil {
+		t.Errorf("CreateShareLink = %v, want an active password protected link", link)
+	}
+
+	got, passwordHash, err := r.GetShareLinkByTokenHash(ctx, []byte("token-hash"))
+	if err != nil || got.Id != link.Id || passwordHash != "secret-hash" {
+		t.Errorf("GetShareLinkByTokenHash = %v, %q, %v", got, passwordHash, err)
+	}
+
+	revoked, err := r.RevokeShareLink(ctx, link.Id)
+	if err != nil || revoked.RevokedAt == nil {
+		t.Errorf("RevokeShareLink = %v, %v", revoked, err)
+	}
+	_, _, err = r.GetShareLinkByTokenHash(ctx, []byte("unknown"))
+	wantErr(t, "GetShareLinkByTokenHash(unknown)", err, storage.ErrNotFound)
+	_, err = r.CreateShareLink(ctx, uuid.NewString(), []byte("other"), "", nil)
+	wantErr(t, "CreateShareLink(missing checklist)", err, storage.ErrNotFound)
+}
+
+func testSavedViews(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+
+	b, err := r.CreateSavedView(ctx, "b view", "tag:b")
+	if err != nil {
+		t.Fatalf("CreateSavedView: %v", err)
+	}
+	if _, err := r.CreateSavedView(ctx, "a view", "tag:a"); err != nil {
+		t.Fatalf("CreateSavedView: %v", err)
+	}
+
+	views, err := r.ListSavedViews(ctx)
+	if err != nil || len(views) != 2 || views[0].Name != "a view" {
+		t.Errorf("ListSavedViews = %v, %v, want sorted by name", views, err)
+	}
+
+	updated, err := r.UpdateSavedView(ctx, b.Id, "b view", "tag:c")
+	if err != nil || updated.Filter != "tag:c" {
+		t.Errorf("UpdateSavedView = %v, %v", updated, err)
+	}
+	if err := r.DeleteSavedView(ctx, b.Id); err != nil {
+		t.Fatalf("DeleteSavedView: %v", err)
+	}
+	_, err = r.GetSavedView(ctx, b.Id)
+	wantErr(t, "GetSavedView(deleted)", err, storage.ErrNotFound)
+}
+
+func testTemplates(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "onboarding")
+	due := c.CreatedAt.AsTime().Add(72 * time.Hour)
+	createTask(t, r, &pb.Task{Title: "laptop", ChecklistId: c.Id, DueAt: timestamppb.New(due), Tags: []string{"it"}})
+	createTask(t, r, &pb.Task{Title: "badge", ChecklistId: c.Id})
+
+	tmpl, err := r.CreateTemplateFromChecklist(ctx, c.Id, "New hire", "", "")
+	if err != nil {
+		t.Fatalf("CreateTemplateFromChecklist: %v", err)
+	}
+	if tmpl.ChecklistTitle != "onboarding" || len(tmpl.Tasks) != 2 {
+		t.Fatalf("template = %v, want the checklist title and both tasks", tmpl)
+	}
+	laptop := tmpl.Tasks[0]
+	if laptop.Title != "laptop" || laptop.DueOffset.AsDuration() != 72*time.Hour || !slices.Equal(laptop.Tags, []string{"it"}) {
+		t.Errorf("first blueprint = %v, want laptop due after 72h", laptop)
+	}
+	if tmpl.Tasks[1].DueOffset != nil {
+		t.Errorf("blueprint without due date has offset %v", tmpl.Tasks[1].DueOffset)
+	}
+
+	got, err := r.GetTemplate(ctx, tmpl.Id)
+	if err != nil || len(got.Tasks) != 2 {
+		t.Errorf("GetTemplate = %v, %v", got, err)
+	}
+	_, err = r.CreateTemplateFromChecklist(ctx, uuid.NewString(), "x", "", "")
+	wantErr(t, "CreateTemplateFromChecklist(missing)", err, storage.ErrNotFound)
+}
+
+func testSearch(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	inTitle := createTask(t, r, &pb.Task{Title: "Pay invoice", Description: "before friday"})
+	inDescription := createTask(t, r, &pb.Task{Title: "Accounting", Description: "check the invoice total"})
+	inComment := createTask(t, r, &pb.Task{Title: "Call supplier"})
+	createTask(t, r, &pb.Task{Title: "Unrelated"})
+
+	comment, err := r.AddComment(ctx, inComment.Id, "alice", "they resent the invoice")
+	if err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+
+	tsquery, err := search.ParseQuery("invoice")
+	if err != nil {
+		t.Fatalf("ParseQuery: %v", err)
+	}
+	results, err := r.SearchTasks(ctx, tsquery, "", 10)
+	if err != nil {
+		t.Fatalf("SearchTasks: %v", err)
+	}
+	var ids []string
+	for _, res := range results {
+		ids = append(ids, res.Task.Id)
+	}
+	if !slices.Equal(ids, []string{inTitle.Id, inDescription.Id, inComment.Id}) {
+		t.Fatalf("SearchTasks = %v, want title, description and comment matches in that order", ids)
+	}
+	if results[0].TitleHighlight == "Pay invoice" || results[0].TitleHighlight == "" {
+		t.Errorf("title highlight %q does not mark the match", results[0].TitleHighlight)
+	}
+	if results[1].DescriptionSnippet == "" {
+		t.Error("description match has no snippet")
+	}
+	if results[2].CommentId != comment.Id || results[2].CommentSnippet == "" {
+		t.Errorf("comment match: comment_id=%q snippet=%q", results[2].CommentId, results[2].CommentSnippet)
+	}
+
+	if results, err := r.SearchTasks(ctx, tsquery, "", 1); err != nil || len(results) != 1 {
+		t.Errorf("SearchTasks(limit 1) = %d results, %v", len(results), err)
+	}
+
+	// English and Russian words are stemmed on both sides, as by the
+	// russian configuration in Postgres.
+	backups := createTask(t, r, &pb.Task{Title: "Run the backups"})
+	reports := createTask(t, r, &pb.Task{Title: "Задачи для отчёта"})
+	for query, want := range map[string][]string{
+		"invoices":       {inTitle.Id, inDescription.Id, inComment.Id},
+		"running backup": {backups.Id},
+		"задача отчет":   {reports.Id},
+	} {
+		tsquery, err := search.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("ParseQuery(%q): %v", query, err)
+		}
+		results, err := r.SearchTasks(ctx, tsquery, "", 10)
+		if err != nil {
+			t.Fatalf("SearchTasks(%q): %v", query, err)
+		}
+		var ids []string
+		for _, res := range results {
+			ids = append(ids, res.Task.Id)
+		}
+		if !slices.Equal(ids, want) {
+			t.Errorf("SearchTasks(%q) = %v, want %v", query, ids, want)
+		}
+	}
+}
+
+func testStats(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "release")
+	now := time.Now()
+
+	done := createTask(t, r, &pb.Task{Title: "tag", ChecklistId: c.Id})
+	createTask(t, r, &pb.Task{Title: "notes", ChecklistId: c.Id, DueAt: timestamppb.New(now.Add(-time.Hour))})
+	createTask(t, r, &pb.Task{Title: "elsewhere"})
+	if err := r.MarkTaskDone(ctx, done.Id, false); err != nil {
+		t.Fatalf("MarkTaskDone: %v", err)
+	}
+
+	stats, err := r.GetStats(ctx, storage.StatsQuery{
+		From:     now.Add(-24 * time.Hour),
+		To:       now.Add(time.Hour),
+		GroupBy:  "day",
+		Timezone: "UTC",
+		Now:      now.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("GetStats: %v", err)
+	}
+	if stats.Totals.Total != 3 || stats.Totals.Completed != 1 || stats.Totals.Overdue != 1 {
+		t.Errorf("totals = %v, want 3 tasks, 1 completed, 1 overdue", stats.Totals)
+	}
+	var release *pb.ChecklistStats
+	for _, cs := range stats.Checklists {
+		if cs.ChecklistId == c.Id {
+			release = cs
+		}
+	}
+	if release == nil || release.ChecklistTitle != "release" || release.Total != 2 || release.CompletionRatio != 0.5 {
+		t.Errorf("checklist stats = %v, want 2 tasks half done", release)
+	}
+	var created, completed int64
+	for _, b := range stats.Series {
+		created += b.Created
+		completed += b.Completed
+	}
+	if created != 3 || completed != 1 {
+		t.Errorf("series counts created=%d completed=%d, want 3 and 1", created, completed)
+	}
+}
+
+func testRecurrences(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "chores")
+	task := createTask(t, r, &pb.Task{Title: "water plants", ChecklistId: c.Id, Tags: []string{"home"}})
+
+	dtstart := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	rec, err := r.UpsertRecurrence(ctx, &pb.Recurrence{
+		TaskId:           task.Id,
+		Rrule:            "FREQ=DAILY",
+		Dtstart:          timestamppb.New(dtstart),
+		Timezone:         "UTC",
+		NextOccurrenceAt: timestamppb.New(dtstart.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("UpsertRecurrence: %v", err)
+	}
+	again, err := r.UpsertRecurrence(ctx, &pb.Recurrence{
+		TaskId:           task.Id,
+		Rrule:            "FREQ=DAILY;INTERVAL=1",
+		Dtstart:          timestamppb.New(dtstart),
+		Timezone:         "UTC",
+		NextOccurrenceAt: timestamppb.New(dtstart.Add(24 * time.Hour)),
+	})
+	if err != nil || again.Id != rec.Id || again.Rrule != "FREQ=DAILY;INTERVAL=1" {
+		t.Errorf("second UpsertRecurrence = %v, %v, want the rule replaced in place", again, err)
+	}
+
+	occurrence := dtstart.Add(24 * time.Hour)
+	plan := func(d storage.DueRecurrence) (time.Time, *time.Time, error) {
+		return d.NextOccurrenceAt, nil, nil
+	}
+	processed, err := r.ProcessDueRecurrences(ctx, time.Now(), 10, plan)
+	if err != nil || processed != 1 {
+		t.Fatalf("ProcessDueRecurrences = %d, %v, want 1", processed, err)
+	}
+
+	tasks, err := r.ListTasks(ctx, storage.TaskFilter{ChecklistID: c.Id})
+	if err != nil || len(tasks) != 2 {
+		t.Fatalf("ListTasks = %v, %v, want the task and its occurrence", titles(tasks), err)
+	}
+	occ := tasks[0]
+	if occ.RecurrenceId != rec.Id || occ.Title != "water plants" || !slices.Equal(occ.Tags, []string{"home"}) ||
+		occ.DueAt == nil || !occ.DueAt.AsTime().Equal(occurrence) {
+		t.Errorf("occurrence = %v, want a copy due at %v", occ, occurrence)
+	}
+
+	recurrences, err := r.ListRecurrences(ctx)
+	if err != nil || len(recurrences) != 1 || recurrences[0].NextOccurrenceAt != nil {
+		t.Errorf("ListRecurrences = %v, %v, want the exhausted rule", recurrences, err)
+	}
+	if processed, err := r.ProcessDueRecurrences(ctx, time.Now(), 10, plan); err != nil || processed != 0 {
+		t.Errorf("ProcessDueRecurrences again = %d, %v, want 0", processed, err)
+	}
+
+	// A rule that cannot be planned is disabled with the error rather than
+	// picked up again, until it is set anew.
+	broken := createTask(t, r, &pb.Task{Title: "broken rule"})
+	brokenRule := &pb.Recurrence{
+		TaskId:           broken.Id,
+		Rrule:            "FREQ=SOMETIMES",
+		Dtstart:          timestamppb.New(dtstart),
+		Timezone:         "UTC",
+		NextOccurrenceAt: timestamppb.New(dtstart),
+	}
+	if _, err := r.UpsertRecurrence(ctx, brokenRule); err != nil {
+		t.Fatalf("UpsertRecurrence: %v", err)
+	}
+	fail := func(storage.DueRecurrence) (time.Time, *time.Time, error) {
+		return time.Time{}, nil, errors.New("bad rule")
+	}
+	if processed, err := r.ProcessDueRecurrences(ctx, time.Now(), 10, fail); err != nil || processed != 0 {
+		t.Errorf("ProcessDueRecurrences with a failing plan = %d, %v, want 0", processed, err)
+	}
+	recurrences, err = r.ListRecurrences(ctx)
+	if err != nil || len(recurrences) != 2 || recurrences[0].NextOccurrenceAt != nil || recurrences[1].NextOccurrenceAt != nil {
+		t.Errorf("ListRecurrences = %v, %v, want the failed rule disabled", recurrences, err)
+	} else if recurrences[0].LastError != "bad rule" || recurrences[1].LastError != "" {
+		t.Errorf("last errors = %q, %q, want only the failed rule's", recurrences[0].LastError, recurrences[1].LastError)
+	}
+	if processed, err := r.ProcessDueRecurrences(ctx, time.Now(), 10, fail); err != nil || processed != 0 {
+		t.Errorf("ProcessDueRecurrences after the failure = %d, %v, want 0", processed, err)
+	}
+	brokenRule.Rrule = "FREQ=DAILY"
+	if reset, err := r.UpsertRecurrence(ctx, brokenRule); err != nil || reset.LastError != "" || reset.NextOccurrenceAt == nil {
+		t.Errorf("UpsertRecurrence of the fixed rule = %v, %v, want it enabled without the error", reset, err)
+	}
+
+	if err := r.DeleteRecurrence(ctx, rec.Id); err != nil {
+		t.Fatalf("DeleteRecurrence: %v", err)
+	}
+	wantErr(t, "DeleteRecurrence twice", r.DeleteRecurrence(ctx, rec.Id), storage.ErrNotFound)
+}
+
+func testAttachments(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	task := createTask(t, r, &pb.Task{Title: "scan receipts"})
+
+	a, err := r.CreateAttachment(ctx, &pb.Attachment{
+		TaskId:      task.Id,
+		Filename:    "receipt.pdf",
+		ContentType: "application/pdf",
+		SizeBytes:   1024,
+		Sha256:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		StorageKey:  "tasks/" + task.Id + "/receipt.pdf",
+		UploadedBy:  "alice",
+	})
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+	if a.Id == "" || a.CreatedAt == nil || a.UploadedBy != "alice" || a.SizeBytes != 1024 {
+		t.Errorf("CreateAttachment = %v", a)
+	}
+
+	list, err := r.ListAttachments(ctx, task.Id)
+	if err != nil || len(list) != 1 || list[0].Id != a.Id {
+		t.Errorf("ListAttachments = %v, %v, want the attachment", list, err)
+	}
+	got, err := r.GetAttachment(ctx, a.Id)
+	if err != nil || got.StorageKey != a.StorageKey {
+		t.Errorf("GetAttachment = %v, %v", got, err)
+	}
+
+	deleted, err := r.DeleteAttachment(ctx, a.Id)
+	if err != nil || deleted.StorageKey != a.StorageKey {
+		t.Fatalf("DeleteAttachment = %v, %v, want the deleted row", deleted, err)
+	}
+	_, err = r.DeleteAttachment(ctx, a.Id)
+	wantErr(t, "DeleteAttachment twice", err, storage.ErrNotFound)
+	_, err = r.CreateAttachment(ctx, &pb.Attachment{TaskId: uuid.NewString(), Filename: "x", StorageKey: "x"})
+	wantErr(t, "CreateAttachment(missing task)", err, storage.ErrNotFound)
+}
+
+// parallel runs fn n times concurrently and returns the errors.
+func parallel(n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = fn(i)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func testConcurrentCreate(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	const n = 20
+
+	errs := parallel(n, func(i int) error {
+		_, err := r.CreateTask(ctx, &pb.Task{Title: "task"})
+		return err
+	})
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+	if got := listTitles(t, r, storage.TaskFilter{}); len(got) != n {
+		t.Errorf("ListTasks returned %d tasks, want %d", len(got), n)
+	}
+}
+
+func testConcurrentDone(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	task := createTask(t, r, &pb.Task{Title: "once"})
+
+	var completedAt []time.Time
+	var mu sync.Mutex
+	errs := parallel(10, func(int) error {
+		if err := r.MarkTaskDone(ctx, task.Id, false); err != nil {
+			return err
+		}
+		got, err := r.GetTask(ctx, task.Id)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		completedAt = append(completedAt, got.CompletedAt.AsTime())
+		mu.Unlock()
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("MarkTaskDone: %v", err)
+		}
+	}
+
+	final, _ := r.GetTask(ctx, task.Id)
+	for _, at := range completedAt {
+		if !at.Equal(final.CompletedAt.AsTime()) {
+			t.Fatalf("completed_at changed from %v to %v, want it set once", at, final.CompletedAt.AsTime())
+		}
+	}
+}
+
+func testConcurrentTransition(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+	c := createChecklist(t, r, "race")
+	err := r.SetWorkflow(ctx, &pb.Workflow{
+		ChecklistId: c.Id,
+		States:      []*pb.WorkflowState{{Key: "open", Name: "Open"}, {Key: "claimed", Name: "Claimed"}},
+		Transitions: []*pb.WorkflowTransition{{FromState: "open", ToState: "claimed"}},
+	})
+	if err != nil {
+		t.Fatalf("SetWorkflow: %v", err)
+	}
+	task := createTask(t, r, &pb.Task{Title: "claim me", ChecklistId: c.Id})
+
+	errs := parallel(10, func(int) error {
+		return r.TransitionTask(ctx, task.Id, "open", "claimed", false, false)
+	})
+	var won int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			won++
+		case !errors.Is(err, storage.ErrConflict):
+			t.Fatalf("TransitionTask: %v", err)
+		}
+	}
+	if won != 1 {
+		t.Errorf("%d transitions from the same state succeeded, want 1", won)
+	}
+}
+
+func testConcurrentDependencies(t *testing.T, r storage.TaskRepository) {
+	ctx := context.Background()
+
+	for range 5 {
+		a := createTask(t, r, &pb.Task{Title: "a"})
+		b := createTask(t, r, &pb.Task{Title: "b"})
+
+		errs := parallel(2, func(i int) error {
+			if i == 0 {
+				return r.AddDependency(ctx, a.Id, b.Id)
+			}
+			return r.AddDependency(ctx, b.Id, a.Id)
+		})
+		var added int
+		for _, err := range errs {
+			switch {
+			case err == nil:
+				added++
+			case !errors.Is(err, storage.ErrDependencyCycle):
+				t.Fatalf("AddDependency: %v", err)
+			}
+		}
+		if added != 1 {
+			t.Fatalf("%d of two opposite dependencies were added, want 1", added)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const templateColumns = `id, name, description, checklist_title, created_at, updated_at`
+
+// CreateTemplateFromChecklist snapshots the tasks of a checklist, in creation
+// order, as the blueprints of a new template. Due dates are stored as offsets
+// from the checklist's creation time.
+func (s *Storage) CreateTemplateFromChecklist(ctx context.Context, checklistID string, name string, description string, checklistTitle string) (*pb.Template, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var sourceTitle string
+	err = tx.QueryRow(ctx, `SELECT title FROM checklists WHERE id = $1`, checklistID).Scan(&sourceTitle)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	if checklistTitle == "" {
+		checklistTitle = sourceTitle
+	}
+
+	id := uuid.New()
+	query := `INSERT INTO templates (id, name, description, checklist_title) VALUES ($1, $2, $3, $4) RETURNING ` + templateColumns
+	template, err := scanTemplate(tx.QueryRow(ctx, query, id, name, description, checklistTitle))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO template_tasks (template_id, position, title, description, due_offset_seconds, tags)
+		SELECT $1, ROW_NUMBER() OVER (ORDER BY t.created_at, t.id) - 1, t.title, COALESCE(t.description, ''),
+			EXTRACT(EPOCH FROM t.due_at - c.created_at)::BIGINT, t.tags
+		FROM tasks t
+		JOIN checklists c ON c.id = t.checklist_id
+		WHERE t.checklist_id = $2`, id, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy checklist tasks: %w", err)
+	}
+
+	template.Tasks, err = listTemplateTasks(ctx, tx, template.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return template, nil
+}
+
+func (s *Storage) GetTemplate(ctx context.Context, id string) (*pb.Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM templates WHERE id = $1`
+
+	template, err := scanTemplate(s.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	template.Tasks, err = listTemplateTasks(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *Storage) ListTemplates(ctx context.Context) ([]*pb.Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM templates ORDER BY created_at desc`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Template, error) {
+		return scanTemplate(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan template: %w", err)
+	}
+
+	for _, template := range templates {
+		template.Tasks, err = listTemplateTasks(ctx, s.db, template.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+// querier is satisfied by both the pool and a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func listTemplateTasks(ctx context.Context, q querier, templateID string) ([]*pb.TaskBlueprint, error) {
+	rows, err := q.Query(ctx, `SELECT title, description, due_offset_seconds, tags FROM template_tasks
+		WHERE template_id = $1 ORDER BY position`, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template tasks: %w", err)
+	}
+	tasks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.TaskBlueprint, error) {
+		var task pb.TaskBlueprint
+		var offset *int64
+		if err := row.Scan(&task.Title, &task.Description, &offset, &task.Tags); err != nil {
+			return nil, err
+		}
+		if offset != nil {
+			task.DueOffset = durationpb.New(time.Duration(*offset) * time.Second)
+		}
+		return &task, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan template task: %w", err)
+	}
+	return tasks, nil
+}
+
+func scanTemplate(row pgx.Row) (*pb.Template, error) {
+	var template pb.Template
+	var id uuid.UUID
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(&id, &template.Name, &template.Description, &template.ChecklistTitle, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Id = id.String()
+	template.CreatedAt = timestamppb.New(createdAt)
+	template.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &template, nil
+}
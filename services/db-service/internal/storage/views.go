@@ -0,0 +1,99 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const savedViewColumns = `id, name, filter, created_at, updated_at`
+
+func (s *Storage) CreateSavedView(ctx context.Context, name string, filter string) (*pb.SavedView, error) {
+	query := `INSERT INTO saved_views (id, name, filter) VALUES ($1, $2, $3) RETURNING ` + savedViewColumns
+
+	view, err := scanSavedView(s.db.QueryRow(ctx, query, uuid.New(), name, filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved view: %w", err)
+	}
+	return view, nil
+}
+
+func (s *Storage) ListSavedViews(ctx context.Context) ([]*pb.SavedView, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+savedViewColumns+` FROM saved_views ORDER BY name, created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []*pb.SavedView
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved view: %w", err)
+		}
+		views = append(views, view)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over saved views: %w", err)
+	}
+	return views, nil
+}
+
+func (s *Storage) GetSavedView(ctx context.Context, id string) (*pb.SavedView, error) {
+	view, err := scanSavedView(s.db.QueryRow(ctx, `SELECT `+savedViewColumns+` FROM saved_views WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+	return view, nil
+}
+
+func (s *Storage) UpdateSavedView(ctx context.Context, id string, name string, filter string) (*pb.SavedView, error) {
+	query := `UPDATE saved_views SET name = $2, filter = $3, updated_at = NOW() WHERE id = $1 RETURNING ` + savedViewColumns
+
+	view, err := scanSavedView(s.db.QueryRow(ctx, query, id, name, filter))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to update saved view: %w", err)
+	}
+	return view, nil
+}
+
+func (s *Storage) DeleteSavedView(ctx context.Context, id string) error {
+	cmdTag, err := s.db.Exec(ctx, `DELETE FROM saved_views WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanSavedView(row pgx.Row) (*pb.SavedView, error) {
+	var view pb.SavedView
+	var id uuid.UUID
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&id, &view.Name, &view.Filter, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	view.Id = id.String()
+	view.CreatedAt = timestamppb.New(createdAt)
+	view.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &view, nil
+}
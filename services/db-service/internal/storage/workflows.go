@@ -0,0 +1,167 @@
+package storage
+
+import (
+	pb "checklist-go/proto"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrConflict is returned when a row changed between being read and updated.
+var ErrConflict = errors.New("concurrent modification")
+
+// initialStatus returns an SQL expression for the initial state of the
+// workflow of the checklist given by the SQL expression checklistID. Tasks
+// outside checklists with a custom workflow start in "todo".
+func initialStatus(checklistID string) string {
+	return `COALESCE((SELECT ws.key FROM workflow_states ws
+		WHERE ws.checklist_id = ` + checklistID + ` AND NOT ws.is_done ORDER BY ws.position LIMIT 1), 'todo')`
+}
+
+// doneStatus is like initialStatus but returns the first done state.
+func doneStatus(checklistID string) string {
+	return `COALESCE((SELECT ws.key FROM workflow_states ws
+		WHERE ws.checklist_id = ` + checklistID + ` AND ws.is_done ORDER BY ws.position LIMIT 1), 'done')`
+}
+
+// GetWorkflow returns the custom workflow of the checklist. A checklist that
+// uses the default workflow yields a workflow without states.
+func (s *Storage) GetWorkflow(ctx context.Context, checklistID string) (*pb.Workflow, error) {
+	var exists bool
+	if err := s.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM checklists WHERE id = $1)`, checklistID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	w := &pb.Workflow{ChecklistId: checklistID}
+
+	rows, err := s.db.Query(ctx, `SELECT key, name, is_done FROM workflow_states WHERE checklist_id = $1 ORDER BY position`, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow states: %w", err)
+	}
+	w.States, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.WorkflowState, error) {
+		var state pb.WorkflowState
+		err := row.Scan(&state.Key, &state.Name, &state.IsDone)
+		return &state, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan workflow state: %w", err)
+	}
+
+	rows, err = s.db.Query(ctx, `SELECT t.from_state, t.to_state FROM workflow_transitions t
+		JOIN workflow_states f ON f.checklist_id = t.checklist_id AND f.key = t.from_state
+		JOIN workflow_states o ON o.checklist_id = t.checklist_id AND o.key = t.to_state
+		WHERE t.checklist_id = $1 ORDER BY f.position, o.position`, checklistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow transitions: %w", err)
+	}
+	w.Transitions, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.WorkflowTransition, error) {
+		var t pb.WorkflowTransition
+		err := row.Scan(&t.FromState, &t.ToState)
+		return &t, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan workflow transition: %w", err)
+	}
+
+	return w, nil
+}
+
+// SetWorkflow replaces the workflow of the checklist. Tasks whose status is
+// not a state of the new workflow are moved to its first done state if they
+// are done and to its initial state otherwise.
+func (s *Storage) SetWorkflow(ctx context.Context, w *pb.Workflow) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked int
+	err = tx.QueryRow(ctx, `SELECT 1 FROM checklists WHERE id = $1 FOR UPDATE`, w.ChecklistId).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to lock checklist: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM workflow_states WHERE checklist_id = $1`, w.ChecklistId); err != nil {
+		return fmt.Errorf("failed to clear workflow: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for i, state := range w.States {
+		batch.Queue(`INSERT INTO workflow_states (checklist_id, key, name, position, is_done) VALUES ($1, $2, $3, $4, $5)`,
+			w.ChecklistId, state.Key, state.Name, i, state.IsDone)
+	}
+	for _, t := range w.Transitions {
+		batch.Queue(`INSERT INTO workflow_transitions (checklist_id, from_state, to_state) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+			w.ChecklistId, t.FromState, t.ToState)
+	}
+	batch.Queue(`UPDATE tasks SET
+			status = CASE WHEN done THEN `+doneStatus(`$1`)+` ELSE `+initialStatus(`$1`)+` END,
+			updated_at = NOW()
+		WHERE checklist_id = $1
+			AND status NOT IN (SELECT key FROM workflow_states WHERE checklist_id = $1)`, w.ChecklistId)
+	batch.Queue(`UPDATE tasks t SET
+			done = ws.is_done,
+			completed_at = CASE WHEN ws.is_done THEN COALESCE(t.completed_at, NOW()) END,
+			updated_at = NOW()
+		FROM workflow_states ws
+		WHERE t.checklist_id = $1 AND ws.checklist_id = $1 AND ws.key = t.status AND t.done <> ws.is_done`, w.ChecklistId)
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to save workflow: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// TransitionTask moves the task from status from to status to, setting done
+// (and completed_at) accordingly. It returns ErrConflict if the task is no
+// longer in status from, and ErrTaskBlocked if requirePrerequisites is set
+// and the task has open prerequisites.
+func (s *Storage) TransitionTask(ctx context.Context, id string, from string, to string, done bool, requirePrerequisites bool) error {
+	query := `UPDATE tasks SET status = $3, done = $4,
+			completed_at = CASE WHEN $4 THEN COALESCE(completed_at, NOW()) END,
+			updated_at = NOW()
+		WHERE id = $1 AND status = $2 AND NOT ($5 AND ` + blockedCondition + `)`
+	cmdTag, err := s.db.Exec(ctx, query, id, from, to, done, requirePrerequisites)
+	if err != nil {
+		return fmt.Errorf("failed to transition task: %w", err)
+	}
+	if cmdTag.RowsAffected() == 1 {
+		return nil
+	}
+
+	task, err := s.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task.Status != from {
+		return ErrConflict
+	}
+	return ErrTaskBlocked
+}
+
+// copyWorkflow gives the checklist dst the same custom workflow as src.
+func copyWorkflow(ctx context.Context, tx pgx.Tx, src string, dst string) error {
+	_, err := tx.Exec(ctx, `INSERT INTO workflow_states (checklist_id, key, name, position, is_done)
+		SELECT $2, key, name, position, is_done FROM workflow_states WHERE checklist_id = $1`, src, dst)
+	if err != nil {
+		return fmt.Errorf("failed to copy workflow states: %w", err)
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO workflow_transitions (checklist_id, from_state, to_state)
+		SELECT $2, from_state, to_state FROM workflow_transitions WHERE checklist_id = $1`, src, dst)
+	if err != nil {
+		return fmt.Errorf("failed to copy workflow transitions: %w", err)
+	}
+	return nil
+}
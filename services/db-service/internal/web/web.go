@@ -0,0 +1,185 @@
+// Package web serves ChecklistService and CommentService to browsers over the
+// Connect and gRPC-Web protocols, next to the native gRPC server. Like the
+// api-service, it requires the caller's identity in the X-User-ID header,
+// set by the authenticating proxy in front of it, and makes the caller the
+// author of the comments it writes. Calls go through the same interceptors
+// as gRPC calls, so they are logged and counted alike. Tracing differs: the
+// otelgrpc stats handler of the gRPC server cannot see Connect calls, so they
+// get an otelhttp server span named after the procedure, carrying the rpc.*
+// attributes and the error code but no message events. The attachment RPCs
+// are left out: their metadata belongs with the content the api-service
+// stores, so they are only called by it.
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	pb "checklist-go/proto"
+	"checklist-go/proto/protoconnect"
+	"checklist-go/services/db-service/internal/logging"
+
+	"connectrpc.com/connect"
+	connectcors "connectrpc.com/cors"
+	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewHandler serves checklists and comments, which are normally the
+// GRPCServer and CommentServer of package server, over Connect, gRPC-Web and
+// gRPC. origins are the other origins browsers may call from: "*" allows
+// any, none allows only same-origin calls. interceptors run on every call in
+// order, as grpc.ChainUnaryInterceptor would run them.
+func NewHandler(checklists protoconnect.ChecklistServiceHandler, comments protoconnect.CommentServiceHandler,
+	origins []string, interceptors ...grpc.UnaryServerInterceptor) http.Handler {
+	// statusErrors comes before the gRPC interceptors so that they still see
+	// the status errors the services return, and callerIdentity after them
+	// so that they see the calls it rejects.
+	opts := []connect.Interceptor{spanAttributes(), statusErrors()}
+	for _, i := range interceptors {
+		opts = append(opts, adapt(i))
+	}
+	opts = append(opts, callerIdentity())
+
+	mux := http.NewServeMux()
+	path, checklistHandler := protoconnect.NewChecklistServiceHandler(checklists, connect.WithInterceptors(opts...))
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attachmentProcedures[r.URL.Path] {
+			http.NotFound(w, r)
+			return
+		}
+		checklistHandler.ServeHTTP(w, r)
+	}))
+	mux.Handle(protoconnect.NewCommentServiceHandler(comments, connect.WithInterceptors(opts...)))
+
+	handler := otelhttp.NewHandler(mux, "web",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return strings.TrimPrefix(r.URL.Path, "/")
+		}))
+	if len(origins) == 0 {
+		// rs/cors would allow every origin.
+		return handler
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: origins,
+		AllowedMethods: connectcors.AllowedMethods(),
+		AllowedHeaders: append(connectcors.AllowedHeaders(), logging.RequestIDKey, userIDHeader),
+		ExposedHeaders: connectcors.ExposedHeaders(),
+		MaxAge:         7200,
+	}).Handler(handler)
+}
+
+// attachmentProcedures are the ChecklistService RPCs NewHandler does not
+// serve. They answer 404 like a procedure that does not exist.
+var attachmentProcedures = map[string]bool{
+	protoconnect.ChecklistServiceCreateAttachmentProcedure: true,
+	protoconnect.ChecklistServiceListAttachmentsProcedure:  true,
+	protoconnect.ChecklistServiceGetAttachmentProcedure:    true,
+	protoconnect.ChecklistServiceDeleteAttachmentProcedure: true,
+}
+
+// userIDHeader carries the caller's identity, as it does for the
+// api-service.
+const userIDHeader = "X-User-ID"
+
+// callerIdentity rejects calls without a user ID, as the api-service answers
+// 401 to requests without one, and sets the author of the comment RPCs to
+// the caller whatever the request says.
+func callerIdentity() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			userID := strings.TrimSpace(req.Header().Get(userIDHeader))
+			if userID == "" {
+				return nil, status.Error(codes.Unauthenticated, userIDHeader+" header is required")
+			}
+			switch msg := req.Any().(type) {
+			case *pb.AddCommentRequest:
+				msg.AuthorId = userID
+			case *pb.EditCommentRequest:
+				msg.AuthorId = userID
+			case *pb.DeleteCommentRequest:
+				msg.AuthorId = userID
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// spanAttributes adds to the otelhttp span of a call the rpc.* attributes
+// otelgrpc sets on gRPC spans, and marks the span failed on an error.
+func spanAttributes() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			span := trace.SpanFromContext(ctx)
+			service, method, _ := strings.Cut(strings.TrimPrefix(req.Spec().Procedure, "/"), "/")
+			span.SetAttributes(semconv.RPCSystemConnectRPC, semconv.RPCService(service), semconv.RPCMethod(method))
+
+			res, err := next(ctx, req)
+			if err != nil {
+				span.SetAttributes(semconv.RPCConnectRPCErrorCodeKey.String(connect.CodeOf(err).String()))
+				span.SetStatus(otelcodes.Error, err.Error())
+			}
+			return res, err
+		}
+	}
+}
+
+// statusErrors turns the gRPC status errors of the services into Connect
+// errors with the same code and message; Connect would report any other
+// error as unknown.
+func statusErrors() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if err == nil {
+				return res, nil
+			}
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return nil, err
+			}
+			if st, ok := status.FromError(err); ok {
+				return nil, connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+			}
+			return nil, err
+		}
+	}
+}
+
+// adapt runs a gRPC interceptor on Connect calls. The request headers become
+// the incoming metadata, as they would for a gRPC call.
+func adapt(interceptor grpc.UnaryServerInterceptor) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			md := metadata.MD{}
+			for key, values := range req.Header() {
+				md.Append(key, values...)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			var res connect.AnyResponse
+			info := &grpc.UnaryServerInfo{FullMethod: req.Spec().Procedure}
+			_, err := interceptor(ctx, req.Any(), info, func(ctx context.Context, _ any) (any, error) {
+				var err error
+				res, err = next(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				return res.Any(), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return res, nil
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "checklist-go/proto"
+	"checklist-go/proto/protoconnect"
+	"checklist-go/services/db-service/internal/server"
+	"checklist-go/services/db-service/internal/storage"
+	"checklist-go/services/db-service/internal/web"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// serve starts the web handler on a fresh MemoryStorage and returns its URL.
+func serve(t *testing.T, interceptors ...grpc.UnaryServerInterceptor) string {
+	t.Helper()
+	st := storage.NewMemoryStorage()
+	srv := httptest.NewServer(web.NewHandler(server.NewGRPCServer(st, server.Options{}), server.NewCommentServer(st),
+		[]string{"https://app.example.com"}, interceptors...))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// asUser returns an HTTP client that sends every request with userID in the
+// X-User-ID header, as the authenticating proxy would.
+func asUser(userID string) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("X-User-ID", userID)
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestProtocols(t *testing.T) {
+	url := serve(t)
+	ctx := context.Background()
+
+	for name, opts := range map[string][]connect.ClientOption{
+		"connect":  nil,
+		"grpc-web": {connect.WithGRPCWeb()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := protoconnect.NewChecklistServiceClient(asUser("alice"), url, opts...)
+			task, err := client.CreateTask(ctx, &pb.CreateTaskRequest{Title: "from the browser"})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			done, err := client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: task.Id})
+			if err != nil || !done.Done {
+				t.Fatalf("MarkTaskDone = %v, %v", done, err)
+			}
+
+			_, err = client.MarkTaskDone(ctx, &pb.TaskActionRequest{Id: uuid.NewString()})
+			if connect.CodeOf(err) != connect.CodeNotFound {
+				t.Errorf("MarkTaskDone(missing) = %v, want not_found", err)
+			}
+			_, err = client.CreateTask(ctx, &pb.CreateTaskRequest{})
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("CreateTask(no title) = %v, want invalid_argument", err)
+			}
+		})
+	}
+}
+
+// TestNoAttachments checks that the attachment RPCs, which would store
+// metadata without content or leave content behind, are not served.
+func TestNoAttachments(t *testing.T) {
+	client := protoconnect.NewChecklistServiceClient(asUser("alice"), serve(t))
+	ctx := context.Background()
+
+	_, err := client.CreateAttachment(ctx, &pb.CreateAttachmentRequest{TaskId: uuid.NewString(), StorageKey: "elsewhere"})
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("CreateAttachment = %v, want unimplemented", err)
+	}
+	_, err = client.DeleteAttachment(ctx, &pb.AttachmentActionRequest{Id: uuid.NewString()})
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("DeleteAttachment = %v, want unimplemented", err)
+	}
+}
+
+// TestInterceptors calls over plain HTTP, as a browser without a generated
+// client would, and checks that the interceptors see the call and its headers.
+func TestInterceptors(t *testing.T) {
+	var methods, requestIDs, userIDs []string
+	record := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		methods = append(methods, info.FullMethod)
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestIDs = append(requestIDs, md.Get("x-request-id")...)
+		userIDs = append(userIDs, md.Get("x-user-id")...)
+		return handler(ctx, req)
+	}
+	url := serve(t, record)
+
+	req, _ := http.NewRequest(http.MethodPost, url+"/proto.CommentService/ListComments",
+		strings.NewReader(`{"task_id":"`+uuid.NewString()+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "abc")
+	req.Header.Set("X-User-ID", "alice")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", res.StatusCode)
+	}
+
+	if len(methods) != 1 || methods[0] != "/proto.CommentService/ListComments" {
+		t.Errorf("intercepted %v, want /proto.CommentService/ListComments", methods)
+	}
+	if len(requestIDs) != 1 || requestIDs[0] != "abc" {
+		t.Errorf("x-request-id metadata = %v, want [abc]", requestIDs)
+	}
+	if len(userIDs) != 1 || userIDs[0] != "alice" {
+		t.Errorf("x-user-id metadata = %v, want [alice]", userIDs)
+	}
+}
+
+// TestCallerIdentity checks that calls need X-User-ID, like the requests of
+// the api-service, and that comments are written as the caller.
+func TestCallerIdentity(t *testing.T) {
+	var seen []codes.Code
+	record := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := handler(ctx, req)
+		seen = append(seen, status.Code(err))
+		return res, err
+	}
+	url := serve(t, record)
+	ctx := context.Background()
+
+	_, err := protoconnect.NewChecklistServiceClient(http.DefaultClient, url).CreateTask(ctx, &pb.CreateTaskRequest{Title: "anonymous"})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("CreateTask without X-User-ID = %v, want unauthenticated", err)
+	}
+	_, err = protoconnect.NewChecklistServiceClient(asUser("  "), url).CreateTask(ctx, &pb.CreateTaskRequest{Title: "blank"})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("CreateTask with a blank X-User-ID = %v, want unauthenticated", err)
+	}
+	if len(seen) != 2 || seen[0] != codes.Unauthenticated || seen[1] != codes.Unauthenticated {
+		t.Errorf("interceptor saw %v, want two Unauthenticated calls", seen)
+	}
+
+	task, err := protoconnect.NewChecklistServiceClient(asUser("alice"), url).CreateTask(ctx, &pb.CreateTaskRequest{Title: "discuss"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	alice := protoconnect.NewCommentServiceClient(asUser("alice"), url)
+	comment, err := alice.AddComment(ctx, &pb.AddCommentRequest{TaskId: task.Id, AuthorId: "mallory", Body: "first"})
+	if err != nil || comment.AuthorId != "alice" {
+		t.Fatalf("AddComment as mallory = %v, %v, want a comment by alice", comment, err)
+	}
+	bob := protoconnect.NewCommentServiceClient(asUser("bob"), url)
+	_, err = bob.EditComment(ctx, &pb.EditCommentRequest{TaskId: task.Id, Id: comment.Id, AuthorId: "alice", Body: "changed"})
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("EditComment by bob claiming to be alice = %v, want permission_denied", err)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	url := serve(t)
+	preflight := func(origin string) *http.Response {
+		req, _ := http.NewRequest(http.MethodOptions, url+"/proto.ChecklistService/ListTasks", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		// Browsers send the names lowercase and sorted.
+		req.Header.Set("Access-Control-Request-Headers", "connect-protocol-version,content-type,x-request-id,x-user-id")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res
+	}
+
+	if got := preflight("https://app.example.com").Header.Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("allowed origin: Access-Control-Allow-Origin = %q", got)
+	}
+	if got := preflight("https://evil.example.com").Header.Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("other origin: Access-Control-Allow-Origin = %q, want none", got)
+	}
+}
@@ -0,0 +1,105 @@
+package workflow
+
+import (
+	pb "checklist-go/proto"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// maxStates bounds the number of states a checklist workflow may define.
+const maxStates = 32
+
+var stateKey = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Default returns the workflow used by tasks without a checklist and by
+// checklists that have not configured their own.
+func Default(checklistID string) *pb.Workflow {
+	return &pb.Workflow{
+		ChecklistId: checklistID,
+		States: []*pb.WorkflowState{
+			{Key: "todo", Name: "To do"},
+			{Key: "in_progress", Name: "In progress"},
+			{Key: "review", Name: "Review"},
+			{Key: "done", Name: "Done", IsDone: true},
+		},
+		Transitions: []*pb.WorkflowTransition{
+			{FromState: "todo", ToState: "in_progress"},
+			{FromState: "todo", ToState: "done"},
+			{FromState: "in_progress", ToState: "todo"},
+			{FromState: "in_progress", ToState: "review"},
+			{FromState: "in_progress", ToState: "done"},
+			{FromState: "review", ToState: "in_progress"},
+			{FromState: "review", ToState: "done"},
+			{FromState: "done", ToState: "todo"},
+			{FromState: "done", ToState: "in_progress"},
+		},
+		IsDefault: true,
+	}
+}
+
+// Validate checks that state keys are well-formed and unique, that there is
+// at least one open and one done state, and that every transition connects
+// two distinct known states.
+func Validate(w *pb.Workflow) error {
+	if len(w.States) == 0 {
+		return errors.New("workflow must define at least one state")
+	}
+	if len(w.States) > maxStates {
+		return fmt.Errorf("workflow may define at most %d states", maxStates)
+	}
+
+	seen := make(map[string]bool, len(w.States))
+	var hasOpen, hasDone bool
+	for _, state := range w.States {
+		if !stateKey.MatchString(state.Key) {
+			return fmt.Errorf("invalid state key %q: use lowercase letters, digits and underscores", state.Key)
+		}
+		if seen[state.Key] {
+			return fmt.Errorf("duplicate state %q", state.Key)
+		}
+		if state.Name == "" {
+			return fmt.Errorf("state %q must have a name", state.Key)
+		}
+		seen[state.Key] = true
+		if state.IsDone {
+			hasDone = true
+		} else {
+			hasOpen = true
+		}
+	}
+	if !hasOpen || !hasDone {
+		return errors.New("workflow must have at least one open and one done state")
+	}
+
+	for _, t := range w.Transitions {
+		if !seen[t.FromState] || !seen[t.ToState] {
+			return fmt.Errorf("transition %s -> %s references an unknown state", t.FromState, t.ToState)
+		}
+		if t.FromState == t.ToState {
+			return fmt.Errorf("transition %s -> %s does not change the state", t.FromState, t.ToState)
+		}
+	}
+	return nil
+}
+
+// State returns the state with the given key.
+func State(w *pb.Workflow, key string) (*pb.WorkflowState, bool) {
+	for _, state := range w.States {
+		if state.Key == key {
+			return state, true
+		}
+	}
+	return nil, false
+}
+
+// CanTransition reports whether the workflow allows moving a task from one
+// state to another.
+func CanTransition(w *pb.Workflow, from, to string) bool {
+	for _, t := range w.Transitions {
+		if t.FromState == from && t.ToState == to {
+			return true
+		}
+	}
+	return false
+}
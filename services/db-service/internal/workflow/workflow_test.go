@@ -0,0 +1,82 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "checklist-go/proto"
+)
+
+func TestValidate(t *testing.T) {
+	open := &pb.WorkflowState{Key: "todo", Name: "To do"}
+	done := &pb.WorkflowState{Key: "done", Name: "Done", IsDone: true}
+	states := func(n int) []*pb.WorkflowState {
+		s := []*pb.WorkflowState{done}
+		for i := 1; i < n; i++ {
+			s = append(s, &pb.WorkflowState{Key: fmt.Sprintf("step_%d", i), Name: "Step"})
+		}
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		w     *pb.Workflow
+		valid bool
+		// want is part of the error of an invalid workflow.
+		want string
+	}{
+		{name: "default", w: Default(""), valid: true},
+		{name: "open and done only", w: &pb.Workflow{States: []*pb.WorkflowState{open, done}}, valid: true},
+		{name: "key of 64 characters", valid: true, w: &pb.Workflow{States: []*pb.WorkflowState{
+			{Key: "a" + strings.Repeat("0", 63), Name: "Long"}, done}}},
+		{name: "maxStates states", w: &pb.Workflow{States: states(maxStates)}, valid: true},
+		{name: "no states", w: &pb.Workflow{}, want: "at least one state"},
+		{name: "more than maxStates", w: &pb.Workflow{States: states(maxStates + 1)}, want: "at most 32 states"},
+		{name: "empty key", w: &pb.Workflow{States: []*pb.WorkflowState{{Key: "", Name: "Nothing"}, done}}, want: "invalid state key"},
+		{name: "upper case key", w: &pb.Workflow{States: []*pb.WorkflowState{{Key: "Todo", Name: "To do"}, done}}, want: "invalid state key"},
+		{name: "key starting with a digit", w: &pb.Workflow{States: []*pb.WorkflowState{{Key: "1st", Name: "First"}, done}}, want: "invalid state key"},
+		{name: "key with a space", w: &pb.Workflow{States: []*pb.WorkflowState{{Key: "in progress", Name: "In progress"}, done}}, want: "invalid state key"},
+		{name: "key of 65 characters", w: &pb.Workflow{States: []*pb.WorkflowState{
+			{Key: "a" + strings.Repeat("0", 64), Name: "Long"}, done}}, want: "invalid state key"},
+		{name: "duplicate key", w: &pb.Workflow{States: []*pb.WorkflowState{open, done, {Key: "todo", Name: "Again"}}}, want: `duplicate state "todo"`},
+		{name: "no name", w: &pb.Workflow{States: []*pb.WorkflowState{{Key: "todo"}, done}}, want: "must have a name"},
+		{name: "no open state", w: &pb.Workflow{States: []*pb.WorkflowState{done, {Key: "cancelled", Name: "Cancelled", IsDone: true}}},
+			want: "at least one open and one done state"},
+		{name: "no done state", w: &pb.Workflow{States: []*pb.WorkflowState{open, {Key: "doing", Name: "Doing"}}},
+			want: "at least one open and one done state"},
+		{name: "self transition", w: &pb.Workflow{States: []*pb.WorkflowState{open, done},
+			Transitions: []*pb.WorkflowTransition{{FromState: "todo", ToState: "todo"}}}, want: "does not change the state"},
+		{name: "transition from an unknown state", w: &pb.Workflow{States: []*pb.WorkflowState{open, done},
+			Transitions: []*pb.WorkflowTransition{{FromState: "review", ToState: "done"}}}, want: "unknown state"},
+		{name: "transition to an unknown state", w: &pb.Workflow{States: []*pb.WorkflowState{open, done},
+			Transitions: []*pb.WorkflowTransition{{FromState: "todo", ToState: "review"}}}, want: "unknown state"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.w)
+			switch {
+			case tt.valid && err != nil:
+				t.Errorf("Validate() = %v, want nil", err)
+			case !tt.valid && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("Validate() = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanTransition(t *testing.T) {
+	w := Default("")
+	if !CanTransition(w, "todo", "in_progress") {
+		t.Error("todo -> in_progress is not allowed")
+	}
+	if CanTransition(w, "todo", "review") || CanTransition(w, "review", "todo") {
+		t.Error("a transition the default workflow lacks is allowed")
+	}
+	if state, ok := State(w, "done"); !ok || !state.IsDone {
+		t.Errorf("State(done) = %v, %t", state, ok)
+	}
+	if _, ok := State(w, "archived"); ok {
+		t.Error("State(archived) found a state")
+	}
+}
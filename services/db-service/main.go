@@ -1,15 +1,143 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	// Timezone database for the alpine runtime image.
+	_ "time/tzdata"
 
+	"checklist-go/internal/config"
 	"checklist-go/services/db-service/internal/app"
+	"checklist-go/services/db-service/internal/health"
 )
 
 func main(){
-	a, err := app.New()
+	// "db-service healthcheck" is the container health check: it exits with
+	// status 0 only if the running server reports SERVING.
+	if len(os.Args) > 1 && os.Args[1] == "healthcheck" {
+		healthcheck(os.Args[2:])
+		return
+	}
+	// "db-service migrate up|down [N|all]|status" manages the schema without
+	// starting the server.
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			slog.Error("Migration failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg, err := config.LoadDB(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+
+	a, err := app.New(cfg)
+	if err != nil {
+		slog.Error("Failed to create app", "error", err)
+		os.Exit(1)
+	}
+	if err := a.Run(); err != nil {
+		slog.Error("db-service stopped with errors", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("db-service stopped")
+}
+
+func healthcheck(args []string) {
+	cfg, err := config.LoadDB(args)
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := health.Probe(ctx, net.JoinHostPort("localhost", strconv.Itoa(cfg.GRPCPort))); err != nil {
+		slog.Error("Health check failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: db-service migrate up|down [N|all]|status [flags]")
+	}
+	action, args := args[0], args[1:]
+	if action != "up" && action != "down" && action != "status" {
+		return fmt.Errorf("unknown migrate action %q, want up, down or status", action)
+	}
+
+	steps := 1
+	if action == "down" && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		if args[0] == "all" {
+			steps = -1
+		} else {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[0])
+			}
+			steps = n
+		}
+		args = args[1:]
+	}
+
+	cfg, err := config.LoadDB(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	m, err := app.OpenMigrator(ctx, cfg.DSN)
 	if err != nil {
-		log.Fatalf("failed to create app: %v", err)
+		return err
+	}
+	defer m.Close(ctx)
+
+	switch action {
+	case "up":
+		applied, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migration(s)\n", applied)
+	case "down":
+		reverted, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("reverted %d migration(s)\n", reverted)
+	case "status":
+		st, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d", st.Version)
+		if st.Dirty {
+			fmt.Print(" (dirty)")
+		}
+		fmt.Println()
+		if len(st.Pending) == 0 {
+			fmt.Println("pending: none")
+		}
+		for _, p := range st.Pending {
+			fmt.Printf("pending: %06d_%s\n", p.Version, p.Name)
+		}
 	}
-	a.Run()
-}
\ No newline at end of file
+	return nil
+}
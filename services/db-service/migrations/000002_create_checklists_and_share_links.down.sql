@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS share_links;
+ALTER TABLE tasks DROP COLUMN IF EXISTS checklist_id;
+DROP TABLE IF EXISTS checklists;
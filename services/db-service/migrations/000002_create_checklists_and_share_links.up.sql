@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS checklists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS checklist_id UUID REFERENCES checklists(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_tasks_checklist_id ON tasks(checklist_id);
+
+CREATE TABLE IF NOT EXISTS share_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    checklist_id UUID NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    token_hash BYTEA NOT NULL UNIQUE,
+    password_hash TEXT,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_share_links_checklist_id ON share_links(checklist_id);
@@ -0,0 +1,13 @@
+ALTER TABLE checklists
+    DROP COLUMN IF EXISTS occurrence_at,
+    DROP COLUMN IF EXISTS recurrence_id;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS occurrence_at,
+    DROP COLUMN IF EXISTS recurrence_id;
+
+DROP TABLE IF EXISTS recurrences;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS due_at;
@@ -0,0 +1,32 @@
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS due_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+UPDATE tasks SET completed_at = updated_at WHERE done AND completed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS recurrences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID UNIQUE REFERENCES tasks(id) ON DELETE CASCADE,
+    checklist_id UUID UNIQUE REFERENCES checklists(id) ON DELETE CASCADE,
+    rrule TEXT NOT NULL,
+    dtstart TIMESTAMPTZ NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    -- NULL, когда правило исчерпано (COUNT/UNTIL)
+    next_occurrence_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((task_id IS NULL) <> (checklist_id IS NULL))
+);
+CREATE INDEX IF NOT EXISTS idx_recurrences_next_occurrence_at ON recurrences(next_occurrence_at) WHERE next_occurrence_at IS NOT NULL;
+
+-- Повторения, созданные планировщиком. Уникальный индекс по (recurrence_id, occurrence_at)
+-- не дает нескольким репликам db-service создать одно и то же повторение дважды.
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS recurrence_id UUID REFERENCES recurrences(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS occurrence_at TIMESTAMPTZ;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_recurrence_occurrence ON tasks(recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL;
+
+ALTER TABLE checklists
+    ADD COLUMN IF NOT EXISTS recurrence_id UUID REFERENCES recurrences(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS occurrence_at TIMESTAMPTZ;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_checklists_recurrence_occurrence ON checklists(recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS template_tasks;
+DROP TABLE IF EXISTS templates;
+DROP INDEX IF EXISTS idx_tasks_tags;
+ALTER TABLE tasks DROP COLUMN IF EXISTS tags;
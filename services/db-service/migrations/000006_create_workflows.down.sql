@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_checklist_status;
+ALTER TABLE tasks DROP COLUMN IF EXISTS status;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_states;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS workflow_states (
+    checklist_id UUID NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    key VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    position INT NOT NULL,
+    is_done BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (checklist_id, key)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_transitions (
+    checklist_id UUID NOT NULL,
+    from_state VARCHAR(64) NOT NULL,
+    to_state VARCHAR(64) NOT NULL,
+    PRIMARY KEY (checklist_id, from_state, to_state),
+    FOREIGN KEY (checklist_id, from_state) REFERENCES workflow_states(checklist_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (checklist_id, to_state) REFERENCES workflow_states(checklist_id, key) ON DELETE CASCADE
+);
+
+-- done остается для обратной совместимости и всегда соответствует status
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS status VARCHAR(64) NOT NULL DEFAULT 'todo';
+UPDATE tasks SET status = 'done' WHERE done;
+CREATE INDEX IF NOT EXISTS idx_tasks_checklist_status ON tasks(checklist_id, status);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_assignees;
+DROP TABLE IF EXISTS checklist_members;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_tasks_open_due_at ON tasks (due_at) WHERE NOT done;
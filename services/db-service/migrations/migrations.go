@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL schema migrations of the db-service so
+// that the binary can apply them without the files on disk.
+package migrations
+
+import "embed"
+
+// FS holds the NNNNNN_name.up.sql and NNNNNN_name.down.sql files.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS saved_views;
+DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS task_assignees;
+DROP TABLE IF EXISTS checklist_members;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_states;
+DROP TABLE IF EXISTS task_dependencies;
+DROP TABLE IF EXISTS template_tasks;
+DROP TABLE IF EXISTS templates;
+DROP TABLE IF EXISTS share_links;
+DROP TABLE IF EXISTS recurrences;
+DROP TABLE IF EXISTS tasks;
+DROP TABLE IF EXISTS checklists;
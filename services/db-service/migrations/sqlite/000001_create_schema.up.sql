@@ -0,0 +1,160 @@
+-- Схема повторяет схему Postgres со следующими отличиями:
+-- идентификаторы UUID хранятся как TEXT в каноническом виде (строчными буквами),
+-- время — как INTEGER, число микросекунд с начала эпохи Unix (UTC),
+-- теги — как JSON-массив строк, булевы значения — как 0 и 1.
+-- Полнотекстовый поиск выполняется функциями приложения, поэтому столбцов
+-- search_vector нет.
+
+CREATE TABLE checklists (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    recurrence_id TEXT REFERENCES recurrences(id) ON DELETE SET NULL,
+    occurrence_at INTEGER
+);
+CREATE UNIQUE INDEX idx_checklists_recurrence_occurrence ON checklists(recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL;
+
+CREATE TABLE tasks (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT,
+    done INTEGER NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'todo',
+    checklist_id TEXT REFERENCES checklists(id) ON DELETE CASCADE,
+    tags TEXT NOT NULL DEFAULT '[]',
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    due_at INTEGER,
+    completed_at INTEGER,
+    recurrence_id TEXT REFERENCES recurrences(id) ON DELETE SET NULL,
+    occurrence_at INTEGER
+);
+CREATE INDEX idx_tasks_checklist_id ON tasks(checklist_id);
+CREATE INDEX idx_tasks_checklist_status ON tasks(checklist_id, status);
+CREATE INDEX idx_tasks_created_at ON tasks(created_at);
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at) WHERE completed_at IS NOT NULL;
+CREATE INDEX idx_tasks_open_due_at ON tasks(due_at) WHERE NOT done;
+-- Не дает создать одно и то же повторение дважды.
+CREATE UNIQUE INDEX idx_tasks_recurrence_occurrence ON tasks(recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL;
+
+CREATE TABLE share_links (
+    id TEXT PRIMARY KEY,
+    checklist_id TEXT NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    token_hash BLOB NOT NULL UNIQUE,
+    password_hash TEXT,
+    expires_at INTEGER,
+    revoked_at INTEGER,
+    created_at INTEGER NOT NULL
+);
+CREATE INDEX idx_share_links_checklist_id ON share_links(checklist_id);
+
+CREATE TABLE recurrences (
+    id TEXT PRIMARY KEY,
+    task_id TEXT UNIQUE REFERENCES tasks(id) ON DELETE CASCADE,
+    checklist_id TEXT UNIQUE REFERENCES checklists(id) ON DELETE CASCADE,
+    rrule TEXT NOT NULL,
+    dtstart INTEGER NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    -- NULL, когда правило исчерпано (COUNT/UNTIL)
+    next_occurrence_at INTEGER,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    CHECK ((task_id IS NULL) <> (checklist_id IS NULL))
+);
+CREATE INDEX idx_recurrences_next_occurrence_at ON recurrences(next_occurrence_at) WHERE next_occurrence_at IS NOT NULL;
+
+CREATE TABLE templates (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    checklist_title TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE template_tasks (
+    template_id TEXT NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- Срок относительно момента создания чек-листа из шаблона, NULL — без срока
+    due_offset_seconds INTEGER,
+    tags TEXT NOT NULL DEFAULT '[]',
+    PRIMARY KEY (template_id, position)
+);
+
+CREATE TABLE task_dependencies (
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    depends_on_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (task_id, depends_on_id),
+    CHECK (task_id <> depends_on_id)
+);
+CREATE INDEX idx_task_dependencies_depends_on_id ON task_dependencies(depends_on_id);
+
+CREATE TABLE workflow_states (
+    checklist_id TEXT NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    is_done INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (checklist_id, key)
+);
+
+CREATE TABLE workflow_transitions (
+    checklist_id TEXT NOT NULL,
+    from_state TEXT NOT NULL,
+    to_state TEXT NOT NULL,
+    PRIMARY KEY (checklist_id, from_state, to_state),
+    FOREIGN KEY (checklist_id, from_state) REFERENCES workflow_states(checklist_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (checklist_id, to_state) REFERENCES workflow_states(checklist_id, key) ON DELETE CASCADE
+);
+
+CREATE TABLE checklist_members (
+    checklist_id TEXT NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (checklist_id, user_id)
+);
+
+CREATE TABLE task_assignees (
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (task_id, user_id)
+);
+CREATE INDEX idx_task_assignees_user_id ON task_assignees(user_id);
+
+CREATE TABLE comments (
+    id TEXT PRIMARY KEY,
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    edited_at INTEGER
+);
+CREATE INDEX idx_comments_task_id_created_at ON comments(task_id, created_at, id);
+
+CREATE TABLE attachments (
+    id TEXT PRIMARY KEY,
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    filename TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes INTEGER NOT NULL CHECK (size_bytes >= 0),
+    sha256 TEXT NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    uploaded_by TEXT,
+    created_at INTEGER NOT NULL
+);
+CREATE INDEX idx_attachments_task_id ON attachments(task_id);
+
+CREATE TABLE saved_views (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    filter TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
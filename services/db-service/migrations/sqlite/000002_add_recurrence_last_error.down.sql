@@ -0,0 +1 @@
+ALTER TABLE recurrences DROP COLUMN last_error;
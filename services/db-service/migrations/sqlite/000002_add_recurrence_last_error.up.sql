@@ -0,0 +1,2 @@
+-- Почему планировщик отключил правило; NULL, пока правило работает
+ALTER TABLE recurrences ADD COLUMN last_error TEXT;
@@ -0,0 +1,11 @@
+// Package sqlite embeds the schema migrations of the SQLite storage backend.
+// They describe the same schema as the Postgres migrations in a single step,
+// since SQLite databases have no older schema versions to upgrade.
+package sqlite
+
+import "embed"
+
+// FS holds the NNNNNN_name.up.sql and NNNNNN_name.down.sql files.
+//
+//go:embed *.sql
+var FS embed.FS